@@ -5,15 +5,19 @@ import (
 	_ "embed"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.mondoo.com/cnquery/cli/config"
 	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/mqlc"
 	"go.mondoo.com/cnquery/stringx"
 	"go.mondoo.com/cnquery/upstream"
+	"go.mondoo.com/ranger-rpc"
 )
 
 func init() {
@@ -23,10 +27,25 @@ func init() {
 	// bundle lint
 	packBundlesCmd.AddCommand(queryPackLintCmd)
 
+	// bundle fmt
+	queryPackFmtCmd.Flags().Bool("check", false, "Don't write any files. Show how they would change, and exit with an error if any aren't formatted")
+	packBundlesCmd.AddCommand(queryPackFmtCmd)
+
+	// bundle lock
+	queryPackLockCmd.Flags().Bool("update", false, "Accept imports that changed since they were locked")
+	packBundlesCmd.AddCommand(queryPackLockCmd)
+
+	// bundle sign
+	queryPackSignCmd.Flags().String("key", "", "Path to the PEM encoded ed25519, ECDSA, or RSA private key to sign with")
+	queryPackSignCmd.Flags().String("cert", "", "Path to the PEM encoded certificate of the key, followed by its intermediate certificates")
+	queryPackSignCmd.MarkFlagRequired("key")
+	packBundlesCmd.AddCommand(queryPackSignCmd)
+
 	// publish
 	queryPackPublishCmd.Flags().String("pack-version", "", "Override the version of each pack in the bundle")
 	packBundlesCmd.AddCommand(queryPackPublishCmd)
 
+	packBundlesCmd.PersistentFlags().Bool("no-code-cache", false, "Compile all queries from scratch instead of reusing compiled queries from previous runs")
 	rootCmd.AddCommand(packBundlesCmd)
 }
 
@@ -62,7 +81,7 @@ var queryPackInitCmd = &cobra.Command{
 	},
 }
 
-func validate(queryPackBundle *explorer.Bundle) []string {
+func validate(queryPackBundle *explorer.Bundle, sources explorer.QuerySources, cache *explorer.CodeCache, resolver *explorer.ImportResolver) []string {
 	errors := []string{}
 
 	// check that we have uids for packs and queries
@@ -89,21 +108,100 @@ func validate(queryPackBundle *explorer.Bundle) []string {
 				queryId = query.Uid
 			}
 
-			if query.Title == "" {
+			// references to other queries use their title
+			isReference := query.Mql == "" && len(query.Variants) == 0
+			if query.Title == "" && !isReference {
 				errors = append(errors, fmt.Sprintf("query %s/%s does not define a name", packId, queryId))
 			}
 		}
 	}
 
+	// imports are resolved before queries are compiled, so we can tell
+	// which references can't be found in the bundle or its imports
+	ctx := explorer.WithImportResolver(explorer.WithCodeCache(context.Background(), cache), resolver)
+	if err := queryPackBundle.ResolveImports(ctx); err != nil {
+		return append(errors, "could not resolve imports: "+err.Error())
+	}
+	unresolved := queryPackBundle.UnresolvedReferences()
+	if len(unresolved) != 0 {
+		return append(errors, unresolved...)
+	}
+
 	// we compile after the checks because it removes the uids and replaces it with mrns
-	_, err := queryPackBundle.Compile(context.Background())
+	_, err := queryPackBundle.Compile(ctx)
 	if err != nil {
-		errors = append(errors, "could not compile the query pack bundle", err.Error())
+		errors = append(errors, "could not compile the query pack bundle", sources.Explain(err))
 	}
 
 	return errors
 }
 
+// newImportResolver resolves the imports of the bundle at the given path,
+// pinned by its lock file. Packs are imported by MRN from the registry.
+func newImportResolver(bundlePath string, update bool) (*explorer.ImportResolver, error) {
+	lockPath := explorer.LockFilePath(bundlePath)
+	lock, err := explorer.LoadBundleLock(lockPath)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := explorer.NewRegistryClient(ranger.DefaultHttpClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return &explorer.ImportResolver{
+		Lock:     lock,
+		LockDir:  filepath.Dir(lockPath),
+		Update:   update,
+		Registry: registry,
+	}, nil
+}
+
+// loadCodeCache loads the cache of compiled queries, unless it is disabled
+// via --no-code-cache. Returns nil if no cache is used.
+func loadCodeCache(cmd *cobra.Command) *explorer.CodeCache {
+	if disabled, _ := cmd.Flags().GetBool("no-code-cache"); disabled {
+		return nil
+	}
+
+	path, err := explorer.DefaultCodeCachePath()
+	if err != nil {
+		log.Warn().Err(err).Msg("cannot use code cache")
+		return nil
+	}
+
+	cache := explorer.NewCodeCache(path)
+	if err := cache.Load(); err != nil {
+		log.Warn().Err(err).Msg("cannot use code cache")
+		return nil
+	}
+	return cache
+}
+
+// storeCodeCache saves newly compiled queries for the next run
+func storeCodeCache(cache *explorer.CodeCache) {
+	if cache == nil {
+		return
+	}
+
+	hits, misses := cache.Stats()
+	log.Debug().Int("cached", hits).Int("compiled", misses).Msg("code cache")
+	if err := cache.Save(); err != nil {
+		log.Warn().Err(err).Msg("failed to store code cache")
+	}
+}
+
+// loadQuerySources finds where queries are defined in the bundle files, so
+// that errors can point to them. Errors only lead to less helpful messages.
+func loadQuerySources(path string) explorer.QuerySources {
+	res, err := explorer.LoadQuerySources(path)
+	if err != nil {
+		log.Debug().Err(err).Msg("could not load sources of queries")
+	}
+	return res
+}
+
 var queryPackLintCmd = &cobra.Command{
 	Use:     "lint [path]",
 	Aliases: []string{"validate"},
@@ -116,8 +214,16 @@ var queryPackLintCmd = &cobra.Command{
 			log.Fatal().Err(err).Msg("could not load query pack")
 		}
 
-		errors := validate(queryPackBundle)
+		resolver, err := newImportResolver(args[0], false)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not load lock file")
+		}
+
+		sources := loadQuerySources(args[0])
+		cache := loadCodeCache(cmd)
+		errors := validate(queryPackBundle, sources, cache, resolver)
 		if len(errors) > 0 {
+			storeCodeCache(cache)
 			log.Error().Msg("could not validate query pack")
 			for i := range errors {
 				fmt.Fprintf(os.Stderr, stringx.Indent(2, errors[i]))
@@ -125,10 +231,166 @@ var queryPackLintCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		diagnostics := queryPackBundle.LintWithCache(cache)
+		storeCodeCache(cache)
+		hasErrors := false
+		for i := range diagnostics {
+			d := diagnostics[i]
+			fmt.Fprint(os.Stderr, stringx.Indent(2, d.String()))
+			if src := sources.Lookup(d.Query); src != nil && d.Pos.Line != 0 {
+				if excerpt := src.Excerpt(d.Pos, 1); excerpt != "" {
+					fmt.Fprint(os.Stderr, stringx.Indent(4, excerpt))
+				}
+			}
+			if d.Fix != nil {
+				fmt.Fprint(os.Stderr, stringx.Indent(4, "fix: "+d.Fix.Title))
+			}
+			if d.Severity == mqlc.SeverityError {
+				hasErrors = true
+			}
+		}
+		if hasErrors {
+			log.Error().Msg("query pack has lint errors")
+			os.Exit(1)
+		}
+
 		log.Info().Msg("valid query pack")
 	},
 }
 
+var queryPackLockCmd = &cobra.Command{
+	Use:   "lock [path]",
+	Short: "Pin the imports of a query pack to their current content.",
+	Long: `
+Lock resolves all imports of the query packs in a bundle and writes their
+checksums to a lock file next to it. Linting and scanning the bundle fail
+if an import no longer matches the lock file. Use --update to accept
+changed imports.
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		update, _ := cmd.Flags().GetBool("update")
+		resolver, err := newImportResolver(args[0], update)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not load lock file")
+		}
+
+		queryPackBundle, err := explorer.BundleFromPaths(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not load query pack")
+		}
+		if err := queryPackBundle.ResolveImports(explorer.WithImportResolver(context.Background(), resolver)); err != nil {
+			log.Fatal().Err(err).Msg("could not resolve imports")
+		}
+
+		lock := resolver.Resolved()
+		lockPath := explorer.LockFilePath(args[0])
+		if err := lock.Save(lockPath); err != nil {
+			log.Fatal().Err(err).Msg("could not write lock file")
+		}
+		log.Info().Str("file", lockPath).Int("imports", len(lock.Imports)).Msg("locked imports")
+	},
+}
+
+var queryPackSignCmd = &cobra.Command{
+	Use:   "sign [path...]",
+	Short: "Sign query packs, so that scans can verify their origin.",
+	Long: `
+Sign writes a detached signature next to each bundle file, with the suffix
+".sig". It signs the content of the bundle, so changes to whitespace and
+comments keep the signature valid, while any other change invalidates it. Scans refuse
+bundles with invalid signatures. Use --require-signed-packs and
+--trusted-key to only scan packs signed by a trusted key.
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		keyPath, _ := cmd.Flags().GetString("key")
+		key, err := os.ReadFile(keyPath)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not read private key")
+		}
+
+		var cert []byte
+		if certPath, _ := cmd.Flags().GetString("cert"); certPath != "" {
+			cert, err = os.ReadFile(certPath)
+			if err != nil {
+				log.Fatal().Err(err).Msg("could not read certificate")
+			}
+		}
+
+		signer, err := explorer.NewBundleSigner(key, cert)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not load signing key")
+		}
+
+		files, err := explorer.SignBundleFiles(signer, args...)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not sign query pack")
+		}
+		for _, file := range files {
+			log.Info().Str("file", file).Msg("signed query pack")
+		}
+	},
+}
+
+var queryPackFmtCmd = &cobra.Command{
+	Use:     "fmt [path]",
+	Aliases: []string{"format"},
+	Short:   "Format the MQL code of all queries in a query pack.",
+	Args:    cobra.MinimumNArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("check", cmd.Flags().Lookup("check"))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		files, err := explorer.FormatBundleFiles(args...)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not format query pack")
+		}
+
+		check := viper.GetBool("check")
+		unformatted := 0
+		for i := range files {
+			file := files[i]
+			if !file.Changed() {
+				continue
+			}
+			unformatted++
+
+			if check {
+				diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+					A:        difflib.SplitLines(file.Original),
+					B:        difflib.SplitLines(file.Formatted),
+					FromFile: file.Path,
+					ToFile:   file.Path + " (formatted)",
+					Context:  3,
+				})
+				if err != nil {
+					log.Fatal().Err(err).Msgf("could not compare '%s'", file.Path)
+				}
+				fmt.Print(diff)
+				continue
+			}
+
+			mode := os.FileMode(0o640)
+			if fi, err := os.Stat(file.Path); err == nil {
+				mode = fi.Mode()
+			}
+			if err := os.WriteFile(file.Path, []byte(file.Formatted), mode); err != nil {
+				log.Fatal().Err(err).Msgf("could not write '%s'", file.Path)
+			}
+			log.Info().Str("file", file.Path).Msg("formatted query pack")
+		}
+
+		if check && unformatted != 0 {
+			log.Error().Msgf("%d query pack file(s) are not formatted, run `cnquery bundle fmt` to fix them", unformatted)
+			os.Exit(1)
+		}
+		if unformatted == 0 {
+			log.Info().Msg("query packs are formatted")
+		}
+	},
+}
+
 var queryPackPublishCmd = &cobra.Command{
 	Use:     "publish [path]",
 	Aliases: []string{"upload"},
@@ -151,7 +413,14 @@ var queryPackPublishCmd = &cobra.Command{
 			log.Fatal().Err(err).Msg("could not load query pack bundle")
 		}
 
-		errors := validate(queryPackBundle)
+		resolver, err := newImportResolver(filename, false)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not load lock file")
+		}
+
+		cache := loadCodeCache(cmd)
+		errors := validate(queryPackBundle, loadQuerySources(filename), cache, resolver)
+		storeCodeCache(cache)
 		if len(errors) > 0 {
 			log.Error().Msg("could not validate query pack")
 			for i := range errors {
@@ -197,6 +466,11 @@ var queryPackPublishCmd = &cobra.Command{
 		queryPackBundle.OwnerMrn = opts.SpaceMrn
 		ctx := context.Background()
 
+		// imports are only available here, so they are sent along
+		if err := queryPackBundle.ResolveImports(explorer.WithImportResolver(ctx, resolver)); err != nil {
+			log.Fatal().Err(err).Msg("could not resolve imports")
+		}
+
 		// override version and/or labels
 		for i := range queryPackBundle.Packs {
 			p := queryPackBundle.Packs[i]
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/cli/reporter"
+	"go.mondoo.com/cnquery/cli/theme/colors"
+	"go.mondoo.com/cnquery/explorer"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	diffCmd.Flags().StringP("output", "o", "text", "Set output format. Accepts text or json.")
+	rootCmd.AddCommand(diffCmd)
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff OLD NEW",
+	Short: "Show how the results of two scans differ.",
+	Long: `
+Diff compares the results of two scans and shows which assets and results
+were added, removed, or changed. Results are matched by their query's code ID.
+
+Both files are report collections in JSON, as written by the "report"
+output format:
+
+    $ cnquery diff old.json new.json
+	`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		format, ok := reporter.DiffFormats[output]
+		if !ok {
+			log.Fatal().Msg("unknown output format '" + output + "'. Available: text, json")
+		}
+
+		old, new, err := fileReports(args[0], args[1])
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load reports")
+		}
+
+		diff := explorer.DiffReports(old, new)
+		if err := reporter.PrintDiff(diff, format, &colors.DefaultColorTheme, os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("failed to print diff")
+		}
+	},
+}
+
+func fileReports(oldPath string, newPath string) (*explorer.ReportCollection, *explorer.ReportCollection, error) {
+	old, err := readReportCollection(oldPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	new, err := readReportCollection(newPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return old, new, nil
+}
+
+func readReportCollection(path string) (*explorer.ReportCollection, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &explorer.ReportCollection{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, res); err != nil {
+		return nil, errors.New("failed to read report '" + path + "', it must be written with the 'report' output format: " + err.Error())
+	}
+	return res, nil
+}
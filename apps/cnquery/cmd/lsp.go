@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/cli/config"
+	"go.mondoo.com/cnquery/cli/lsp"
+	"go.mondoo.com/cnquery/resources/packs/all/info"
+)
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+}
+
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Run a language server for MQL and query packs.",
+	Long: `Run a language server that speaks the Language Server Protocol over stdio.
+It supports .mql files and query packs in .mql.yaml files, with completion and
+hover docs for resources and fields, go-to-definition for props and queries,
+and diagnostics for all queries.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		server := lsp.New(info.Registry.Schema(), config.Features, cnquery.GetVersion())
+		if err := server.Serve(os.Stdin, os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("language server failed")
+		}
+	},
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/cli/theme"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/explorer/packstore"
+	"go.mondoo.com/ranger-rpc"
+)
+
+func init() {
+	// pack search
+	packSearchCmd.Flags().String("namespace", packstore.DefaultNamespace, "Namespace of the registry to search in")
+	packBundlesCmd.AddCommand(packSearchCmd)
+
+	// pack install
+	packBundlesCmd.AddCommand(packInstallCmd)
+
+	// pack update
+	packBundlesCmd.AddCommand(packUpdateCmd)
+
+	// pack list
+	packBundlesCmd.AddCommand(packListCmd)
+
+	for _, cmd := range []*cobra.Command{packSearchCmd, packInstallCmd, packUpdateCmd, packListCmd} {
+		cmd.Flags().String("dir", "", "Directory of installed query packs (default is ~/.config/mondoo/querypacks)")
+	}
+}
+
+// openPackStore opens the store of installed query packs. The registry is
+// the public one, or the one set via REGISTRY_URL.
+func openPackStore(cmd *cobra.Command) (*packstore.Store, error) {
+	dir, _ := cmd.Flags().GetString("dir")
+	if dir == "" {
+		var err error
+		dir, err = packstore.DefaultPath()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	registry, err := explorer.NewRegistryClient(ranger.DefaultHttpClient())
+	if err != nil {
+		return nil, err
+	}
+	return packstore.New(dir, registry), nil
+}
+
+var packSearchCmd = &cobra.Command{
+	Use:   "search [term]",
+	Short: "Search the query packs of the registry.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		store, err := openPackStore(cmd)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open query pack store")
+		}
+
+		var term string
+		if len(args) == 1 {
+			term = args[0]
+		}
+		namespace, _ := cmd.Flags().GetString("namespace")
+
+		packs, err := store.Search(context.Background(), namespace, term)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not search query packs")
+		}
+		if len(packs) == 0 {
+			fmt.Println("No query packs found.")
+			return
+		}
+
+		for _, pack := range packs {
+			fmt.Println(theme.DefaultTheme.Primary(pack.Mrn) + " " + theme.DefaultTheme.Secondary(pack.Version))
+			fmt.Println("  " + pack.Name)
+		}
+	},
+}
+
+var packInstallCmd = &cobra.Command{
+	Use:   "install PACK...",
+	Short: "Install query packs from the registry.",
+	Long: `
+Install query packs from the registry, so that they can be scanned via
+"cnquery scan --querypack namespace/uid", or by UID alone if no other
+namespace has a pack with it. Packs are referenced by their UID in the
+mondoohq namespace, by "namespace/uid", or by their MRN. Add a version constraint to pin a pack,
+e.g. "linux-security@^1.2". Updates of pinned packs stay within the
+constraint.
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		store, err := openPackStore(cmd)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open query pack store")
+		}
+
+		for _, arg := range args {
+			ref, err := packstore.ParsePackRef(arg)
+			if err != nil {
+				log.Fatal().Err(err).Msg("invalid query pack")
+			}
+
+			pack, err := store.Install(context.Background(), ref)
+			if err != nil {
+				log.Fatal().Err(err).Msg("could not install query pack")
+			}
+			log.Info().Str("pack", pack.Ref()).Str("version", pack.Version).Msg("installed query pack")
+		}
+	},
+}
+
+var packUpdateCmd = &cobra.Command{
+	Use:   "update [pack...]",
+	Short: "Update installed query packs, or all of them if none are given.",
+	Run: func(cmd *cobra.Command, args []string) {
+		store, err := openPackStore(cmd)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open query pack store")
+		}
+
+		updates, err := store.Update(context.Background(), args...)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not update query packs")
+		}
+		if len(updates) == 0 {
+			log.Info().Msg("all query packs are up to date")
+			return
+		}
+
+		for _, update := range updates {
+			if update.HeldBack {
+				log.Warn().Str("pack", update.Pack.Ref()).Str("pin", update.Pack.Pin).Str("available", update.NewVersion).
+					Msg("query pack has a newer version outside of its pin")
+				continue
+			}
+			log.Info().Str("pack", update.Pack.Ref()).Str("from", update.OldVersion).Str("to", update.NewVersion).
+				Msg("updated query pack")
+		}
+	},
+}
+
+var packListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List installed query packs.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		store, err := openPackStore(cmd)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open query pack store")
+		}
+
+		packs, err := store.List()
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not list query packs")
+		}
+		if len(packs) == 0 {
+			fmt.Println("No query packs installed.")
+			return
+		}
+
+		for _, pack := range packs {
+			line := "  " + theme.DefaultTheme.Primary(pack.Ref()) + " " + theme.DefaultTheme.Secondary(pack.Version)
+			if pack.Pin != "" {
+				line += " (pinned to " + pack.Pin + ")"
+			}
+			fmt.Println(line)
+		}
+	},
+}
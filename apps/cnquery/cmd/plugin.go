@@ -1,16 +1,20 @@
 package cmd
 
 import (
+	"encoding/json"
 	"os"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/go-plugin"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"go.mondoo.com/cnquery/cli/config"
 	"go.mondoo.com/cnquery/cli/printer"
 	"go.mondoo.com/cnquery/cli/reporter"
 	"go.mondoo.com/cnquery/cli/shell"
+	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/logger"
 	"go.mondoo.com/cnquery/motor/asset"
 	v1 "go.mondoo.com/cnquery/motor/inventory/v1"
@@ -80,6 +84,38 @@ func (c *cnqueryPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Ru
 		return nil
 	}
 
+	if conf.DoExplain || conf.MaxCost != "" {
+		maxCost := mqlc.CostHigh
+		if conf.MaxCost != "" {
+			var err error
+			if maxCost, err = mqlc.ParseCost(conf.MaxCost); err != nil {
+				return errors.Wrap(err, "invalid max cost")
+			}
+		}
+
+		b, err := mqlc.Compile(conf.Command, nil, mqlc.NewConfig(runtime.Schema(), conf.Features))
+		if err != nil {
+			return errors.Wrap(err, "failed to compile command")
+		}
+
+		plan := mqlc.Explain(b, runtime.Schema())
+		if conf.Format != "json" {
+			out.WriteString(plan.String())
+		} else {
+			data, err := json.Marshal(plan)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal query plan")
+			}
+			out.Write(data)
+		}
+
+		// this lets CI pipelines reject expensive queries
+		if plan.Cost > maxCost {
+			return errors.New("the estimated cost of the query is " + plan.Cost.String() + ", which exceeds the maximum of " + maxCost.String())
+		}
+		return nil
+	}
+
 	assetList := conf.Inventory.Spec.Assets
 	log.Debug().Msgf("resolved %d assets", len(assetList))
 
@@ -121,6 +157,7 @@ func (c *cnqueryPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Ru
 		}
 	}
 
+	var spans []*llx.Span
 	for i := range filteredAssets {
 		connectAsset := filteredAssets[i]
 		err := runtime.Connect(&proto.ConnectReq{
@@ -150,7 +187,13 @@ func (c *cnqueryPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Ru
 			shellOptions = append(shellOptions, shell.WithUpstreamConfig(upstreamConfig))
 		}
 
-		sh, err := shell.New(runtime, shellOptions...)
+		scheduler := llx.NewScheduler(runtime, llx.SchedulerOptions{
+			Workers:      int(conf.Workers),
+			Connection:   runtime.ConnectionType(),
+			Trace:        zerolog.GlobalLevel() <= zerolog.DebugLevel || conf.TraceFile != "",
+			FieldTimeout: time.Duration(conf.FieldTimeout),
+		})
+		sh, err := shell.New(scheduler, shellOptions...)
 		if err != nil {
 			return errors.Wrap(err, "failed to initialize the shell")
 		}
@@ -160,6 +203,7 @@ func (c *cnqueryPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Ru
 		if err != nil {
 			return errors.Wrap(err, "failed to run")
 		}
+		spans = append(spans, scheduler.Spans()...)
 
 		if conf.Format != "json" {
 			sh.PrintResults(code, results)
@@ -176,5 +220,6 @@ func (c *cnqueryPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Ru
 		out.WriteString("]")
 	}
 
+	storeTrace(conf.TraceFile, spans)
 	return nil
 }
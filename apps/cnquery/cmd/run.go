@@ -6,6 +6,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers"
 	"go.mondoo.com/cnquery/providers/proto"
 	"go.mondoo.com/cnquery/shared"
@@ -18,8 +19,13 @@ func init() {
 	runCmd.Flags().StringP("command", "c", "", "MQL query to executed in the shell.")
 	runCmd.Flags().Bool("parse", false, "Parse the query and return the logical structure.")
 	runCmd.Flags().Bool("ast", false, "Parse the query and return the abstract syntax tree (AST).")
+	runCmd.Flags().Bool("explain", false, "Compile the query and explain which resources and fields it fetches and how expensive they are, without running it.")
+	runCmd.Flags().String("max-cost", "", "Explain the query and fail if its estimated cost exceeds this: low, medium, or high.")
 	runCmd.Flags().BoolP("json", "j", false, "Run the query and return the object in a JSON structure.")
 	runCmd.Flags().String("platform-id", "", "Select a specific target asset by providing its platform ID.")
+	runCmd.Flags().Int("workers", llx.DefaultSchedulerWorkers, "Max number of resource fields that are resolved at the same time.")
+	runCmd.Flags().Duration("field-timeout", 0, "Max time to wait for a resource field, e.g. 30s. Fields that take longer are reported as timed out.")
+	runCmd.Flags().String("trace", "", "Write a trace of all resources and fields to this file, in Chrome's trace event format, and print the slowest fields.")
 }
 
 var runCmd = &cobra.Command{
@@ -28,6 +34,9 @@ var runCmd = &cobra.Command{
 	Long:  `Run an MQL query on the CLI and displays its results.`,
 	PreRun: func(cmd *cobra.Command, args []string) {
 		viper.BindPFlag("platform-id", cmd.Flags().Lookup("platform-id"))
+		viper.BindPFlag("workers", cmd.Flags().Lookup("workers"))
+		viper.BindPFlag("field-timeout", cmd.Flags().Lookup("field-timeout"))
+		viper.BindPFlag("trace", cmd.Flags().Lookup("trace"))
 	},
 }
 
@@ -37,11 +46,16 @@ var runcmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *pro
 	conf.Command, _ = cmd.Flags().GetString("command")
 	conf.DoAst, _ = cmd.Flags().GetBool("ast")
 	conf.DoParse, _ = cmd.Flags().GetBool("parse")
+	conf.DoExplain, _ = cmd.Flags().GetBool("explain")
+	conf.MaxCost, _ = cmd.Flags().GetString("max-cost")
 	conf.DoRecord, _ = cmd.Flags().GetBool("record")
 	if doJSON, _ := cmd.Flags().GetBool("json"); doJSON {
 		conf.Format = "json"
 	}
 	conf.PlatformId, _ = cmd.Flags().GetString("platform-id")
+	conf.Workers = uint32(viper.GetInt("workers"))
+	conf.FieldTimeout = int64(viper.GetDuration("field-timeout"))
+	conf.TraceFile = viper.GetString("trace")
 	conf.Inventory = cliRes.Inventory
 
 	x := cnqueryPlugin{}
@@ -16,6 +16,7 @@ import (
 	"go.mondoo.com/cnquery/cli/reporter"
 	"go.mondoo.com/cnquery/cli/theme"
 	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/explorer/packstore"
 	"go.mondoo.com/cnquery/motor/asset"
 	v1 "go.mondoo.com/cnquery/motor/inventory/v1"
 	"go.mondoo.com/cnquery/providers"
@@ -36,7 +37,7 @@ func init() {
 
 	// bundles, packs & incognito mode
 	scanCmd.Flags().Bool("incognito", false, "Run in incognito mode. Do not report scan results to  Mondoo Platform.")
-	scanCmd.Flags().StringSlice("querypack", nil, "Set the query packs to execute. This requires `querypack-bundle`. You can specify multiple UIDs.")
+	scanCmd.Flags().StringSlice("querypack", nil, "Set the query packs to execute. Without `querypack-bundle`, installed query packs are used. You can specify multiple UIDs.")
 	scanCmd.Flags().StringSliceP("querypack-bundle", "f", nil, "Path to local query pack file")
 	// flag completion command
 	scanCmd.RegisterFlagCompletionFunc("querypack", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -45,6 +46,9 @@ func init() {
 	scanCmd.Flags().String("asset-name", "", "User-override for the asset name")
 	scanCmd.Flags().StringToString("annotation", nil, "Add an annotation to the asset.") // user-added, editable
 	scanCmd.Flags().StringToString("props", nil, "Custom values for properties")
+	scanCmd.Flags().Bool("no-code-cache", false, "Compile all queries from scratch instead of reusing compiled queries from previous runs")
+	scanCmd.Flags().Bool("require-signed-packs", false, "Refuse query packs that aren't signed by a trusted key.")
+	scanCmd.Flags().StringSlice("trusted-key", nil, "Path to a PEM file with public keys or root certificates that are trusted to sign query packs.")
 
 	// v6 should make detect-cicd and category flag public
 	scanCmd.Flags().Bool("detect-cicd", true, "Try to detect CI/CD environments. If detected, set the asset category to 'cicd'.")
@@ -93,6 +97,8 @@ To manually configure a query pack, use this:
 		viper.BindPFlag("record", cmd.Flags().Lookup("record"))
 
 		viper.BindPFlag("output", cmd.Flags().Lookup("output"))
+		viper.BindPFlag("querypack_signatures.require", cmd.Flags().Lookup("require-signed-packs"))
+		viper.BindPFlag("querypack_signatures.trusted_keys", cmd.Flags().Lookup("trusted-key"))
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
@@ -125,7 +131,14 @@ var scanCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *pr
 func getQueryPacksForCompletion() []string {
 	querypackList := []string{}
 
-	// TODO: autocompletion
+	// installed query packs
+	if dir, err := packstore.DefaultPath(); err == nil {
+		packs, _ := packstore.New(dir, nil).List()
+		for _, pack := range packs {
+			querypackList = append(querypackList, pack.Ref())
+		}
+	}
+
 	sort.Strings(querypackList)
 
 	return querypackList
@@ -139,6 +152,10 @@ type scanConfig struct {
 	QueryPackNames []string
 	Props          map[string]string
 	Bundle         *explorer.Bundle
+	// Signatures decides which query packs can be scanned
+	Signatures *explorer.SignaturePolicy
+	// CodeCache has the compiled queries of previous scans, it is optional
+	CodeCache *explorer.CodeCache
 
 	IsIncognito bool
 	DoRecord    bool
@@ -166,6 +183,24 @@ func getCobraScanConfig(cmd *cobra.Command, runtime *providers.Runtime, cliRes *
 		QueryPackPaths: viper.GetStringSlice("querypack-bundle"),
 		QueryPackNames: viper.GetStringSlice("querypacks"),
 		Props:          props,
+		CodeCache:      loadCodeCache(cmd),
+	}
+
+	conf.Signatures, err = loadSignaturePolicy(opts.QueryPackSignatures)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load trusted keys for query packs")
+	}
+
+	// query packs without a bundle are the installed packs with these UIDs
+	if len(conf.QueryPackPaths) == 0 && len(conf.QueryPackNames) != 0 {
+		dir, err := packstore.DefaultPath()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to find installed query packs")
+		}
+		conf.QueryPackPaths, err = packstore.New(dir, nil).BundlePaths(conf.Signatures, conf.QueryPackNames...)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load installed query packs")
+		}
 	}
 
 	// if users want to get more information on available output options,
@@ -248,18 +283,56 @@ func getCobraScanConfig(cmd *cobra.Command, runtime *providers.Runtime, cliRes *
 	return &conf, nil
 }
 
+// loadSignaturePolicy reads the trusted keys for query packs. Returns nil
+// if no signatures are required and no keys are trusted.
+func loadSignaturePolicy(opts config.QueryPackSignatureOpts) (*explorer.SignaturePolicy, error) {
+	if !opts.Require && len(opts.TrustedKeys) == 0 {
+		return nil, nil
+	}
+
+	res := &explorer.SignaturePolicy{RequireSignature: opts.Require}
+	for _, path := range opts.TrustedKeys {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := res.AddTrusted(raw); err != nil {
+			return nil, errors.Wrap(err, "failed to load "+path)
+		}
+	}
+	return res, nil
+}
+
+// context for compiling and fetching the bundles of a scan
+func (c *scanConfig) context() context.Context {
+	ctx := explorer.WithCodeCache(context.Background(), c.CodeCache)
+	return explorer.WithSignaturePolicy(ctx, c.Signatures)
+}
+
 func (c *scanConfig) loadBundles() error {
 	if c.IsIncognito {
 		if len(c.QueryPackPaths) == 0 {
 			return nil
 		}
 
-		bundle, err := explorer.BundleFromPaths(c.QueryPackPaths...)
+		bundle, err := explorer.BundleFromPathsWithPolicy(c.Signatures, c.QueryPackPaths...)
 		if err != nil {
 			return err
 		}
 
-		_, err = bundle.Compile(context.Background())
+		// imports are pinned by the lock file, which only exists for one bundle
+		resolver := &explorer.ImportResolver{}
+		if len(c.QueryPackPaths) == 1 {
+			resolver, err = newImportResolver(c.QueryPackPaths[0], false)
+			if err != nil {
+				return err
+			}
+		}
+		resolver.Signatures = c.Signatures
+		ctx := explorer.WithImportResolver(c.context(), resolver)
+
+		_, err = bundle.Compile(ctx)
+		storeCodeCache(c.CodeCache)
 		if err != nil {
 			return errors.Wrap(err, "failed to compile bundle")
 		}
@@ -271,6 +344,11 @@ func (c *scanConfig) loadBundles() error {
 	return nil
 }
 
+// RunScan is not wired to a runtime yet, which also blocks features that
+// depend on scans.
+// TODO: scan --trace (only run and shell trace fields for now)
+// TODO: scan --datalake with scan.WithDatalake and diff --datalake, which
+// reads the results of a datalake at two points in time
 func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
 	// opts := []scan.ScannerOption{}
 	// if config.UpstreamConfig != nil {
@@ -278,7 +356,7 @@ func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
 	// }
 
 	// scanner := scan.NewLocalScanner(opts...)
-	// ctx := cnquery.SetFeatures(context.Background(), config.Features)
+	// ctx := cnquery.SetFeatures(config.context(), config.Features)
 
 	// if config.IsIncognito {
 	// 	return scanner.RunIncognito(
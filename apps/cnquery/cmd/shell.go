@@ -3,8 +3,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/mattn/go-isatty"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,6 +15,7 @@ import (
 	"go.mondoo.com/cnquery/cli/config"
 	"go.mondoo.com/cnquery/cli/shell"
 	"go.mondoo.com/cnquery/cli/theme"
+	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/motor/asset"
 	"go.mondoo.com/cnquery/motor/inventory"
 	v1 "go.mondoo.com/cnquery/motor/inventory/v1"
@@ -27,6 +30,9 @@ func init() {
 
 	shellCmd.Flags().StringP("command", "c", "", "MQL query to executed in the shell.")
 	shellCmd.Flags().String("platform-id", "", "Select a specific target asset by providing its platform ID.")
+	shellCmd.Flags().Int("workers", llx.DefaultSchedulerWorkers, "Max number of resource fields that are resolved at the same time.")
+	shellCmd.Flags().Duration("field-timeout", 0, "Max time to wait for a resource field, e.g. 30s. Fields that take longer are reported as timed out.")
+	shellCmd.Flags().String("trace", "", "Write a trace of all resources and fields to this file when the shell exits, in Chrome's trace event format.")
 }
 
 var shellCmd = &cobra.Command{
@@ -35,6 +41,9 @@ var shellCmd = &cobra.Command{
 	Long:  `Allows the interactive exploration of MQL queries.`,
 	PreRun: func(cmd *cobra.Command, args []string) {
 		viper.BindPFlag("platform-id", cmd.Flags().Lookup("platform-id"))
+		viper.BindPFlag("workers", cmd.Flags().Lookup("workers"))
+		viper.BindPFlag("field-timeout", cmd.Flags().Lookup("field-timeout"))
+		viper.BindPFlag("trace", cmd.Flags().Lookup("trace"))
 	},
 }
 
@@ -47,9 +56,12 @@ var shellRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *prot
 	config.DisplayUsedConfig()
 
 	shellConf := ShellConfig{
-		Features:   config.Features,
-		PlatformID: viper.GetString("platform-id"),
-		Inventory:  cliRes.Inventory,
+		Features:     config.Features,
+		PlatformID:   viper.GetString("platform-id"),
+		Inventory:    cliRes.Inventory,
+		Workers:      viper.GetInt("workers"),
+		FieldTimeout: viper.GetDuration("field-timeout"),
+		TraceFile:    viper.GetString("trace"),
 	}
 
 	shellConf.Command, _ = cmd.Flags().GetString("command")
@@ -97,6 +109,9 @@ type ShellConfig struct {
 	Features       cnquery.Features
 	PlatformID     string
 	WelcomeMessage string
+	Workers        int
+	FieldTimeout   time.Duration
+	TraceFile      string
 
 	UpstreamConfig *providers.UpstreamConfig
 }
@@ -148,8 +163,20 @@ func StartShell(runtime *providers.Runtime, conf *ShellConfig) error {
 		log.Fatal().Err(err).Msg("failed to connect to asset")
 	}
 
+	scheduler := llx.NewScheduler(runtime, llx.SchedulerOptions{
+		Workers:      conf.Workers,
+		Connection:   runtime.ConnectionType(),
+		Trace:        zerolog.GlobalLevel() <= zerolog.DebugLevel || conf.TraceFile != "",
+		FieldTimeout: conf.FieldTimeout,
+	})
+
 	// when we close the shell, we need to close the backend and store the recording
 	onCloseHandler := func() {
+		if conf.TraceFile != "" {
+			if err := writeTrace(conf.TraceFile, scheduler.Spans()); err != nil {
+				log.Error().Err(err).Msg("failed to store trace")
+			}
+		}
 		runtime.Close()
 	}
 
@@ -161,7 +188,7 @@ func StartShell(runtime *providers.Runtime, conf *ShellConfig) error {
 		shellOptions = append(shellOptions, shell.WithUpstreamConfig(conf.UpstreamConfig))
 	}
 
-	sh, err := shell.New(runtime, shellOptions...)
+	sh, err := shell.New(scheduler, shellOptions...)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to initialize interactive shell")
 	}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/llx"
+)
+
+// slowestFields is the number of fields in the summary of a trace
+const slowestFields = 10
+
+// writeTrace stores the spans in Chrome's trace event format at the given
+// path. The file can be opened in chrome://tracing or ui.perfetto.dev.
+func writeTrace(path string, spans []*llx.Span) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to create trace file")
+	}
+	defer f.Close()
+
+	if err := llx.WriteChromeTrace(f, spans); err != nil {
+		return errors.Wrap(err, "failed to write trace")
+	}
+	log.Info().Str("path", path).Int("spans", len(spans)).Msg("stored trace")
+	return nil
+}
+
+// printSlowestFields prints a table of the fields that took the longest
+// in total to resolve
+func printSlowestFields(out io.Writer, spans []*llx.Span) {
+	profiles := llx.Profile(spans)
+	if len(profiles) == 0 {
+		return
+	}
+	io.WriteString(out, "\nSlowest fields:\n"+llx.FormatProfile(profiles, slowestFields))
+}
+
+// storeTrace writes the trace file, if one was requested, and prints the
+// slowest fields to stderr, so they don't mix with the results
+func storeTrace(path string, spans []*llx.Span) {
+	if path == "" {
+		return
+	}
+	if err := writeTrace(path, spans); err != nil {
+		log.Error().Err(err).Msg("failed to store trace")
+	}
+	printSlowestFields(os.Stderr, spans)
+}
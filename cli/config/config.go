@@ -173,6 +173,17 @@ type Config struct {
 	// Asset Category
 	Category               string `json:"category,omitempty" mapstructure:"category"`
 	AutoDetectCICDCategory bool   `json:"detect-cicd,omitempty" mapstructure:"detect-cicd"`
+
+	// Signatures of query packs that are scanned
+	QueryPackSignatures QueryPackSignatureOpts `json:"querypack_signatures,omitempty" mapstructure:"querypack_signatures"`
+}
+
+type QueryPackSignatureOpts struct {
+	// Require refuses query packs that aren't signed by a trusted key
+	Require bool `json:"require,omitempty" mapstructure:"require"`
+	// TrustedKeys are paths of PEM files with public keys or root
+	// certificates that are trusted to sign query packs
+	TrustedKeys []string `json:"trusted_keys,omitempty" mapstructure:"trusted_keys"`
 }
 
 type CommonOpts struct {
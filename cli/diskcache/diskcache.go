@@ -0,0 +1,93 @@
+// Package diskcache stores caches as JSON files on disk. Every cache file
+// is named after the hash of its key, so that caches for different
+// versions, schemas or assets don't overwrite each other.
+package diskcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Key hashes all values, so that no two lists of values lead to the same key
+func Key(values ...string) string {
+	sum := sha256.New()
+	for i := range values {
+		sum.Write([]byte(strconv.Itoa(len(values[i])) + ":"))
+		sum.Write([]byte(values[i]))
+	}
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+// File is one cache file in a cache directory
+type File struct {
+	// Dir is the directory with all cache files
+	Dir string
+	// Path of this cache file
+	Path string
+	// Name of the cache in errors and logs, e.g. "code cache"
+	Name string
+}
+
+// New returns the cache file for the key in the given directory
+func New(dir string, name string, key ...string) File {
+	return File{
+		Dir:  dir,
+		Path: filepath.Join(dir, Key(key...)+".json"),
+		Name: name,
+	}
+}
+
+// Load the cache into v. Returns false if there is no cache yet, or if it is
+// broken, in which case it is rebuilt from scratch.
+func (f File) Load(v interface{}) (bool, error) {
+	raw, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to read "+f.Name)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		log.Warn().Err(err).Str("path", f.Path).Msg("ignoring invalid " + f.Name)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Save v to the cache file. It is written to a temporary file first and
+// then renamed, so that concurrent runs never read a partial cache.
+func (f File) Save(v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal "+f.Name)
+	}
+
+	if err := os.MkdirAll(f.Dir, 0o700); err != nil {
+		return errors.Wrap(err, "failed to create "+f.Name+" directory")
+	}
+
+	tmp, err := os.CreateTemp(f.Dir, filepath.Base(f.Path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to store "+f.Name)
+	}
+	_, err = tmp.Write(raw)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.Path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return errors.Wrap(err, "failed to store "+f.Name)
+	}
+	return nil
+}
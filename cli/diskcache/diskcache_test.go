@@ -0,0 +1,57 @@
+package diskcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, Key("a", "b"), Key("a", "b"))
+	assert.NotEqual(t, Key("ab"), Key("a", "b"))
+	assert.NotEqual(t, Key("a", "bc"), Key("ab", "c"))
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	file := New(dir, "test cache", "v1")
+
+	var res map[string]int
+	found, err := file.Load(&res)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, file.Save(map[string]int{"a": 1}))
+	found, err = New(dir, "test cache", "v1").Load(&res)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, map[string]int{"a": 1}, res)
+
+	t.Run("caches are per key", func(t *testing.T) {
+		found, err := New(dir, "test cache", "v2").Load(&res)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("saving leaves no temporary files", func(t *testing.T) {
+		require.NoError(t, file.Save(map[string]int{"b": 2}))
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, filepath.Base(file.Path), entries[0].Name())
+
+		info, err := entries[0].Info()
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("broken caches are ignored", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(file.Path, []byte("{"), 0o600))
+		found, err := file.Load(&res)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
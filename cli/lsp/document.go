@@ -0,0 +1,322 @@
+package lsp
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"go.mondoo.com/cnquery/explorer"
+	"gopkg.in/yaml.v3"
+)
+
+// document is a file that is open in the editor. It is either plain MQL or
+// a bundle in YAML, which may contain many MQL snippets.
+type document struct {
+	uri  string
+	text string
+	// lines contains the offset at which every line starts
+	lines    []int
+	snippets []*snippet
+	// queries and props that are defined in this document, by UID
+	queries map[string]Range
+	props   []*propDef
+	// refs are places that reference a query by its UID
+	refs []*uidRef
+	// err is set if the document can't be parsed
+	err *Diagnostic
+}
+
+// snippet is a piece of MQL code in a document
+type snippet struct {
+	// kind of snippet, e.g. query, property, filter, or function
+	kind string
+	code string
+	// offsets maps every byte of the code to its offset in the document,
+	// including one extra entry for the end of the code
+	offsets []int
+	// props that are available to this snippet
+	props []*propDef
+	// filters that restrict which platforms this snippet runs on
+	filters []string
+	// functions that are available to this snippet
+	functions []*explorer.Function
+}
+
+type propDef struct {
+	name string
+	mql  string
+	rng  Range
+}
+
+type uidRef struct {
+	uid string
+	rng Range
+}
+
+func newDocument(uri string, text string) *document {
+	doc := &document{
+		uri:     uri,
+		text:    text,
+		lines:   []int{0},
+		queries: map[string]Range{},
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			doc.lines = append(doc.lines, i+1)
+		}
+	}
+
+	if isMqlFile(uri) {
+		offsets := make([]int, len(text)+1)
+		for i := range offsets {
+			offsets[i] = i
+		}
+		doc.snippets = []*snippet{{kind: "query", code: text, offsets: offsets}}
+		return doc
+	}
+
+	doc.parseBundle()
+	return doc
+}
+
+func isMqlFile(uri string) bool {
+	return strings.HasSuffix(uri, ".mql")
+}
+
+// position converts an offset in the document into an LSP position, whose
+// characters are counted in UTF-16 code units
+func (d *document) position(offset int) Position {
+	if offset > len(d.text) {
+		offset = len(d.text)
+	}
+	line := 0
+	for line+1 < len(d.lines) && d.lines[line+1] <= offset {
+		line++
+	}
+
+	var char int
+	for _, r := range d.text[d.lines[line]:offset] {
+		char += len(utf16.Encode([]rune{r}))
+	}
+	return Position{Line: line, Character: char}
+}
+
+// offset converts an LSP position into an offset in the document
+func (d *document) offset(pos Position) int {
+	if pos.Line >= len(d.lines) {
+		return len(d.text)
+	}
+
+	offset := d.lines[pos.Line]
+	for char := 0; char < pos.Character && offset < len(d.text) && d.text[offset] != '\n'; {
+		r, size := utf8.DecodeRuneInString(d.text[offset:])
+		char += len(utf16.Encode([]rune{r}))
+		offset += size
+	}
+	return offset
+}
+
+func (d *document) rangeOf(start int, end int) Range {
+	return Range{Start: d.position(start), End: d.position(end)}
+}
+
+// snippetAt returns the snippet at the given offset in the document and the
+// offset inside the snippet's code
+func (d *document) snippetAt(offset int) (*snippet, int) {
+	for _, s := range d.snippets {
+		if offset < s.offsets[0] || offset > s.offsets[len(s.offsets)-1] {
+			continue
+		}
+		i := len(s.offsets) - 1
+		for i > 0 && s.offsets[i] > offset {
+			i--
+		}
+		return s, i
+	}
+	return nil, 0
+}
+
+// nodeOffset is where a YAML node starts in the document
+func (d *document) nodeOffset(node *yaml.Node) int {
+	if node.Line < 1 || node.Line > len(d.lines) {
+		return len(d.text)
+	}
+	// YAML columns count runes, not UTF-16 code units like LSP positions
+	return explorer.YamlOffset(d.text, node)
+}
+
+func (d *document) nodeRange(node *yaml.Node) Range {
+	start := d.nodeOffset(node)
+	return d.rangeOf(start, start+len(node.Value))
+}
+
+// newSnippet for the code in a YAML node
+func (d *document) newSnippet(kind string, node *yaml.Node) *snippet {
+	return &snippet{
+		kind:    kind,
+		code:    node.Value,
+		offsets: explorer.MapYamlValue(d.text, node),
+	}
+}
+
+var reYamlErrorLine = regexp.MustCompile(`line (\d+)`)
+
+func (d *document) parseBundle() {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(d.text), &root); err != nil {
+		var line int
+		if m := reYamlErrorLine.FindStringSubmatch(err.Error()); m != nil {
+			line, _ = strconv.Atoi(m[1])
+			line--
+		}
+		d.err = &Diagnostic{
+			Range:    Range{Start: Position{Line: line}, End: Position{Line: line + 1}},
+			Severity: DiagnosticSeverityError,
+			Source:   "yaml",
+			Message:  err.Error(),
+		}
+		return
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return
+	}
+	bundle := root.Content[0]
+
+	functions := d.parseFunctions(explorer.YamlValue(bundle, "functions"))
+	props := d.parseProps(explorer.YamlValue(bundle, "props"))
+	d.parseQueries(explorer.YamlValue(bundle, "queries"), nil, functions, props)
+
+	for _, pack := range explorer.YamlItems(explorer.YamlValue(bundle, "packs")) {
+		packFunctions := d.parseFunctions(explorer.YamlValue(pack, "functions"))
+		packFunctions = append(packFunctions, functions...)
+		packProps := d.parseProps(explorer.YamlValue(pack, "props"))
+		packFilters := d.parseFilters(explorer.YamlValue(pack, "filters"), packFunctions)
+
+		d.parseQueries(explorer.YamlValue(pack, "queries"), packFilters, packFunctions, packProps)
+		for _, group := range explorer.YamlItems(explorer.YamlValue(pack, "groups")) {
+			groupFilters := d.parseFilters(explorer.YamlValue(group, "filters"), packFunctions)
+			if len(groupFilters) == 0 {
+				groupFilters = packFilters
+			}
+			d.parseQueries(explorer.YamlValue(group, "queries"), groupFilters, packFunctions, packProps)
+		}
+	}
+}
+
+func (d *document) parseFunctions(node *yaml.Node) []*explorer.Function {
+	var res []*explorer.Function
+	for _, item := range explorer.YamlItems(node) {
+		fun := &explorer.Function{}
+		if name := explorer.YamlValue(item, "name"); name != nil {
+			fun.Name = name.Value
+		}
+		for _, arg := range explorer.YamlItems(explorer.YamlValue(item, "args")) {
+			cur := &explorer.FunctionArg{}
+			if name := explorer.YamlValue(arg, "name"); name != nil {
+				cur.Name = name.Value
+			}
+			if typ := explorer.YamlValue(arg, "type"); typ != nil {
+				cur.Type = typ.Value
+			}
+			fun.Args = append(fun.Args, cur)
+		}
+		if mql := explorer.YamlValue(item, "mql"); mql != nil {
+			fun.Mql = mql.Value
+			d.snippets = append(d.snippets, d.newSnippet("function", mql))
+		}
+		res = append(res, fun)
+	}
+	return res
+}
+
+func (d *document) parseProps(node *yaml.Node) []*propDef {
+	var res []*propDef
+	for _, item := range explorer.YamlItems(node) {
+		uid := explorer.YamlValue(item, "uid")
+		if uid == nil {
+			continue
+		}
+
+		prop := &propDef{name: uid.Value, rng: d.nodeRange(uid)}
+		if mql := explorer.YamlValue(item, "mql"); mql != nil {
+			prop.mql = mql.Value
+			d.snippets = append(d.snippets, d.newSnippet("property", mql))
+		}
+		d.props = append(d.props, prop)
+		res = append(res, prop)
+	}
+	return res
+}
+
+// parseFilters adds snippets for all filters and returns their code
+func (d *document) parseFilters(node *yaml.Node, functions []*explorer.Function) []string {
+	if node == nil {
+		return nil
+	}
+
+	var nodes []*yaml.Node
+	switch node.Kind {
+	case yaml.ScalarNode:
+		nodes = append(nodes, node)
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind == yaml.ScalarNode {
+				nodes = append(nodes, item)
+			} else if mql := explorer.YamlValue(item, "mql"); mql != nil {
+				nodes = append(nodes, mql)
+			}
+		}
+	}
+
+	res := make([]string, len(nodes))
+	for i := range nodes {
+		s := d.newSnippet("filter", nodes[i])
+		s.functions = functions
+		d.snippets = append(d.snippets, s)
+		res[i] = nodes[i].Value
+	}
+	return res
+}
+
+func (d *document) parseQueries(node *yaml.Node, filters []string, functions []*explorer.Function, props []*propDef) {
+	for _, item := range explorer.YamlItems(node) {
+		// props of the query take precedence over those of its pack
+		queryProps := append(d.parseProps(explorer.YamlValue(item, "props")), props...)
+
+		queryFilters := d.parseFilters(explorer.YamlValue(item, "filters"), functions)
+		if len(queryFilters) == 0 {
+			queryFilters = filters
+		}
+
+		mql := explorer.YamlValue(item, "mql")
+		if mql == nil {
+			mql = explorer.YamlValue(item, "query")
+		}
+
+		variants := explorer.YamlItems(explorer.YamlValue(item, "variants"))
+		if uid := explorer.YamlValue(item, "uid"); uid != nil {
+			if mql != nil || len(variants) != 0 {
+				d.queries[uid.Value] = d.nodeRange(uid)
+			} else {
+				// queries without code are references to other queries
+				d.refs = append(d.refs, &uidRef{uid: uid.Value, rng: d.nodeRange(uid)})
+			}
+		}
+
+		for _, variant := range variants {
+			if uid := explorer.YamlValue(variant, "uid"); uid != nil {
+				d.refs = append(d.refs, &uidRef{uid: uid.Value, rng: d.nodeRange(uid)})
+			}
+		}
+
+		if mql != nil {
+			s := d.newSnippet("query", mql)
+			s.props = queryProps
+			s.filters = queryFilters
+			s.functions = functions
+			d.snippets = append(d.snippets, s)
+		}
+	}
+}
@@ -0,0 +1,421 @@
+// Package lsp implements a language server for MQL and query packs, which
+// talks the Language Server Protocol over stdio.
+package lsp
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/mqlc/parser"
+	"go.mondoo.com/cnquery/types"
+)
+
+// Server is a language server for MQL. Queries are compiled against the
+// schema that it is given.
+type Server struct {
+	schema   llx.Schema
+	features cnquery.Features
+	version  string
+	conn     *conn
+	docs     map[string]*document
+	shutdown bool
+}
+
+// New creates a language server for the given schema
+func New(schema llx.Schema, features cnquery.Features, version string) *Server {
+	return &Server{
+		schema:   schema,
+		features: features,
+		version:  version,
+		docs:     map[string]*document{},
+	}
+}
+
+// Serve requests from the client until it exits or closes the connection.
+// Requests are handled one after the other.
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	s.conn = newConn(in, out)
+
+	for {
+		data, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var req request
+		if err := json.Unmarshal(data, &req); err != nil {
+			s.conn.reply(nil, nil, &responseError{Code: codeParseError, Message: err.Error()})
+			continue
+		}
+
+		if req.Method == "exit" {
+			return nil
+		}
+
+		result, err := s.handle(&req)
+		if req.isNotification() {
+			if err != nil {
+				log.Debug().Err(err).Str("method", req.Method).Msg("lsp> failed to handle notification")
+			}
+			continue
+		}
+		if err := s.conn.reply(req.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(req *request) (interface{}, error) {
+	if s.shutdown && req.Method != "exit" {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "server is shutting down"}
+	}
+
+	switch req.Method {
+	case "initialize":
+		return s.initialize(), nil
+	case "initialized", "$/cancelRequest", "$/setTrace", "workspace/didChangeConfiguration":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := parseParams(req, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.open(params.TextDocument.URI, params.TextDocument.Text)
+
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := parseParams(req, &params); err != nil {
+			return nil, err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// we only support full syncs, so the last change has the entire text
+		text := params.ContentChanges[len(params.ContentChanges)-1].Text
+		return nil, s.open(params.TextDocument.URI, text)
+
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := parseParams(req, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		if err := parseParams(req, &params); err != nil {
+			return nil, err
+		}
+		return s.completion(params), nil
+
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := parseParams(req, &params); err != nil {
+			return nil, err
+		}
+		return s.hover(params), nil
+
+	case "textDocument/definition":
+		var params TextDocumentPositionParams
+		if err := parseParams(req, &params); err != nil {
+			return nil, err
+		}
+		return s.definition(params), nil
+
+	default:
+		return nil, &responseError{Code: codeMethodNotFound, Message: "method not supported: " + req.Method}
+	}
+}
+
+func parseParams(req *request, v interface{}) error {
+	if err := json.Unmarshal(req.Params, v); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) initialize() *InitializeResult {
+	return &InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: TextDocumentSyncFull,
+			CompletionProvider: &CompletionOptions{
+				TriggerCharacters: []string{"."},
+			},
+			HoverProvider:      true,
+			DefinitionProvider: true,
+		},
+		ServerInfo: &ServerInfo{
+			Name:    "cnquery",
+			Version: s.version,
+		},
+	}
+}
+
+// open or update a document and publish its diagnostics
+func (s *Server) open(uri string, text string) error {
+	doc := newDocument(uri, text)
+	s.docs[uri] = doc
+
+	return s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: s.diagnostics(doc),
+	})
+}
+
+// functions that are available to the given snippet. Errors in functions
+// are reported where they are defined.
+func (s *Server) functions(snippet *snippet) []*parser.Function {
+	var res []*parser.Function
+	for i := range snippet.functions {
+		fun, err := snippet.functions[i].Parse()
+		if err != nil {
+			continue
+		}
+		res = append(res, fun)
+	}
+	return res
+}
+
+// props for the given snippet, typed by compiling their code
+func (s *Server) props(snippet *snippet) map[string]*llx.Primitive {
+	res := map[string]*llx.Primitive{}
+	for i := len(snippet.props) - 1; i >= 0; i-- {
+		prop := snippet.props[i]
+		typ := types.Any
+		if code, err := mqlc.Compile(prop.mql, nil, mqlc.NewConfig(s.schema, s.features)); err == nil {
+			if eps := code.CodeV2.Entrypoints(); len(eps) == 1 {
+				typ = code.CodeV2.Chunk(eps[0]).Type()
+			}
+		}
+		res[prop.name] = &llx.Primitive{Type: string(typ)}
+	}
+	return res
+}
+
+func (s *Server) analyze(snippet *snippet) *mqlc.Analysis {
+	if snippet.kind == "function" {
+		// functions are compiled where they are called, so we only
+		// check their syntax
+		res := &mqlc.Analysis{}
+		if _, err := parser.Parse(snippet.code); err != nil {
+			d := &mqlc.Diagnostic{Severity: mqlc.SeverityError, Rule: "syntax", Message: err.Error()}
+			d.Pos, _ = parser.ErrorPosition(err)
+			res.Diagnostics = append(res.Diagnostics, d)
+		}
+		return res
+	}
+
+	conf := mqlc.NewConfig(s.schema, s.features)
+	conf.Functions = s.functions(snippet)
+	opts := mqlc.LintOptions{
+		Platforms: mqlc.FilterPlatforms(snippet.filters),
+	}
+	return mqlc.Analyze(snippet.code, s.props(snippet), conf, opts)
+}
+
+var severities = map[mqlc.Severity]int{
+	mqlc.SeverityError:   DiagnosticSeverityError,
+	mqlc.SeverityWarning: DiagnosticSeverityWarning,
+	mqlc.SeverityInfo:    DiagnosticSeverityInformation,
+}
+
+func (s *Server) diagnostics(doc *document) []Diagnostic {
+	res := []Diagnostic{}
+	if doc.err != nil {
+		return append(res, *doc.err)
+	}
+
+	for _, snippet := range doc.snippets {
+		analysis := s.analyze(snippet)
+		for _, d := range analysis.Diagnostics {
+			// diagnostics without a position cover the entire snippet
+			start, end := 0, len(snippet.code)
+			if d.Pos.Line != 0 {
+				start = d.Pos.Offset
+				end = start + identLength(snippet.code[start:])
+			}
+
+			res = append(res, Diagnostic{
+				Range:    doc.rangeOf(snippet.offsets[start], snippet.offsets[end]),
+				Severity: severities[d.Severity],
+				Code:     d.Rule,
+				Source:   "mql",
+				Message:  d.Message,
+			})
+		}
+	}
+	return res
+}
+
+var reIdent = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*`)
+
+// identLength returns the length of the identifier at the start of the
+// code or 1 if there is none, so that ranges are never empty
+func identLength(code string) int {
+	if n := len(reIdent.FindString(code)); n != 0 {
+		return n
+	}
+	if len(code) == 0 {
+		return 0
+	}
+	return 1
+}
+
+func (s *Server) completion(params TextDocumentPositionParams) *CompletionList {
+	res := &CompletionList{Items: []CompletionItem{}}
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return res
+	}
+	snippet, idx := doc.snippetAt(doc.offset(params.Position))
+	if snippet == nil {
+		return res
+	}
+
+	conf := mqlc.NewConfig(s.schema, s.features)
+	conf.Functions = s.functions(snippet)
+	code, _ := mqlc.Compile(snippet.code[:idx], s.props(snippet), conf)
+	if code == nil {
+		return res
+	}
+
+	for i := range code.Suggestions {
+		cur := code.Suggestions[i]
+		item := CompletionItem{
+			Label:  cur.Field,
+			Kind:   CompletionItemKindField,
+			Detail: cur.Title,
+		}
+		if s.schema.Lookup(cur.Field) != nil {
+			item.Kind = CompletionItemKindModule
+		}
+		if cur.Desc != "" {
+			item.Documentation = &MarkupContent{Kind: "markdown", Value: cur.Desc}
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res
+}
+
+func (s *Server) hover(params TextDocumentPositionParams) *Hover {
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	snippet, idx := doc.snippetAt(doc.offset(params.Position))
+	if snippet == nil {
+		return nil
+	}
+
+	analysis := s.analyze(snippet)
+	for _, ref := range analysis.References {
+		if idx < ref.Start.Offset || idx >= ref.End {
+			continue
+		}
+
+		rng := doc.rangeOf(snippet.offsets[ref.Start.Offset], snippet.offsets[ref.End])
+		return &Hover{
+			Contents: MarkupContent{Kind: "markdown", Value: hoverDocs(ref)},
+			Range:    &rng,
+		}
+	}
+	return nil
+}
+
+func hoverDocs(ref *mqlc.Reference) string {
+	var res strings.Builder
+	title, desc, version := ref.Resource.Title, ref.Resource.Desc, ref.Resource.MinMondooVersion
+
+	if ref.Field == nil {
+		res.WriteString("**" + ref.Resource.Id + "** resource")
+	} else {
+		res.WriteString("**" + ref.Resource.Id + "." + ref.Field.Name + "** `" + types.Type(ref.Field.Type).Label() + "`")
+		title, desc, version = ref.Field.Title, ref.Field.Desc, ref.Field.MinMondooVersion
+	}
+
+	if title != "" {
+		res.WriteString("\n\n" + title)
+	}
+	if desc != "" {
+		res.WriteString("\n\n" + desc)
+	}
+	if version != "" {
+		res.WriteString("\n\nRequires version " + version + " or later.")
+	}
+	return res.String()
+}
+
+var reProp = regexp.MustCompile(`props\.([a-zA-Z0-9_]+)$`)
+
+func (s *Server) definition(params TextDocumentPositionParams) []Location {
+	res := []Location{}
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return res
+	}
+	offset := doc.offset(params.Position)
+
+	// references to other queries, e.g. in variants
+	for _, ref := range doc.refs {
+		if !contains(ref.rng, params.Position) {
+			continue
+		}
+		// prefer definitions in the same document
+		if rng, ok := doc.queries[ref.uid]; ok {
+			return append(res, Location{URI: doc.uri, Range: rng})
+		}
+		for uri, other := range s.docs {
+			if rng, ok := other.queries[ref.uid]; ok {
+				res = append(res, Location{URI: uri, Range: rng})
+			}
+		}
+		return res
+	}
+
+	// properties used in code
+	snippet, idx := doc.snippetAt(offset)
+	if snippet == nil {
+		return res
+	}
+	end := idx + len(reIdent.FindString(snippet.code[idx:]))
+	m := reProp.FindStringSubmatch(snippet.code[:end])
+	if m == nil {
+		return res
+	}
+	for _, prop := range snippet.props {
+		if prop.name == m[1] {
+			return append(res, Location{URI: doc.uri, Range: prop.rng})
+		}
+	}
+	return res
+}
+
+func contains(rng Range, pos Position) bool {
+	if pos.Line < rng.Start.Line || pos.Line > rng.End.Line {
+		return false
+	}
+	if pos.Line == rng.Start.Line && pos.Character < rng.Start.Character {
+		return false
+	}
+	if pos.Line == rng.End.Line && pos.Character > rng.End.Character {
+		return false
+	}
+	return true
+}
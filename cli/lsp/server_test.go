@@ -0,0 +1,231 @@
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/logger"
+	"go.mondoo.com/cnquery/resources/packs/os/info"
+)
+
+func init() {
+	logger.InitTestEnv()
+}
+
+const testBundle = `packs:
+  - uid: test-pack
+    name: Test
+    filters: asset.family.contains("unix")
+    queries:
+      - uid: home
+        props:
+          - uid: homeDir
+            mql: "'/root'"
+        mql: |
+          users.where(home == props.homeDir)
+            .length == 0
+      - uid: broken
+        mql: "users.list { nope }"
+      - uid: hotfixes
+        mql: windows.hotfixes.length
+      - uid: composed
+        variants:
+          - uid: home
+`
+
+type testMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *responseError  `json:"error"`
+}
+
+// runServer sends all requests to a server and returns all responses and
+// notifications it sent back, until it exits
+func runServer(t *testing.T, requests ...interface{}) []testMessage {
+	var in bytes.Buffer
+	for i := range requests {
+		data, err := json.Marshal(requests[i])
+		require.NoError(t, err)
+		in.WriteString("Content-Length: " + strconv.Itoa(len(data)) + "\r\n\r\n")
+		in.Write(data)
+	}
+
+	var out bytes.Buffer
+	server := New(info.Registry.Schema(), cnquery.Features{}, "test")
+	require.NoError(t, server.Serve(&in, &out))
+
+	var res []testMessage
+	c := newConn(&out, io.Discard)
+	for {
+		data, err := c.read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		var msg testMessage
+		require.NoError(t, json.Unmarshal(data, &msg))
+		res = append(res, msg)
+	}
+	return res
+}
+
+func req(id int, method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+func notify(method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+}
+
+func didOpen(uri string, text string) map[string]interface{} {
+	return notify("textDocument/didOpen", DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "yaml", Text: text},
+	})
+}
+
+func at(uri string, line int, char int) TextDocumentPositionParams {
+	return TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: line, Character: char},
+	}
+}
+
+func resultOf(t *testing.T, messages []testMessage, id int, v interface{}) {
+	for i := range messages {
+		if string(messages[i].ID) == strconv.Itoa(id) {
+			require.Nil(t, messages[i].Error)
+			require.NoError(t, json.Unmarshal(messages[i].Result, v))
+			return
+		}
+	}
+	t.Fatalf("no response for request %d", id)
+}
+
+func TestDocument_Snippets(t *testing.T) {
+	doc := newDocument("file:///pack.mql.yaml", testBundle)
+	require.Nil(t, doc.err)
+
+	// every char of the code must be mapped to the same char in the document
+	for _, s := range doc.snippets {
+		for i := range s.code {
+			if s.code[i] == '\n' || s.code[i] == ' ' {
+				continue
+			}
+			assert.Equal(t, string(s.code[i]), string(doc.text[s.offsets[i]]), "char %d of %q", i, s.code)
+		}
+	}
+
+	kinds := []string{}
+	for _, s := range doc.snippets {
+		kinds = append(kinds, s.kind)
+	}
+	assert.Equal(t, []string{"filter", "property", "query", "query", "query"}, kinds)
+
+	assert.Contains(t, doc.queries, "home")
+	assert.Contains(t, doc.queries, "composed")
+	require.Len(t, doc.refs, 1)
+	assert.Equal(t, "home", doc.refs[0].uid)
+
+	pos := Position{Line: 11, Character: 10}
+	assert.Equal(t, pos, doc.position(doc.offset(pos)))
+}
+
+func TestDocument_Unicode(t *testing.T) {
+	text := "queries:\n  - {title: \"🚀 launch\", uid: rocket, mql: \"true\"}\n"
+	doc := newDocument("file:///pack.mql.yaml", text)
+	require.Nil(t, doc.err)
+
+	// the rocket is one rune in YAML columns, but two UTF-16 code units
+	offset := strings.Index(text, "rocket")
+	assert.Equal(t, Range{
+		Start: Position{Line: 1, Character: 30},
+		End:   Position{Line: 1, Character: 36},
+	}, doc.queries["rocket"])
+	assert.Equal(t, offset, doc.offset(doc.queries["rocket"].Start))
+}
+
+func TestServer(t *testing.T) {
+	uri := "file:///pack.mql.yaml"
+	messages := runServer(t,
+		req(1, "initialize", map[string]interface{}{}),
+		notify("initialized", map[string]interface{}{}),
+		didOpen(uri, testBundle),
+		req(2, "textDocument/completion", at(uri, 10, 16)),
+		req(3, "textDocument/hover", at(uri, 10, 12)),
+		req(4, "textDocument/definition", at(uri, 10, 38)),
+		req(5, "textDocument/definition", at(uri, 18, 18)),
+		req(6, "unknown/method", nil),
+		req(7, "shutdown", nil),
+		notify("exit", nil),
+	)
+
+	var init InitializeResult
+	resultOf(t, messages, 1, &init)
+	assert.True(t, init.Capabilities.HoverProvider)
+
+	t.Run("diagnostics", func(t *testing.T) {
+		var params PublishDiagnosticsParams
+		for i := range messages {
+			if messages[i].Method == "textDocument/publishDiagnostics" {
+				require.NoError(t, json.Unmarshal(messages[i].Params, &params))
+			}
+		}
+		assert.Equal(t, uri, params.URI)
+
+		res := map[string]Diagnostic{}
+		for _, d := range params.Diagnostics {
+			res[d.Code] = d
+		}
+		assert.Equal(t, Range{Start: Position{Line: 10, Character: 10}, End: Position{Line: 10, Character: 15}}, res["prefer-none"].Range)
+		assert.Equal(t, DiagnosticSeverityError, res["compile"].Severity)
+		assert.Equal(t, Range{Start: Position{Line: 13, Character: 27}, End: Position{Line: 13, Character: 31}}, res["compile"].Range)
+		assert.Equal(t, 15, res["platform-availability"].Range.Start.Line)
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		var list CompletionList
+		resultOf(t, messages, 2, &list)
+		labels := []string{}
+		for _, item := range list.Items {
+			labels = append(labels, item.Label)
+		}
+		assert.Contains(t, labels, "where")
+	})
+
+	t.Run("hover", func(t *testing.T) {
+		var hover Hover
+		resultOf(t, messages, 3, &hover)
+		assert.True(t, strings.HasPrefix(hover.Contents.Value, "**users** resource"), hover.Contents.Value)
+		assert.Equal(t, Position{Line: 10, Character: 10}, hover.Range.Start)
+	})
+
+	t.Run("definition of props", func(t *testing.T) {
+		var locations []Location
+		resultOf(t, messages, 4, &locations)
+		require.Len(t, locations, 1)
+		assert.Equal(t, Position{Line: 7, Character: 17}, locations[0].Range.Start)
+	})
+
+	t.Run("definition of queries", func(t *testing.T) {
+		var locations []Location
+		resultOf(t, messages, 5, &locations)
+		require.Len(t, locations, 1)
+		assert.Equal(t, Position{Line: 5, Character: 13}, locations[0].Range.Start)
+	})
+
+	for i := range messages {
+		if string(messages[i].ID) == "6" {
+			require.NotNil(t, messages[i].Error)
+			assert.Equal(t, codeMethodNotFound, messages[i].Error.Code)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package printer
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -398,12 +399,21 @@ func (print *Printer) dict(typ types.Type, raw interface{}, codeID string, bundl
 }
 
 func (print *Printer) intMap(typ types.Type, data map[int]interface{}, codeID string, bundle *llx.CodeBundle, indent string) string {
+	if len(data) == 0 {
+		return "{}"
+	}
+
 	var res strings.Builder
 	res.WriteString("{\n")
 
-	for i := range data {
-		value := data[i]
-		res.WriteString(fmt.Sprintf(indent+"  %d: "+print.Secondary("%#v")+"\n", i, value))
+	keys := make([]int, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	for _, k := range keys {
+		val := print.Data(typ.Child(), data[k], strconv.Itoa(k), bundle, indent+"  ")
+		res.WriteString(fmt.Sprintf(indent+"  %d: %s\n", k, val))
 	}
 
 	res.WriteString(indent + "}")
@@ -555,6 +565,11 @@ func (print *Printer) Data(typ types.Type, data interface{}, codeID string, bund
 			return print.Secondary("null")
 		}
 		return print.Secondary(fmt.Sprintf("/%s/", data))
+	case types.IP, types.CIDR:
+		if data == nil {
+			return print.Secondary("null")
+		}
+		return print.Secondary(data.(string))
 	case types.Time:
 		if data == nil {
 			return print.Secondary("null")
@@ -594,6 +609,16 @@ func (print *Printer) Data(typ types.Type, data interface{}, codeID string, bund
 		}
 
 		return print.Secondary(res.String())
+	case types.Version:
+		if data == nil {
+			return print.Secondary("null")
+		}
+		return print.Secondary(data.(llx.Version).String())
+	case types.Duration:
+		if data == nil {
+			return print.Secondary("null")
+		}
+		return print.Secondary(data.(llx.Duration).String())
 	case types.Dict:
 		return print.dict(typ, data, codeID, bundle, indent)
 
@@ -213,6 +213,18 @@ func TestPrinter(t *testing.T) {
 					"]",
 			},
 		},
+		{
+			"users.countBy(gid)",
+			"", // ignore
+			[]string{
+				"users.list.countBy: {\n" +
+					"  0: 1\n" +
+					"  1: 1\n" +
+					"  1001: 2\n" +
+					"  1003: 1\n" +
+					"}",
+			},
+		},
 	})
 }
 
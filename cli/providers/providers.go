@@ -194,6 +194,11 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 			Type: plugin.FlagType_String,
 			Desc: "Use a recording for caching or create a new recording (with --record)",
 		},
+		{
+			Long: "cache",
+			Type: plugin.FlagType_Bool,
+			Desc: "Cache resource fields on disk and reuse them in later runs against the same asset",
+		},
 	}
 
 	allFlags := append(connector.Flags, builtinFlags...)
@@ -249,6 +254,10 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 		if err != nil {
 			log.Warn().Msg("failed to get flag --record")
 		}
+		doCache, err := cc.Flags().GetBool("cache")
+		if err != nil {
+			log.Warn().Msg("failed to get flag --cache")
+		}
 
 		// the following flags are not processed by the provider; we handle them
 		// here instead
@@ -256,6 +265,7 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 			"ask-pass":  {},
 			"record":    {},
 			"recording": {},
+			"cache":     {},
 		}
 
 		flagVals := map[string]*llx.Primitive{}
@@ -302,6 +312,14 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 			log.Fatal().Msg(err.Error())
 		}
 
+		if doCache {
+			path, err := providers.DefaultResourceCachePath()
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to find resource cache")
+			}
+			runtime.Cache = providers.NewResourceCache(path)
+		}
+
 		cliRes, err := runtime.Provider.Instance.Plugin.ParseCLI(&proto.ParseCLIReq{
 			Connector: connector.Name,
 			Args:      args,
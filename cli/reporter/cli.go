@@ -5,6 +5,7 @@ import (
 	"io"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/muesli/termenv"
 	"go.mondoo.com/cnquery/explorer"
@@ -82,9 +83,37 @@ func (r *cliReporter) printAssetSummary(assetMrn string, asset *explorer.Asset)
 	}
 
 	r.out.Write([]byte(fmt.Sprintf("Datapoints: %d\n", len(report.Data))))
+	if timeouts := timedOutFields(report.RawResults()); len(timeouts) != 0 {
+		r.out.Write([]byte(r.Printer.Warn(fmt.Sprintf("Timeouts:   %d (%s)", len(timeouts), strings.Join(timeouts, ", "))) + "\n"))
+	}
 	r.out.Write([]byte("\n"))
 }
 
+// timedOutFields returns the sorted list of fields whose results timed out.
+// Queries that timed out as a whole are listed as "query".
+func timedOutFields(results map[string]*llx.RawResult) []string {
+	fields := map[string]struct{}{}
+	for _, res := range results {
+		if res == nil {
+			continue
+		}
+		if timeout, ok := res.Data.IsTimeout(); ok {
+			field := timeout.Field
+			if field == "" {
+				field = "query"
+			}
+			fields[field] = struct{}{}
+		}
+	}
+
+	res := make([]string, 0, len(fields))
+	for field := range fields {
+		res = append(res, field)
+	}
+	sort.Strings(res)
+	return res
+}
+
 func (r *cliReporter) printQueryData() {
 	r.out.Write([]byte(r.Printer.H1("Data (" + strconv.Itoa(len(r.data.Assets)) + " assets)")))
 
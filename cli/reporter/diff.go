@@ -0,0 +1,161 @@
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"go.mondoo.com/cnquery/cli/theme/colors"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+)
+
+// DiffFormats that are supported for report diffs
+var DiffFormats = map[string]Format{
+	"":     Full,
+	"text": Full,
+	"json": JSON,
+}
+
+// PrintDiff writes the changes between two report collections to out,
+// either as text or as JSON
+func PrintDiff(diff *explorer.ReportDiff, format Format, theme *colors.Theme, out io.Writer) error {
+	if format == JSON {
+		raw, err := json.MarshalIndent(diffToJSON(diff), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(append(raw, '\n'))
+		return err
+	}
+
+	if diff.IsEmpty() {
+		_, err := io.WriteString(out, "No changes\n")
+		return err
+	}
+
+	var res strings.Builder
+	for _, asset := range diff.Assets {
+		name := asset.Name
+		if name == "" {
+			name = asset.Mrn
+		}
+		res.WriteString(diffLine(theme, asset.Kind, "Target: "+name) + "\n")
+
+		for _, result := range asset.Results {
+			title := result.Query
+			if title == "" {
+				title = result.CodeID
+			}
+			if result.Label != "" && result.Label != title {
+				title += " (" + result.Label + ")"
+			}
+			res.WriteString("  " + diffLine(theme, result.Kind, title) + "\n")
+
+			if result.OldError != result.NewError {
+				if result.OldError != "" {
+					res.WriteString("    " + diffLine(theme, llx.DeltaRemoved, "error: "+result.OldError) + "\n")
+				}
+				if result.NewError != "" {
+					res.WriteString("    " + diffLine(theme, llx.DeltaAdded, "error: "+result.NewError) + "\n")
+				}
+			}
+
+			for _, delta := range result.Deltas {
+				res.WriteString("    " + diffLine(theme, delta.Kind, formatDelta(delta, asset.Labels())) + "\n")
+			}
+		}
+		res.WriteString("\n")
+	}
+
+	_, err := io.WriteString(out, res.String())
+	return err
+}
+
+func diffLine(theme *colors.Theme, kind llx.DeltaKind, text string) string {
+	switch kind {
+	case llx.DeltaAdded:
+		return termenv.String("+ " + text).Foreground(theme.Success).String()
+	case llx.DeltaRemoved:
+		return termenv.String("- " + text).Foreground(theme.Error).String()
+	default:
+		return termenv.String("~ " + text).Foreground(theme.Medium).String()
+	}
+}
+
+func formatDelta(delta *llx.Delta, labels map[string]string) string {
+	prefix := ""
+	if delta.Path != "" {
+		prefix = delta.Path + ": "
+	}
+
+	switch delta.Kind {
+	case llx.DeltaAdded:
+		return prefix + llx.FormatPrimitive(delta.New, labels)
+	case llx.DeltaRemoved:
+		return prefix + llx.FormatPrimitive(delta.Old, labels)
+	default:
+		return prefix + llx.FormatPrimitive(delta.Old, labels) + " -> " + llx.FormatPrimitive(delta.New, labels)
+	}
+}
+
+type jsonAssetDiff struct {
+	Mrn     string           `json:"mrn"`
+	Name    string           `json:"name,omitempty"`
+	Kind    llx.DeltaKind    `json:"kind"`
+	Results []jsonResultDiff `json:"results,omitempty"`
+}
+
+type jsonResultDiff struct {
+	CodeID   string        `json:"code_id"`
+	Query    string        `json:"query,omitempty"`
+	Label    string        `json:"label,omitempty"`
+	Kind     llx.DeltaKind `json:"kind"`
+	OldError string        `json:"old_error,omitempty"`
+	NewError string        `json:"new_error,omitempty"`
+	Deltas   []jsonDelta   `json:"deltas,omitempty"`
+}
+
+type jsonDelta struct {
+	Kind llx.DeltaKind `json:"kind"`
+	Path string        `json:"path,omitempty"`
+	Old  *string       `json:"old,omitempty"`
+	New  *string       `json:"new,omitempty"`
+}
+
+func diffToJSON(diff *explorer.ReportDiff) []jsonAssetDiff {
+	res := make([]jsonAssetDiff, len(diff.Assets))
+	for i, asset := range diff.Assets {
+		res[i] = jsonAssetDiff{
+			Mrn:  asset.Mrn,
+			Name: asset.Name,
+			Kind: asset.Kind,
+		}
+
+		for _, result := range asset.Results {
+			cur := jsonResultDiff{
+				CodeID:   result.CodeID,
+				Query:    result.Query,
+				Label:    result.Label,
+				Kind:     result.Kind,
+				OldError: result.OldError,
+				NewError: result.NewError,
+			}
+			for _, delta := range result.Deltas {
+				d := jsonDelta{Kind: delta.Kind, Path: delta.Path}
+				if delta.Old != nil {
+					old := llx.FormatPrimitive(delta.Old, asset.Labels())
+					d.Old = &old
+				}
+				if delta.New != nil {
+					new := llx.FormatPrimitive(delta.New, asset.Labels())
+					d.New = &new
+				}
+				cur.Deltas = append(cur.Deltas, d)
+			}
+			res[i].Results = append(res[i].Results, cur)
+		}
+	}
+	return res
+}
@@ -69,13 +69,18 @@ type simpleTest struct {
 	expected string
 }
 
-func runSimpleTests(t *testing.T, tests []simpleTest) {
+// runSimpleTests writes all results into one shared output, unless
+// isolated is set, in which case every result gets its own output
+func runSimpleTests(t *testing.T, tests []simpleTest, isolated bool) {
 	var out strings.Builder
 	w := shared.IOWriter{Writer: &out}
 
 	for i := range tests {
 		cur := tests[i]
 		t.Run(cur.code, func(t *testing.T) {
+			if isolated {
+				out.Reset()
+			}
 			bundle, results := testQuery(t, cur.code)
 			err := BundleResultsToJSON(bundle, results, &w)
 			require.NoError(t, err)
@@ -90,5 +95,14 @@ func TestJsonReporter(t *testing.T) {
 			"users.where(uid==0)",
 			`{"users.where.list":[{"gid":0,"name":"root","uid":0}]}`,
 		},
-	})
+	}, false)
+}
+
+func TestJsonReporterCountBy(t *testing.T) {
+	runSimpleTests(t, []simpleTest{
+		{
+			"users.countBy(gid)",
+			`{"users.list.countBy":{"0":1,"1":1,"1001":2,"1003":1}}`,
+		},
+	}, true)
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"go.mondoo.com/cnquery/logger"
+	"google.golang.org/protobuf/encoding/protojson"
 	"sigs.k8s.io/yaml"
 
 	"go.mondoo.com/cnquery/cli/printer"
@@ -26,6 +27,7 @@ const (
 	JSON
 	JUnit
 	CSV
+	Report
 )
 
 // Formats that are supported by the reporter
@@ -38,6 +40,7 @@ var Formats = map[string]Format{
 	"yml":     YAML,
 	"json":    JSON,
 	"csv":     CSV,
+	"report":  Report,
 }
 
 func AllFormats() string {
@@ -109,6 +112,14 @@ func (r *Reporter) Print(data *explorer.ReportCollection, out io.Writer) error {
 	case CSV:
 		w := shared.IOWriter{Writer: out}
 		return ReportCollectionToCSV(data, &w)
+	case Report:
+		// the complete report collection, which can be read again, e.g. to diff it
+		raw, err := protojson.MarshalOptions{Indent: "  "}.Marshal(data)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(append(raw, '\n'))
+		return err
 	case YAML:
 		raw := bytes.Buffer{}
 		writer := shared.IOWriter{Writer: &raw}
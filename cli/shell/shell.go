@@ -1,6 +1,7 @@
 package shell
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -38,7 +39,11 @@ func WithOnCloseListener(onCloseHandler func()) ShellOption {
 
 func WithUpstreamConfig(c *providers.UpstreamConfig) ShellOption {
 	return func(t *Shell) {
-		if x, ok := t.Runtime.(*providers.Runtime); ok {
+		runtime := t.Runtime
+		if x, ok := runtime.(*llx.Scheduler); ok {
+			runtime = x.Runtime
+		}
+		if x, ok := runtime.(*providers.Runtime); ok {
 			x.UpstreamConfig = c
 		}
 	}
@@ -78,6 +83,8 @@ type Shell struct {
 	query           string
 	isMultiline     bool
 	multilineIndent int
+	// isExplain is set while the query is explained instead of run
+	isExplain bool
 }
 
 // New creates a new Shell
@@ -202,7 +209,10 @@ func (s *Shell) RunInteractive(cmd string) {
 	s.handleExit()
 }
 
-var helpResource = regexp.MustCompile(`help\s(.*)`)
+var (
+	helpResource = regexp.MustCompile(`help\s(.*)`)
+	explainQuery = regexp.MustCompile(`(?s)^explain\s+(.*)`)
+)
 
 func (s *Shell) ExecCmd(cmd string) {
 	switch {
@@ -229,6 +239,11 @@ func (s *Shell) ExecCmd(cmd string) {
 	case helpResource.MatchString(cmd):
 		s.listFilteredResources(cmd)
 		return
+	case explainQuery.MatchString(cmd):
+		// explained queries can span multiple lines just like others
+		s.isExplain = true
+		s.execQuery(explainQuery.FindStringSubmatch(cmd)[1])
+		return
 	default:
 		s.execQuery(cmd)
 	}
@@ -237,16 +252,12 @@ func (s *Shell) ExecCmd(cmd string) {
 func (s *Shell) execQuery(cmd string) {
 	s.query += " " + cmd
 
-	// Note: we could optimize the call structure here, since compile
-	// will end up being called twice. However, since we are talking about
-	// the shell and we only deal with one query at a time, with the
-	// compiler being rather fast, the additional time is negligible
-	// and may not be worth coding around.
-	code, err := mqlc.Compile(s.query, nil, mqlc.NewConfig(s.Runtime.Schema(), s.features))
+	code, diagnostics, err := mqlc.CompileWithLint(s.query, nil, mqlc.NewConfig(s.Runtime.Schema(), s.features), mqlc.LintOptions{})
 	if err != nil {
-		if e, ok := err.(*parser.ErrIncomplete); ok {
+		var incomplete *parser.ErrIncomplete
+		if errors.As(err, &incomplete) {
 			s.isMultiline = true
-			s.multilineIndent = e.Indent
+			s.multilineIndent = incomplete.Indent
 			return
 		}
 	}
@@ -258,20 +269,49 @@ func (s *Shell) execQuery(cmd string) {
 		cleanCommand = code.Source
 	}
 
+	if s.isExplain {
+		cleanCommand = "explain " + cleanCommand
+	}
 	if len(s.History) == 0 || s.History[len(s.History)-1] != cleanCommand {
 		s.History = append(s.History, cleanCommand)
 	}
 
-	code, res, err := s.RunOnce(s.query)
-	// we can safely ignore err != nil, since RunOnce handles most of the printing we need
-	if err == nil {
-		s.PrintResults(code, res)
+	switch {
+	case s.isExplain && err != nil:
+		s.printCompileError(code, err)
+	case s.isExplain:
+		fmt.Fprint(s.out, mqlc.Explain(code, s.Runtime.Schema()).String())
+	default:
+		s.printDiagnostics(cleanCommand, diagnostics)
+
+		res, err := s.runCode(code, err)
+		// we can safely ignore err != nil, since runCode handles most of the printing we need
+		if err == nil {
+			s.PrintResults(code, res)
+		}
 	}
 
 	s.isMultiline = false
+	s.isExplain = false
 	s.query = ""
 }
 
+// printDiagnostics prints lint warnings for a query that compiles. Errors
+// are left to the regular compile step.
+func (s *Shell) printDiagnostics(query string, diagnostics []*mqlc.Diagnostic) {
+	for i := range diagnostics {
+		d := diagnostics[i]
+		if d.Severity == mqlc.SeverityError {
+			continue
+		}
+		msg := d.String()
+		if d.Fix != nil {
+			msg += "\n  fix: " + d.Fix.Apply(query)
+		}
+		fmt.Fprintln(s.out, s.Theme.Secondary(msg))
+	}
+}
+
 func (s *Shell) changeLivePrefix() (string, bool) {
 	if s.isMultiline {
 		indent := strings.Repeat(" ", s.multilineIndent*2)
@@ -309,24 +349,54 @@ func (s *Shell) Close() {
 
 // RunOnce executes the query and returns
 func (s *Shell) RunOnce(cmd string) (*llx.CodeBundle, map[string]*llx.RawResult, error) {
-	s.resetPrintCache()
-
 	code, err := mqlc.Compile(cmd, nil, mqlc.NewConfig(s.Runtime.Schema(), s.features))
+	results, err := s.runCode(code, err)
 	if err != nil {
-		fmt.Fprintln(s.out, s.Theme.Error("failed to compile: "+err.Error()))
-
-		if code != nil && code.Suggestions != nil {
-			fmt.Fprintln(s.out, formatSuggestions(code.Suggestions, s.Theme))
-		}
 		return nil, nil, err
 	}
+	return code, results, nil
+}
+
+// runCode executes compiled code, or prints the error it failed to compile with
+func (s *Shell) runCode(code *llx.CodeBundle, compileErr error) (map[string]*llx.RawResult, error) {
+	s.resetPrintCache()
+
+	if compileErr != nil {
+		s.printCompileError(code, compileErr)
+		return nil, compileErr
+	}
 
 	results, err := mql.ExecuteCode(s.Runtime.Schema(), s.Runtime, code, nil, s.features)
 	if err != nil {
 		panic(err)
 	}
 
-	return code, results, err
+	return results, err
+}
+
+// Explain how the query will be executed, without running it
+func (s *Shell) Explain(cmd string) (*mqlc.Plan, error) {
+	code, err := mqlc.Compile(cmd, nil, mqlc.NewConfig(s.Runtime.Schema(), s.features))
+	if err != nil {
+		s.printCompileError(code, err)
+		return nil, err
+	}
+	return mqlc.Explain(code, s.Runtime.Schema()), nil
+}
+
+func (s *Shell) printCompileError(code *llx.CodeBundle, err error) {
+	fmt.Fprintln(s.out, s.Theme.Error("failed to compile: "+err.Error()))
+
+	var compileErr *mqlc.CompileError
+	if errors.As(err, &compileErr) {
+		if excerpt := compileErr.Excerpt(); excerpt != "" {
+			fmt.Fprintln(s.out, s.Theme.Secondary(excerpt))
+		}
+	}
+
+	if code != nil && code.Suggestions != nil {
+		fmt.Fprintln(s.out, formatSuggestions(code.Suggestions, s.Theme))
+	}
 }
 
 func (s *Shell) PrintResults(code *llx.CodeBundle, results map[string]*llx.RawResult) {
@@ -2,7 +2,9 @@ package explorer
 
 import (
 	"context"
+	"io"
 	"io/fs"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/segmentio/ksuid"
+	"go.mondoo.com/cnquery"
 	"go.mondoo.com/cnquery/checksums"
 	"go.mondoo.com/cnquery/mrn"
 	"sigs.k8s.io/yaml"
@@ -41,8 +44,15 @@ func NewBundleMap(ownerMrn string) *BundleMap {
 }
 
 // BundleFromPaths loads a single bundle file or a bundle that
-// was split into multiple files into a single Bundle struct
+// was split into multiple files into a single Bundle struct.
+// Files that are signed must match their signature.
 func BundleFromPaths(paths ...string) (*Bundle, error) {
+	return BundleFromPathsWithPolicy(nil, paths...)
+}
+
+// BundleFromPathsWithPolicy loads bundle files like BundleFromPaths and
+// checks every file against the signature policy
+func BundleFromPathsWithPolicy(policy *SignaturePolicy, paths ...string) (*Bundle, error) {
 	// load all the source files
 	resolvedFilenames, err := walkBundleFiles(paths)
 	if err != nil {
@@ -51,7 +61,7 @@ func BundleFromPaths(paths ...string) (*Bundle, error) {
 	}
 
 	// aggregate all files into a single bundle
-	aggregatedBundle, err := aggregateFilesToBundle(resolvedFilenames)
+	aggregatedBundle, err := aggregateFilesToBundle(resolvedFilenames, policy)
 	if err != nil {
 		log.Error().Err(err).Msg("could merge bundle files")
 		return nil, err
@@ -99,13 +109,13 @@ func walkBundleFiles(filenames []string) ([]string, error) {
 
 // aggregateFilesToBundle iterates over all provided files and loads its content.
 // It assumes that all provided files are checked upfront and are not a directory
-func aggregateFilesToBundle(paths []string) (*Bundle, error) {
+func aggregateFilesToBundle(paths []string, policy *SignaturePolicy) (*Bundle, error) {
 	// iterate over all files, load them and merge them
 	mergedBundle := &Bundle{}
 
 	for i := range paths {
 		path := paths[i]
-		bundle, err := bundleFromSingleFile(path)
+		bundle, err := bundleFromSingleFile(path, policy)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not load file: "+path)
 		}
@@ -124,16 +134,71 @@ func combineBundles(into *Bundle, other *Bundle) {
 
 	into.Packs = append(into.Packs, other.Packs...)
 	into.Queries = append(into.Queries, other.Queries...)
+	into.Functions = append(into.Functions, other.Functions...)
 }
 
-// bundleFromSingleFile loads a bundle from a single file
-func bundleFromSingleFile(path string) (*Bundle, error) {
+// bundleFromSingleFile loads a bundle from a single file and checks it
+// against its signature
+func bundleFromSingleFile(path string, policy *SignaturePolicy) (*Bundle, error) {
 	bundleData, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	return BundleFromYAML(bundleData)
+	res, err := BundleFromYAML(bundleData)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := LoadBundleSignature(SignatureFilePath(path))
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Check(res, sig, path); err != nil {
+		return nil, err
+	}
+	res.makeImportPathsAbsolute(filepath.Dir(path))
+	return res, nil
+}
+
+// FetchBundle downloads a bundle and checks it against its signature,
+// which is fetched from next to it, and the signature policy
+func FetchBundle(client *http.Client, bundleUrl string, policy *SignaturePolicy) (*Bundle, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequest("GET", bundleUrl, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set up request to fetch bundle")
+	}
+	req.Header.Set("User-Agent", "cnquery/"+cnquery.Version)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("failed to fetch bundle from " + bundleUrl + ": " + resp.Status)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	res, err := BundleFromYAML(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := FetchBundleSignature(client, bundleUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Check(res, sig, bundleUrl); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // BundleFromYAML create a bundle from yaml contents
@@ -206,6 +271,7 @@ func (p *Bundle) AddBundle(other *Bundle) error {
 
 // Compile a bundle
 // Does a few things:
+// 0. resolves the imports of all packs
 // 1. turns it into a map for easier access
 // 2. compile all queries and validates them
 // 3. validation of all contents
@@ -219,9 +285,14 @@ func (p *Bundle) Compile(ctx context.Context) (*BundleMap, error) {
 		ownerMrn = "//local.cnquery.io/run/local-execution"
 	}
 
+	if err := p.ResolveImports(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to resolve imports")
+	}
+
 	cache := &bundleCache{
 		ownerMrn:    ownerMrn,
 		bundle:      p,
+		code:        GetCodeCache(ctx),
 		uid2mrn:     map[string]string{},
 		lookupProp:  map[string]PropertyRef{},
 		lookupQuery: map[string]*Mquery{},
@@ -246,11 +317,15 @@ func (p *Bundle) Compile(ctx context.Context) (*BundleMap, error) {
 			return nil, errors.New("failed to refresh query pack " + pack.Mrn + ": " + err.Error())
 		}
 
-		if err = pack.Filters.Compile(ownerMrn); err != nil {
+		if err = pack.Filters.compile(ownerMrn, cache.code); err != nil {
 			return nil, errors.Wrap(err, "failed to compile querypack filters")
 		}
 		pack.ComputedFilters.AddFilters(pack.Filters)
 
+		// functions of the bundle are available to all its packs; we store
+		// them in the pack so they are still around when it is executed
+		pack.Functions = mergeFunctions(pack.Functions, p.Functions)
+
 		if err := cache.compileQueries(pack.Queries, pack); err != nil {
 			return nil, err
 		}
@@ -259,7 +334,7 @@ func (p *Bundle) Compile(ctx context.Context) (*BundleMap, error) {
 			group := pack.Groups[i]
 
 			// When filters are initially added they haven't been compiled
-			if err = group.Filters.Compile(ownerMrn); err != nil {
+			if err = group.Filters.compile(ownerMrn, cache.code); err != nil {
 				return nil, errors.Wrap(err, "failed to compile querypack filters")
 			}
 			pack.ComputedFilters.AddFilters(group.Filters)
@@ -280,6 +355,8 @@ type bundleCache struct {
 	uid2mrn     map[string]string
 	bundle      *Bundle
 	errors      []error
+	// code is the optional cache of compiled queries
+	code *CodeCache
 }
 
 type PropertyRef struct {
@@ -296,12 +373,7 @@ func (c *bundleCache) error() error {
 		return nil
 	}
 
-	var msg strings.Builder
-	for i := range c.errors {
-		msg.WriteString(c.errors[i].Error())
-		msg.WriteString("\n")
-	}
-	return errors.New(msg.String())
+	return &BundleError{Errors: c.errors}
 }
 
 func (c *bundleCache) compileQueries(queries []*Mquery, pack *QueryPack) error {
@@ -317,8 +389,13 @@ func (c *bundleCache) compileQueries(queries []*Mquery, pack *QueryPack) error {
 		return c.error()
 	}
 
+	functions := c.bundle.Functions
+	if pack != nil {
+		functions = pack.Functions
+	}
+
 	for i := range queries {
-		c.compileQuery(queries[i])
+		c.compileQuery(queries[i], functions)
 	}
 
 	// The second pass on errors is done after we have compiled as much as possible.
@@ -348,6 +425,11 @@ func (c *bundleCache) precompileQuery(query *Mquery, pack *QueryPack) {
 		c.uid2mrn[uid] = query.Mrn
 	}
 
+	if _, err := query.TimeoutDuration(); err != nil {
+		c.errors = append(c.errors, errors.New("failed to parse timeout for query "+query.Mrn+": "+err.Error()))
+		return
+	}
+
 	// the pack is only nil if we are dealing with shared queries
 	if pack == nil {
 		c.lookupQuery[query.Mrn] = query
@@ -369,7 +451,7 @@ func (c *bundleCache) precompileQuery(query *Mquery, pack *QueryPack) {
 	}
 
 	// filters have no dependencies, so we can compile them early
-	if err := query.Filters.Compile(c.ownerMrn); err != nil {
+	if err := query.Filters.compile(c.ownerMrn, c.code); err != nil {
 		c.errors = append(c.errors, errors.New("failed to compile filters for query "+query.Mrn))
 		return
 	}
@@ -399,10 +481,10 @@ func (c *bundleCache) precompileQuery(query *Mquery, pack *QueryPack) {
 // Note: you only want to run this, after you are sure that all connected
 // dependencies have been processed. Properties must be compiled. Connected
 // queries may not be ready yet, but we have to have precompiled them.
-func (c *bundleCache) compileQuery(query *Mquery) {
-	_, err := query.RefreshChecksumAndType(c.lookupQuery, c.lookupProp)
+func (c *bundleCache) compileQuery(query *Mquery, functions []*Function) {
+	_, err := query.refreshChecksumAndType(c.lookupQuery, c.lookupProp, functions, c.code)
 	if err != nil {
-		c.errors = append(c.errors, errors.Wrap(err, "failed to validate query '"+query.Mrn+"'"))
+		c.errors = append(c.errors, &QueryError{Mrn: query.Mrn, Err: err})
 	}
 }
 
@@ -429,7 +511,7 @@ func (c *bundleCache) compileProp(prop *Property) error {
 		name = m.Basename()
 	}
 
-	if _, err := prop.RefreshChecksumAndType(); err != nil {
+	if _, err := prop.refreshChecksumAndType(c.code); err != nil {
 		return err
 	}
 
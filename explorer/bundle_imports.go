@@ -0,0 +1,544 @@
+package explorer
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
+)
+
+// BundleLock pins the imports of a bundle to the content they had when they
+// were resolved, so that later runs notice if an import changed
+type BundleLock struct {
+	Imports []*LockedImport `json:"imports"`
+}
+
+// LockedImport is the resolved content of one import
+type LockedImport struct {
+	// Source is the path, URL, or MRN of the import. Paths are relative to
+	// the lock file.
+	Source string `json:"source"`
+	// Version of the imported packs
+	Version string `json:"version,omitempty"`
+	// Checksum of the imported bundle
+	Checksum string `json:"checksum"`
+}
+
+// LockFilePath is where the lock file of a bundle is stored: next to a
+// bundle file, or inside a bundle directory
+func LockFilePath(bundlePath string) string {
+	if fi, err := os.Stat(bundlePath); err == nil && fi.IsDir() {
+		return filepath.Join(bundlePath, "querypacks.lock")
+	}
+	ext := filepath.Ext(bundlePath)
+	if ext == ".yaml" || ext == ".yml" {
+		bundlePath = strings.TrimSuffix(bundlePath, ext)
+	}
+	return bundlePath + ".lock"
+}
+
+// LoadBundleLock reads a lock file. It returns an empty lock if the file
+// doesn't exist.
+func LoadBundleLock(path string) (*BundleLock, error) {
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &BundleLock{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &BundleLock{}
+	if err := yaml.Unmarshal(raw, res); err != nil {
+		return nil, errors.Wrap(err, "failed to read lock file "+path)
+	}
+	return res, nil
+}
+
+// Save the lock file
+func (l *BundleLock) Save(path string) error {
+	raw, err := yaml.Marshal(l)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0o644)
+}
+
+func (l *BundleLock) get(source string) *LockedImport {
+	if l == nil {
+		return nil
+	}
+	for i := range l.Imports {
+		if l.Imports[i].Source == source {
+			return l.Imports[i]
+		}
+	}
+	return nil
+}
+
+// ImportResolver fetches the bundles that packs import when a bundle is
+// compiled. Without a resolver in the context, imports are resolved from
+// paths and URLs without a lock.
+type ImportResolver struct {
+	// Lock pins imports to their checksums; imports that don't match it
+	// fail. It is optional.
+	Lock *BundleLock
+	// LockDir is the directory of the lock file, which paths in it are
+	// relative to
+	LockDir string
+	// Update accepts imports that don't match the lock, so it can be updated
+	Update bool
+	// Registry looks up imports by MRN. They fail if it is nil.
+	Registry QueryHub
+	// HttpClient fetches imports by URL; defaults to http.DefaultClient
+	HttpClient *http.Client
+	// Signatures decides which imports from paths and URLs can be used.
+	// Signed imports must match their signature even without it.
+	Signatures *SignaturePolicy
+
+	resolved map[string]*LockedImport
+}
+
+type importResolverContextID struct{}
+
+// WithImportResolver sets the resolver for imports of bundles compiled
+// with this context
+func WithImportResolver(ctx context.Context, resolver *ImportResolver) context.Context {
+	return context.WithValue(ctx, importResolverContextID{}, resolver)
+}
+
+// GetImportResolver from a given context. Returns nil if none is set.
+func GetImportResolver(ctx context.Context) *ImportResolver {
+	res, _ := ctx.Value(importResolverContextID{}).(*ImportResolver)
+	return res
+}
+
+// Resolved returns the lock of all imports this resolver has resolved so far
+func (r *ImportResolver) Resolved() *BundleLock {
+	res := &BundleLock{Imports: make([]*LockedImport, 0, len(r.resolved))}
+	for _, v := range r.resolved {
+		res.Imports = append(res.Imports, v)
+	}
+	sort.Slice(res.Imports, func(i, j int) bool {
+		return res.Imports[i].Source < res.Imports[j].Source
+	})
+	return res
+}
+
+// importedPack is a pack that was imported, to find conflicting versions
+type importedPack struct {
+	version  string
+	importer string
+}
+
+type importState struct {
+	resolver *ImportResolver
+	bundles  map[string]*Bundle
+	packs    map[string]importedPack
+	// merged are the imports whose content was added to the root bundle
+	merged map[string]struct{}
+}
+
+// ResolveImports adds the queries, properties, and functions that the packs
+// of this bundle import, including the imports of imports. Afterwards the
+// bundle has no imports anymore. The resolver is taken from the context.
+func (p *Bundle) ResolveImports(ctx context.Context) error {
+	if !p.hasImports() {
+		return nil
+	}
+
+	resolver := GetImportResolver(ctx)
+	if resolver == nil {
+		resolver = &ImportResolver{}
+	}
+	if resolver.resolved == nil {
+		resolver.resolved = map[string]*LockedImport{}
+	}
+
+	state := &importState{
+		resolver: resolver,
+		bundles:  map[string]*Bundle{},
+		packs:    map[string]importedPack{},
+		merged:   map[string]struct{}{},
+	}
+	return state.resolveBundle(ctx, p, p, nil)
+}
+
+func (p *Bundle) hasImports() bool {
+	for i := range p.Packs {
+		if len(p.Packs[i].Imports) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveBundle resolves all imports of the bundle's packs and adds their
+// content to root. The stack are the sources that are currently resolved,
+// to detect cycles.
+func (s *importState) resolveBundle(ctx context.Context, bundle *Bundle, root *Bundle, stack []string) error {
+	for i := range bundle.Packs {
+		pack := bundle.Packs[i]
+		for j := range pack.Imports {
+			if err := s.resolveImport(ctx, pack, pack.Imports[j], root, stack); err != nil {
+				return err
+			}
+		}
+		pack.Imports = nil
+	}
+	return nil
+}
+
+func (s *importState) resolveImport(ctx context.Context, pack *QueryPack, imp *QueryPackImport, root *Bundle, stack []string) error {
+	source, err := importSource(imp)
+	if err != nil {
+		return errors.Wrap(err, "invalid import in pack "+packID(pack))
+	}
+
+	for i := range stack {
+		if stack[i] == source {
+			return errors.New("import cycle: " + strings.Join(append(stack[i:], source), " -> "))
+		}
+	}
+
+	bundle, err := s.load(ctx, imp, source)
+	if err != nil {
+		return errors.Wrap(err, "failed to import "+source+" in pack "+packID(pack))
+	}
+
+	packs := bundle.Packs
+	if imp.Uid != "" {
+		packs = nil
+		for i := range bundle.Packs {
+			if packID(bundle.Packs[i]) == imp.Uid || bundle.Packs[i].Uid == imp.Uid {
+				packs = append(packs, bundle.Packs[i])
+			}
+		}
+		if len(packs) == 0 {
+			return errors.New("cannot find pack " + imp.Uid + " in " + source + ", which is imported by pack " + packID(pack))
+		}
+	}
+
+	if err := s.checkVersions(pack, imp, packs); err != nil {
+		return err
+	}
+
+	// imports of imports are added to the root as well
+	if err := s.resolveBundle(ctx, bundle, root, append(stack, source)); err != nil {
+		return err
+	}
+
+	if _, ok := s.merged[source+"#"+imp.Uid]; !ok {
+		s.merged[source+"#"+imp.Uid] = struct{}{}
+		mergeImport(root, bundle, packs)
+	}
+	pack.Props = mergeProps(pack.Props, packs)
+	return nil
+}
+
+// importSource identifies an import by its absolute path, URL, or MRN
+func importSource(imp *QueryPackImport) (string, error) {
+	var res []string
+	if imp.Path != "" {
+		abs, err := filepath.Abs(imp.Path)
+		if err != nil {
+			return "", err
+		}
+		res = append(res, abs)
+	}
+	if imp.Url != "" {
+		res = append(res, imp.Url)
+	}
+	if imp.Mrn != "" {
+		res = append(res, imp.Mrn)
+	}
+
+	if len(res) != 1 {
+		return "", errors.New("imports need exactly one of path, url, or mrn")
+	}
+	return res[0], nil
+}
+
+// packID identifies a pack by its MRN or its UID
+func packID(pack *QueryPack) string {
+	if pack.Mrn != "" {
+		return pack.Mrn
+	}
+	return pack.Uid
+}
+
+// load the bundle of an import and check it against the lock
+func (s *importState) load(ctx context.Context, imp *QueryPackImport, source string) (*Bundle, error) {
+	if res, ok := s.bundles[source]; ok {
+		return res, nil
+	}
+
+	var res *Bundle
+	var err error
+	switch {
+	case imp.Path != "":
+		res, err = bundleFromSingleFile(source, s.resolver.Signatures)
+	case imp.Url != "":
+		res, err = s.fetch(source)
+	default:
+		if s.resolver.Registry == nil {
+			return nil, errors.New("no registry to import packs by MRN from")
+		}
+		res, err = s.resolver.Registry.GetBundle(ctx, &Mrn{Mrn: source})
+		if err == nil {
+			// the registry doesn't serve signatures
+			err = s.resolver.Signatures.Check(res, nil, source)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := res.SourceHash()
+	if err != nil {
+		return nil, err
+	}
+
+	lockSource := source
+	if imp.Path != "" && s.resolver.LockDir != "" {
+		if dir, err := filepath.Abs(s.resolver.LockDir); err == nil {
+			if rel, err := filepath.Rel(dir, source); err == nil {
+				lockSource = filepath.ToSlash(rel)
+			}
+		}
+	}
+	if locked := s.resolver.Lock.get(lockSource); locked != nil && locked.Checksum != checksum {
+		if !s.resolver.Update {
+			return nil, errors.New("the content of " + source + " changed and doesn't match the lock file anymore")
+		}
+		log.Info().Str("import", source).Msg("update locked import")
+	}
+
+	versions := make([]string, 0, len(res.Packs))
+	for i := range res.Packs {
+		if res.Packs[i].Version != "" {
+			versions = append(versions, res.Packs[i].Version)
+		}
+	}
+	s.resolver.resolved[lockSource] = &LockedImport{
+		Source:   lockSource,
+		Version:  strings.Join(versions, ", "),
+		Checksum: checksum,
+	}
+
+	s.bundles[source] = res
+	return res, nil
+}
+
+// fetch a bundle from a URL. Paths that it imports are relative to the URL.
+func (s *importState) fetch(source string) (*Bundle, error) {
+	base, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := FetchBundle(s.resolver.HttpClient, source, s.resolver.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range res.Packs {
+		for _, imp := range res.Packs[i].Imports {
+			if imp.Path == "" {
+				continue
+			}
+			ref, err := url.Parse(filepath.ToSlash(imp.Path))
+			if err != nil {
+				return nil, err
+			}
+			imp.Url = base.ResolveReference(ref).String()
+			imp.Path = ""
+		}
+	}
+	return res, nil
+}
+
+// checkVersions makes sure the imported packs satisfy the version
+// constraint and that no pack is imported in different versions
+func (s *importState) checkVersions(pack *QueryPack, imp *QueryPackImport, packs []*QueryPack) error {
+	var constraint *semver.Constraints
+	if imp.Version != "" {
+		var err error
+		constraint, err = semver.NewConstraint(imp.Version)
+		if err != nil {
+			return errors.Wrap(err, "invalid version constraint '"+imp.Version+"' in pack "+packID(pack))
+		}
+	}
+
+	for i := range packs {
+		cur := packs[i]
+		id := packID(cur)
+
+		if constraint != nil {
+			if cur.Version == "" {
+				return errors.New("pack " + id + " has no version, but pack " + packID(pack) + " requires '" + imp.Version + "'")
+			}
+			v, err := semver.NewVersion(cur.Version)
+			if err != nil {
+				return errors.Wrap(err, "invalid version '"+cur.Version+"' of pack "+id)
+			}
+			if !constraint.Check(v) {
+				return errors.New("pack " + packID(pack) + " requires version '" + imp.Version + "' of pack " + id + ", but found " + cur.Version)
+			}
+		}
+
+		if existing, ok := s.packs[id]; ok && existing.version != cur.Version {
+			return errors.New("version conflict: pack " + id + " is imported in version " + existing.version +
+				" by pack " + existing.importer + " and in version " + cur.Version + " by pack " + packID(pack))
+		}
+		s.packs[id] = importedPack{version: cur.Version, importer: packID(pack)}
+	}
+	return nil
+}
+
+// mergeImport adds the queries and functions of an imported bundle to the
+// root bundle. The root's own definitions take precedence.
+func mergeImport(root *Bundle, imported *Bundle, packs []*QueryPack) {
+	existing := map[string]struct{}{}
+	for i := range root.Queries {
+		existing[queryRefID(root.Queries[i].Mrn, root.Queries[i].Uid)] = struct{}{}
+	}
+	add := func(queries []*Mquery) {
+		for i := range queries {
+			query := queries[i]
+			id := queryRefID(query.Mrn, query.Uid)
+			if _, ok := existing[id]; ok {
+				continue
+			}
+			existing[id] = struct{}{}
+			root.Queries = append(root.Queries, query)
+		}
+	}
+
+	add(imported.Queries)
+	for i := range packs {
+		add(packs[i].Queries)
+		for j := range packs[i].Groups {
+			add(packs[i].Groups[j].Queries)
+		}
+		root.Functions = mergeFunctions(root.Functions, packs[i].Functions)
+	}
+	root.Functions = mergeFunctions(root.Functions, imported.Functions)
+}
+
+// mergeProps adds the properties of imported packs to a pack, unless it
+// defines them itself
+func mergeProps(props []*Property, packs []*QueryPack) []*Property {
+	existing := map[string]struct{}{}
+	for i := range props {
+		existing[queryRefID(props[i].Mrn, props[i].Uid)] = struct{}{}
+	}
+	for i := range packs {
+		for _, prop := range packs[i].Props {
+			id := queryRefID(prop.Mrn, prop.Uid)
+			if _, ok := existing[id]; ok {
+				continue
+			}
+			existing[id] = struct{}{}
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+func queryRefID(mrn string, uid string) string {
+	if mrn != "" {
+		return mrn
+	}
+	return uid
+}
+
+// makeImportPathsAbsolute turns paths of imports, which are relative to the
+// bundle file, into absolute paths
+func (p *Bundle) makeImportPathsAbsolute(dir string) {
+	for i := range p.Packs {
+		for _, imp := range p.Packs[i].Imports {
+			if imp.Path != "" && !filepath.IsAbs(imp.Path) {
+				imp.Path = filepath.Join(dir, imp.Path)
+			}
+		}
+	}
+}
+
+// UnresolvedReferences finds queries and properties that packs use, but
+// which are not defined in the bundle. Imports must be resolved first.
+func (p *Bundle) UnresolvedReferences() []string {
+	queries := map[string]struct{}{}
+	props := map[string]struct{}{}
+	addProps := func(all []*Property) {
+		for _, prop := range all {
+			if prop.Mql != "" {
+				props[queryRefID(prop.Mrn, prop.Uid)] = struct{}{}
+			}
+		}
+	}
+	addQueries := func(all []*Mquery) {
+		for _, query := range all {
+			// the deprecated query field is moved into mql on compile
+			if query.Mql != "" || query.Query != "" || len(query.Variants) != 0 {
+				queries[queryRefID(query.Mrn, query.Uid)] = struct{}{}
+			}
+			addProps(query.Props)
+		}
+	}
+
+	addQueries(p.Queries)
+	addProps(p.Props)
+	for _, pack := range p.Packs {
+		addQueries(pack.Queries)
+		addProps(pack.Props)
+		for _, group := range pack.Groups {
+			addQueries(group.Queries)
+		}
+	}
+
+	var res []string
+	checkQueries := func(pack string, all []*Mquery) {
+		for _, query := range all {
+			id := queryRefID(query.Mrn, query.Uid)
+			if _, ok := queries[id]; !ok {
+				if pack == "" {
+					res = append(res, "query "+id+" has no mql")
+				} else {
+					res = append(res, "query "+id+" in pack "+pack+" is not defined")
+				}
+			}
+			for _, variant := range query.Variants {
+				vid := queryRefID(variant.Mrn, variant.Uid)
+				if _, ok := queries[vid]; !ok {
+					res = append(res, "variant "+vid+" of query "+id+" is not defined")
+				}
+			}
+			for _, prop := range query.Props {
+				pid := queryRefID(prop.Mrn, prop.Uid)
+				if _, ok := props[pid]; !ok {
+					res = append(res, "property "+pid+" of query "+id+" is not defined")
+				}
+			}
+		}
+	}
+
+	checkQueries("", p.Queries)
+	for _, pack := range p.Packs {
+		id := packID(pack)
+		checkQueries(id, pack.Queries)
+		for _, group := range pack.Groups {
+			checkQueries(id, group.Queries)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,174 @@
+package explorer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeBundleFile(t *testing.T, dir string, name string, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func basePack(version string) string {
+	return `
+packs:
+- uid: base
+  name: Base
+  version: ` + version + `
+  queries:
+  - uid: answer
+    title: The answer
+    mql: 40 + 2
+`
+}
+
+const importingPack = `
+packs:
+- uid: main
+  name: Main
+  imports:
+  - path: base.mql.yaml
+    version: ^1.0
+  queries:
+  - uid: answer
+`
+
+func TestBundleImports(t *testing.T) {
+	t.Run("queries of imported packs are used", func(t *testing.T) {
+		dir := t.TempDir()
+		writeBundleFile(t, dir, "base.mql.yaml", basePack("1.2.0"))
+		path := writeBundleFile(t, dir, "main.mql.yaml", importingPack)
+
+		bundle, err := BundleFromPaths(path)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"query answer in pack main is not defined"}, bundle.UnresolvedReferences())
+		require.NoError(t, bundle.ResolveImports(context.Background()))
+		assert.Empty(t, bundle.UnresolvedReferences())
+
+		_, err = bundle.Compile(context.Background())
+		require.NoError(t, err)
+		require.Len(t, bundle.Packs, 1)
+		assert.Empty(t, bundle.Packs[0].Imports)
+		assert.Equal(t, "40 + 2", bundle.Packs[0].Queries[0].Mql)
+		assert.NotEmpty(t, bundle.Packs[0].Queries[0].CodeId)
+	})
+
+	t.Run("versions must match the constraint", func(t *testing.T) {
+		dir := t.TempDir()
+		writeBundleFile(t, dir, "base.mql.yaml", basePack("2.0.0"))
+		path := writeBundleFile(t, dir, "main.mql.yaml", importingPack)
+
+		bundle, err := BundleFromPaths(path)
+		require.NoError(t, err)
+		_, err = bundle.Compile(context.Background())
+		assert.ErrorContains(t, err, "requires version '^1.0' of pack base, but found 2.0.0")
+	})
+
+	t.Run("packs can't be imported in different versions", func(t *testing.T) {
+		dir := t.TempDir()
+		writeBundleFile(t, dir, "base1.mql.yaml", basePack("1.0.0"))
+		writeBundleFile(t, dir, "base2.mql.yaml", basePack("2.0.0"))
+		path := writeBundleFile(t, dir, "main.mql.yaml", `
+packs:
+- uid: one
+  imports:
+  - path: base1.mql.yaml
+- uid: two
+  imports:
+  - path: base2.mql.yaml
+`)
+
+		bundle, err := BundleFromPaths(path)
+		require.NoError(t, err)
+		_, err = bundle.Compile(context.Background())
+		assert.ErrorContains(t, err, "version conflict: pack base is imported in version 1.0.0 by pack one and in version 2.0.0 by pack two")
+	})
+
+	t.Run("import cycles are detected", func(t *testing.T) {
+		dir := t.TempDir()
+		writeBundleFile(t, dir, "a.mql.yaml", `
+packs:
+- uid: a
+  imports:
+  - path: b.mql.yaml
+`)
+		writeBundleFile(t, dir, "b.mql.yaml", `
+packs:
+- uid: b
+  imports:
+  - path: a.mql.yaml
+`)
+
+		bundle, err := BundleFromPaths(filepath.Join(dir, "a.mql.yaml"))
+		require.NoError(t, err)
+		_, err = bundle.Compile(context.Background())
+		assert.ErrorContains(t, err, "import cycle: "+filepath.Join(dir, "b.mql.yaml")+" -> "+filepath.Join(dir, "a.mql.yaml")+" -> "+filepath.Join(dir, "b.mql.yaml"))
+	})
+
+	t.Run("imports must match the lock", func(t *testing.T) {
+		dir := t.TempDir()
+		writeBundleFile(t, dir, "base.mql.yaml", basePack("1.2.0"))
+		path := writeBundleFile(t, dir, "main.mql.yaml", importingPack)
+		lockPath := LockFilePath(path)
+		assert.Equal(t, filepath.Join(dir, "main.mql.lock"), lockPath)
+
+		compile := func(update bool) (*ImportResolver, error) {
+			lock, err := LoadBundleLock(lockPath)
+			require.NoError(t, err)
+			resolver := &ImportResolver{Lock: lock, LockDir: dir, Update: update}
+
+			bundle, err := BundleFromPaths(path)
+			require.NoError(t, err)
+			_, err = bundle.Compile(WithImportResolver(context.Background(), resolver))
+			return resolver, err
+		}
+
+		resolver, err := compile(false)
+		require.NoError(t, err)
+		lock := resolver.Resolved()
+		require.Len(t, lock.Imports, 1)
+		assert.Equal(t, "base.mql.yaml", lock.Imports[0].Source)
+		assert.Equal(t, "1.2.0", lock.Imports[0].Version)
+		require.NoError(t, lock.Save(lockPath))
+
+		writeBundleFile(t, dir, "base.mql.yaml", basePack("1.3.0"))
+		_, err = compile(false)
+		assert.ErrorContains(t, err, "doesn't match the lock file")
+
+		resolver, err = compile(true)
+		require.NoError(t, err)
+		assert.Equal(t, "1.3.0", resolver.Resolved().Imports[0].Version)
+	})
+}
+
+func TestBundleUnresolvedReferences(t *testing.T) {
+	bundle, err := BundleFromYAML([]byte(`
+packs:
+- uid: main
+  queries:
+  - uid: missing
+  - uid: composed
+    variants:
+    - uid: other
+  - uid: with-props
+    mql: props.home
+    props:
+    - uid: home
+  - uid: deprecated
+    query: 1 + 1
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"query missing in pack main is not defined",
+		"variant other of query composed is not defined",
+		"property home of query with-props is not defined",
+	}, bundle.UnresolvedReferences())
+}
@@ -0,0 +1,427 @@
+package explorer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.mondoo.com/cnquery"
+	"sigs.k8s.io/yaml"
+)
+
+// SignatureFileSuffix is added to the path of a bundle file for the file of
+// its detached signature
+const SignatureFileSuffix = ".sig"
+
+// SignatureFilePath is the path of the detached signature of a bundle file
+func SignatureFilePath(bundlePath string) string {
+	return bundlePath + SignatureFileSuffix
+}
+
+// BundleSignature is the detached signature of a bundle file. It signs the
+// source hash of the bundle, so it stays valid if only the formatting of
+// the file changes.
+type BundleSignature struct {
+	// Checksum is the source hash of the signed bundle
+	Checksum string `json:"checksum"`
+	// Signature of the checksum, base64 encoded
+	Signature string `json:"signature"`
+	// PublicKey that verifies the signature, PEM encoded. It is only set if
+	// the signature has no certificate.
+	PublicKey string `json:"public_key,omitempty"`
+	// Certificate of the key that verifies the signature, followed by its
+	// intermediate certificates, PEM encoded
+	Certificate string `json:"certificate,omitempty"`
+}
+
+// LoadBundleSignature reads a signature file. Returns nil if it doesn't exist.
+func LoadBundleSignature(path string) (*BundleSignature, error) {
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return BundleSignatureFromYAML(raw)
+}
+
+// BundleSignatureFromYAML reads a signature from its file content
+func BundleSignatureFromYAML(data []byte) (*BundleSignature, error) {
+	res := &BundleSignature{}
+	if err := yaml.Unmarshal(data, res); err != nil {
+		return nil, errors.Wrap(err, "failed to read bundle signature")
+	}
+	return res, nil
+}
+
+// FetchBundleSignature downloads the signature of the bundle at the given
+// URL. Returns nil if there is none.
+func FetchBundleSignature(client *http.Client, bundleUrl string) (*BundleSignature, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequest("GET", SignatureFilePath(bundleUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "cnquery/"+cnquery.Version)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("failed to fetch bundle signature: " + resp.Status)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return BundleSignatureFromYAML(raw)
+}
+
+// Save the signature to a file
+func (s *BundleSignature) Save(path string) error {
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0o644)
+}
+
+// verify the signature of a bundle. Returns the key that signed it and its
+// certificates, if it has any.
+func (s *BundleSignature) verify(bundle *Bundle) (crypto.PublicKey, []*x509.Certificate, error) {
+	checksum, err := bundle.SourceHash()
+	if err != nil {
+		return nil, nil, err
+	}
+	if checksum != s.Checksum {
+		return nil, nil, errors.New("the bundle changed after it was signed")
+	}
+
+	var key crypto.PublicKey
+	var chain []*x509.Certificate
+	if s.Certificate != "" {
+		chain, err = parseCertificates([]byte(s.Certificate))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(chain) == 0 {
+			return nil, nil, errors.New("the signature has no certificate")
+		}
+		key = chain[0].PublicKey
+	} else {
+		block, _ := pem.Decode([]byte(s.PublicKey))
+		if block == nil {
+			return nil, nil, errors.New("the signature has no public key")
+		}
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to parse public key")
+		}
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(s.Signature)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode signature")
+	}
+	if !verifySignature(key, []byte(s.Checksum), sig) {
+		return nil, nil, errors.New("the signature doesn't match the bundle")
+	}
+	return key, chain, nil
+}
+
+func verifySignature(key crypto.PublicKey, msg []byte, sig []byte) bool {
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, msg, sig)
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(msg)
+		return ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(msg)
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return false
+	}
+}
+
+// BundleSigner signs bundles with a private key, which is either trusted
+// directly or via its certificate
+type BundleSigner struct {
+	Key crypto.Signer
+	// Certificates of the key, followed by its intermediate certificates.
+	// They are optional.
+	Certificates []*x509.Certificate
+}
+
+// NewBundleSigner creates a signer from a PEM encoded ed25519, ECDSA, or RSA
+// private key and an optional PEM encoded certificate chain
+func NewBundleSigner(keyPEM []byte, certPEM []byte) (*BundleSigner, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key")
+	}
+	res := &BundleSigner{Key: signer}
+
+	if len(certPEM) != 0 {
+		res.Certificates, err = parseCertificates(certPEM)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Certificates) == 0 {
+			return nil, errors.New("no PEM encoded certificate found")
+		}
+		if !publicKeysEqual(res.Certificates[0].PublicKey, signer.Public()) {
+			return nil, errors.New("the certificate doesn't belong to the private key")
+		}
+	}
+
+	return res, nil
+}
+
+// Sign a bundle as it is loaded from its file
+func (s *BundleSigner) Sign(bundle *Bundle) (*BundleSignature, error) {
+	checksum, err := bundle.SourceHash()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := []byte(checksum)
+	var sig []byte
+	if _, ok := s.Key.Public().(ed25519.PublicKey); ok {
+		sig, err = s.Key.Sign(rand.Reader, msg, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(msg)
+		sig, err = s.Key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign bundle")
+	}
+
+	res := &BundleSignature{
+		Checksum:  checksum,
+		Signature: base64.StdEncoding.EncodeToString(sig),
+	}
+	if len(s.Certificates) != 0 {
+		var buf bytes.Buffer
+		for _, cert := range s.Certificates {
+			pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+		}
+		res.Certificate = buf.String()
+	} else {
+		der, err := x509.MarshalPKIXPublicKey(s.Key.Public())
+		if err != nil {
+			return nil, err
+		}
+		res.PublicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	}
+	return res, nil
+}
+
+// SignBundleFiles signs all bundle files in the given paths and writes a
+// signature file next to each of them. Returns the paths of the signature
+// files.
+func SignBundleFiles(signer *BundleSigner, paths ...string) ([]string, error) {
+	files, err := walkBundleFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(files))
+	for i, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		bundle, err := BundleFromYAML(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load file: "+file)
+		}
+
+		sig, err := signer.Sign(bundle)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = SignatureFilePath(file)
+		if err := sig.Save(res[i]); err != nil {
+			return nil, errors.Wrap(err, "failed to write signature of "+file)
+		}
+	}
+	return res, nil
+}
+
+// SignaturePolicy decides which bundles can be used. Signatures of bundles
+// are always verified, even without a policy; the policy adds which
+// signatures are trusted and if unsigned bundles are refused.
+type SignaturePolicy struct {
+	// RequireSignature refuses bundles that aren't signed by a trusted key
+	RequireSignature bool
+	// TrustedKeys are public keys that are trusted to sign bundles
+	TrustedKeys []crypto.PublicKey
+	// TrustedRoots are certificates that are trusted to issue certificates
+	// of keys that sign bundles. Certificates with extended key usages must
+	// allow code signing.
+	TrustedRoots *x509.CertPool
+}
+
+type signaturePolicyContextID struct{}
+
+// WithSignaturePolicy adds a signature policy to the context, which is then
+// used to check bundles that are fetched
+func WithSignaturePolicy(ctx context.Context, policy *SignaturePolicy) context.Context {
+	return context.WithValue(ctx, signaturePolicyContextID{}, policy)
+}
+
+// GetSignaturePolicy from a given context. Returns nil if none is set.
+func GetSignaturePolicy(ctx context.Context) *SignaturePolicy {
+	res, _ := ctx.Value(signaturePolicyContextID{}).(*SignaturePolicy)
+	return res
+}
+
+// AddTrusted adds all PEM encoded public keys and certificates to the
+// trusted keys and roots of the policy
+func (p *SignaturePolicy) AddTrusted(data []byte) error {
+	found := false
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return errors.Wrap(err, "failed to parse trusted public key")
+			}
+			p.TrustedKeys = append(p.TrustedKeys, key)
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return errors.Wrap(err, "failed to parse trusted certificate")
+			}
+			if p.TrustedRoots == nil {
+				p.TrustedRoots = x509.NewCertPool()
+			}
+			p.TrustedRoots.AddCert(cert)
+		default:
+			continue
+		}
+		found = true
+	}
+
+	if !found {
+		return errors.New("no PEM encoded public key or certificate found")
+	}
+	return nil
+}
+
+// Check a bundle from the given source against its signature, which is nil
+// if the bundle isn't signed
+func (p *SignaturePolicy) Check(bundle *Bundle, sig *BundleSignature, source string) error {
+	if sig == nil {
+		if p != nil && p.RequireSignature {
+			return errors.New("query pack bundle " + source + " is not signed")
+		}
+		return nil
+	}
+
+	key, chain, err := sig.verify(bundle)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature of query pack bundle "+source)
+	}
+
+	if p == nil || (!p.RequireSignature && len(p.TrustedKeys) == 0 && p.TrustedRoots == nil) {
+		return nil
+	}
+	if !p.trusts(key, chain) {
+		return errors.New("query pack bundle " + source + " is not signed by a trusted key")
+	}
+	return nil
+}
+
+func (p *SignaturePolicy) trusts(key crypto.PublicKey, chain []*x509.Certificate) bool {
+	for _, trusted := range p.TrustedKeys {
+		if publicKeysEqual(trusted, key) {
+			return true
+		}
+	}
+
+	if p.TrustedRoots == nil || len(chain) == 0 {
+		return false
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         p.TrustedRoots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	return err == nil
+}
+
+func publicKeysEqual(a crypto.PublicKey, b crypto.PublicKey) bool {
+	k, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && k.Equal(b)
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var res []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return res, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse certificate")
+		}
+		res = append(res, cert)
+	}
+}
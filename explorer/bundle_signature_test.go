@@ -0,0 +1,179 @@
+package explorer
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+const signedPack = `
+packs:
+- uid: signed
+  name: Signed
+  queries:
+  - uid: answer
+    title: The answer
+    mql: 40 + 2
+`
+
+func newEd25519Signer(t *testing.T) *BundleSigner {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	signer, err := NewBundleSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+	require.NoError(t, err)
+	return signer
+}
+
+func publicKeyPEM(t *testing.T, signer *BundleSigner) []byte {
+	der, err := x509.MarshalPKIXPublicKey(signer.Key.Public())
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestBundleSignatures(t *testing.T) {
+	signer := newEd25519Signer(t)
+
+	sign := func(t *testing.T) string {
+		dir := t.TempDir()
+		path := writeBundleFile(t, dir, "signed.mql.yaml", signedPack)
+		files, err := SignBundleFiles(signer, dir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{path + ".sig"}, files)
+		return path
+	}
+
+	t.Run("signed bundles are verified without a policy", func(t *testing.T) {
+		path := sign(t)
+		// formatting doesn't change the signed content
+		writeBundleFile(t, filepath.Dir(path), "signed.mql.yaml", "# reviewed\n"+signedPack)
+		_, err := BundleFromPaths(path)
+		require.NoError(t, err)
+
+		writeBundleFile(t, filepath.Dir(path), "signed.mql.yaml", basePack("1.0.0"))
+		_, err = BundleFromPaths(path)
+		assert.ErrorContains(t, err, "invalid signature of query pack bundle "+path+": the bundle changed after it was signed")
+	})
+
+	t.Run("unsigned bundles can be required to be signed", func(t *testing.T) {
+		path := writeBundleFile(t, t.TempDir(), "unsigned.mql.yaml", signedPack)
+		_, err := BundleFromPaths(path)
+		require.NoError(t, err)
+
+		_, err = BundleFromPathsWithPolicy(&SignaturePolicy{RequireSignature: true}, path)
+		assert.ErrorContains(t, err, "query pack bundle "+path+" is not signed")
+	})
+
+	t.Run("only trusted keys are accepted", func(t *testing.T) {
+		path := sign(t)
+
+		policy := &SignaturePolicy{RequireSignature: true}
+		require.NoError(t, policy.AddTrusted(publicKeyPEM(t, newEd25519Signer(t))))
+		_, err := BundleFromPathsWithPolicy(policy, path)
+		assert.ErrorContains(t, err, "query pack bundle "+path+" is not signed by a trusted key")
+
+		require.NoError(t, policy.AddTrusted(publicKeyPEM(t, signer)))
+		bundle, err := BundleFromPathsWithPolicy(policy, path)
+		require.NoError(t, err)
+		require.Len(t, bundle.Packs, 1)
+	})
+
+	t.Run("fetched bundles are checked against the policy", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(signedPack))
+		require.NoError(t, err)
+		sig, err := signer.Sign(bundle)
+		require.NoError(t, err)
+		rawSig, err := yaml.Marshal(sig)
+		require.NoError(t, err)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/signed.mql.yaml", "/unsigned.mql.yaml":
+				w.Write([]byte(signedPack))
+			case "/signed.mql.yaml.sig":
+				w.Write(rawSig)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		defer srv.Close()
+
+		unsigned := srv.URL + "/unsigned.mql.yaml"
+		_, err = FetchBundle(srv.Client(), unsigned, nil)
+		require.NoError(t, err)
+		_, err = FetchBundle(srv.Client(), unsigned, &SignaturePolicy{RequireSignature: true})
+		assert.ErrorContains(t, err, "query pack bundle "+unsigned+" is not signed")
+
+		policy := &SignaturePolicy{RequireSignature: true}
+		require.NoError(t, policy.AddTrusted(publicKeyPEM(t, signer)))
+		fetched, err := FetchBundle(srv.Client(), srv.URL+"/signed.mql.yaml", policy)
+		require.NoError(t, err)
+		require.Len(t, fetched.Packs, 1)
+	})
+
+	t.Run("keys can be trusted via certificates", func(t *testing.T) {
+		caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		caTemplate := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "Query Pack CA"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+		caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+		require.NoError(t, err)
+		ca, err := x509.ParseCertificate(caDer)
+		require.NoError(t, err)
+
+		leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		leafDer, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      pkix.Name{CommonName: "Reviewer"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		}, ca, &leafKey.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDer, err := x509.MarshalECPrivateKey(leafKey)
+		require.NoError(t, err)
+
+		certSigner, err := NewBundleSigner(
+			pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDer}),
+		)
+		require.NoError(t, err)
+
+		bundle, err := BundleFromYAML([]byte(signedPack))
+		require.NoError(t, err)
+		sig, err := certSigner.Sign(bundle)
+		require.NoError(t, err)
+
+		policy := &SignaturePolicy{RequireSignature: true}
+		require.NoError(t, policy.AddTrusted(publicKeyPEM(t, signer)))
+		assert.ErrorContains(t, policy.Check(bundle, sig, "signed.mql.yaml"), "is not signed by a trusted key")
+
+		require.NoError(t, policy.AddTrusted(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})))
+		assert.NoError(t, policy.Check(bundle, sig, "signed.mql.yaml"))
+	})
+}
@@ -1,11 +1,14 @@
 package explorer
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/mrn"
+	"go.mondoo.com/cnquery/types"
 )
 
 func TestBundleLoad(t *testing.T) {
@@ -31,3 +34,133 @@ func TestBundleLoad(t *testing.T) {
 		assert.True(t, len(bundle.Packs[0].Queries[0].Uid) > 0)
 	})
 }
+
+func TestBundleFunctions(t *testing.T) {
+	bundle, err := BundleFromYAML([]byte(`
+functions:
+  - name: inc
+    args:
+      - name: x
+        type: int
+    mql: x + 1
+packs:
+  - uid: functions-pack
+    name: Functions
+    functions:
+      - name: isZero
+        args:
+          - name: x
+            type: int
+        mql: x == 0
+    queries:
+      - uid: uses-functions
+        mql: isZero(inc(-1))
+`))
+	require.NoError(t, err)
+
+	_, err = bundle.Compile(context.Background())
+	require.NoError(t, err)
+
+	functions := bundle.Packs[0].Functions
+	require.Len(t, functions, 2)
+	assert.Equal(t, "isZero", functions[0].Name)
+	assert.Equal(t, "inc", functions[1].Name)
+
+	query := bundle.Packs[0].Queries[0]
+	assert.NotEmpty(t, query.CodeId)
+	assert.Equal(t, string(types.Bool), query.Type)
+}
+
+func TestBundleLint(t *testing.T) {
+	bundle, err := BundleFromYAML([]byte(`
+packs:
+  - uid: lint-pack
+    name: Lint
+    filters: asset.platform == "ubuntu"
+    queries:
+      - uid: unused
+        mql: |
+          x = 1
+          users.length
+      - uid: wrong-platform
+        mql: aws.ec2.instances.length
+    groups:
+      - filters: asset.family.contains("windows")
+        queries:
+          - uid: right-platform
+            mql: windows.hotfixes.length
+`))
+	require.NoError(t, err)
+
+	_, err = bundle.Compile(context.Background())
+	require.NoError(t, err)
+
+	res := bundle.Lint()
+	rules := map[string]string{}
+	for i := range res {
+		uid, err := mrn.GetResource(res[i].Query, MRN_RESOURCE_QUERY)
+		require.NoError(t, err)
+		rules[uid] = res[i].Rule
+	}
+	assert.Equal(t, map[string]string{
+		"unused":         "unused-variable",
+		"wrong-platform": "platform-availability",
+	}, rules)
+}
+
+func TestBundleCompileErrors(t *testing.T) {
+	text := `packs:
+  - uid: errors-pack
+    name: Errors
+    queries:
+      - uid: broken
+        title: Broken
+        mql: users.list { nope }
+`
+	bundle, err := BundleFromYAML([]byte(text))
+	require.NoError(t, err)
+
+	_, err = bundle.Compile(context.Background())
+	require.Error(t, err)
+
+	sources := QuerySources{}
+	require.NoError(t, sources.Add("pack.mql.yaml", text))
+	res := sources.Explain(err)
+	assert.Contains(t, res, "failed to validate query '//local.cnquery.io/run/local-execution/queries/broken'")
+	assert.Contains(t, res, ""+
+		" --> pack.mql.yaml:7:27\n"+
+		"  |\n"+
+		"7 |         mql: users.list { nope }\n"+
+		"  |                           ^^^^\n")
+}
+
+func TestBundleTimeout(t *testing.T) {
+	bundle, err := BundleFromYAML([]byte(`packs:
+  - uid: timeout-pack
+    name: Timeouts
+    queries:
+      - uid: slow
+        title: Slow
+        mql: mondoo.version
+        timeout: 30s
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "30s", bundle.Packs[0].Queries[0].Timeout)
+
+	_, err = bundle.Compile(context.Background())
+	require.NoError(t, err)
+
+	bundle, err = BundleFromYAML([]byte(`packs:
+  - uid: timeout-pack
+    name: Timeouts
+    queries:
+      - uid: slow
+        title: Slow
+        mql: mondoo.version
+        timeout: soon
+`))
+	require.NoError(t, err)
+
+	_, err = bundle.Compile(context.Background())
+	assert.ErrorContains(t, err, "failed to parse timeout for query //local.cnquery.io/run/local-execution/queries/slow: invalid timeout 'soon'")
+}
@@ -0,0 +1,302 @@
+package explorer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/cli/config"
+	"go.mondoo.com/cnquery/cli/diskcache"
+	llx "go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/resources/packs/all/info"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultCodeCacheMaxAge is how long code and lint results stay in the cache
+// after they were last used
+const DefaultCodeCacheMaxAge = 30 * 24 * time.Hour
+
+// usedResolution is how much the last use of an entry may be behind, before
+// it is stored again. It avoids rewriting the cache in every run.
+const usedResolution = 24 * time.Hour
+
+// DefaultCodeCachePath is the directory where compiled code is stored if no
+// other path is configured
+func DefaultCodeCachePath() (string, error) {
+	return config.HomePath("cache", "code")
+}
+
+// CodeCache stores the compiled code and lint results of queries and
+// properties on disk, so that unchanged queries don't have to be compiled
+// again in later runs. Code is stored per cnquery version, schema and
+// feature flags, and looked up by the source of the query, its properties
+// and functions. Code that wasn't used for MaxAge is removed when the cache
+// is saved.
+type CodeCache struct {
+	// Path is the directory with one cache file per version and schema
+	Path string
+	// MaxAge is how long entries are kept after they were last used
+	MaxAge time.Duration
+
+	lock    sync.Mutex
+	file    *diskcache.File
+	data    codeCacheFile
+	changed bool
+	hits    int
+	misses  int
+	now     func() time.Time
+}
+
+type codeCacheFile struct {
+	Code map[string]*llx.CodeBundle    `json:"code"`
+	Lint map[string][]*mqlc.Diagnostic `json:"lint"`
+	// Used is when each code and lint result was last used, in unix seconds
+	Used map[string]int64 `json:"used"`
+}
+
+func newCodeCacheFile() codeCacheFile {
+	return codeCacheFile{
+		Code: map[string]*llx.CodeBundle{},
+		Lint: map[string][]*mqlc.Diagnostic{},
+		Used: map[string]int64{},
+	}
+}
+
+// NewCodeCache creates a code cache in the given directory. It has to be
+// loaded before it can be used.
+func NewCodeCache(path string) *CodeCache {
+	return &CodeCache{
+		Path:   path,
+		MaxAge: DefaultCodeCacheMaxAge,
+		data:   newCodeCacheFile(),
+		now:    time.Now,
+	}
+}
+
+type codeCacheContextID struct{}
+
+// WithCodeCache adds a code cache to the context, which is then used to
+// compile bundles
+func WithCodeCache(ctx context.Context, cache *CodeCache) context.Context {
+	return context.WithValue(ctx, codeCacheContextID{}, cache)
+}
+
+// GetCodeCache from a given context. Returns nil if no cache is used.
+func GetCodeCache(ctx context.Context) *CodeCache {
+	res, _ := ctx.Value(codeCacheContextID{}).(*CodeCache)
+	return res
+}
+
+// Load the compiled code for the current cnquery version, schema and
+// features. Any code compiled with something else is ignored.
+func (c *CodeCache) Load() error {
+	schema, err := proto.MarshalOptions{Deterministic: true}.Marshal(info.Registry.Schema())
+	if err != nil {
+		return errors.Wrap(err, "failed to determine schema version for code cache")
+	}
+
+	file := diskcache.New(c.Path, "code cache",
+		cnquery.GetVersion(), cnquery.Build, string(schema), string(cnquery.DefaultFeatures))
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.file = &file
+	c.data = newCodeCacheFile()
+	c.changed = false
+
+	found, err := file.Load(&c.data)
+	if err != nil {
+		return err
+	}
+	if !found {
+		c.data = newCodeCacheFile()
+		return nil
+	}
+	if c.data.Code == nil {
+		c.data.Code = map[string]*llx.CodeBundle{}
+	}
+	if c.data.Lint == nil {
+		c.data.Lint = map[string][]*mqlc.Diagnostic{}
+	}
+	if c.data.Used == nil {
+		c.data.Used = map[string]int64{}
+	}
+	return nil
+}
+
+// key of compiled code, which covers everything that the compiler gets
+// besides the schema and features
+func codeKey(mql string, props map[string]*llx.Primitive, functions []*Function) string {
+	key := []string{"mql", mql}
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		key = append(key, "prop", name, props[name].GetType())
+	}
+
+	for i := range functions {
+		fun := functions[i]
+		key = append(key, "function", fun.Name, fun.Mql)
+		for j := range fun.Args {
+			key = append(key, "arg", fun.Args[j].Name, fun.Args[j].Type)
+		}
+	}
+
+	return diskcache.Key(key...)
+}
+
+// get the compiled code for a key. It returns a copy, so that callers may
+// change it.
+func (c *CodeCache) get(key string) (*llx.CodeBundle, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	res, ok := c.data.Code[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.touch(key)
+	return proto.Clone(res).(*llx.CodeBundle), true
+}
+
+func (c *CodeCache) add(key string, code *llx.CodeBundle) {
+	if c == nil || code == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil {
+		return
+	}
+	c.data.Code[key] = proto.Clone(code).(*llx.CodeBundle)
+	c.data.Used[key] = c.now().Unix()
+	c.changed = true
+}
+
+// lintKey of a query's lint results, which also depend on the platforms it
+// runs on
+func lintKey(codeKey string, platforms []string) string {
+	return diskcache.Key(append([]string{"lint", codeKey}, platforms...)...)
+}
+
+func (c *CodeCache) getLint(key string) ([]*mqlc.Diagnostic, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	res, ok := c.data.Lint[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.touch(key)
+	return res, true
+}
+
+func (c *CodeCache) addLint(key string, diagnostics []*mqlc.Diagnostic) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil {
+		return
+	}
+	if diagnostics == nil {
+		diagnostics = []*mqlc.Diagnostic{}
+	}
+	c.data.Lint[key] = diagnostics
+	c.data.Used[key] = c.now().Unix()
+	c.changed = true
+}
+
+// touch records that an entry was used now. Must be called with the lock
+// held.
+func (c *CodeCache) touch(key string) {
+	now := c.now().Unix()
+	if now-c.data.Used[key] < int64(usedResolution/time.Second) {
+		return
+	}
+	c.data.Used[key] = now
+	c.changed = true
+}
+
+// Stats returns how many queries were found in the cache and how many had
+// to be compiled or linted
+func (c *CodeCache) Stats() (hits int, misses int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.hits, c.misses
+}
+
+// Save the cache to disk, if anything changed. Code and lint results that
+// weren't used for MaxAge are removed.
+func (c *CodeCache) Save() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+
+	now := c.now().Unix()
+	oldest := now - int64(c.MaxAge/time.Second)
+	for key := range c.data.Code {
+		c.evict(key, now, oldest, func() { delete(c.data.Code, key) })
+	}
+	for key := range c.data.Lint {
+		c.evict(key, now, oldest, func() { delete(c.data.Lint, key) })
+	}
+
+	if !c.changed {
+		return nil
+	}
+	if err := c.file.Save(c.data); err != nil {
+		return err
+	}
+
+	c.changed = false
+	log.Debug().Str("path", c.file.Path).Int("queries", len(c.data.Code)).Msg("stored code cache")
+	return nil
+}
+
+// evict removes an entry that was last used before oldest. Must be called
+// with the lock held.
+func (c *CodeCache) evict(key string, now int64, oldest int64, remove func()) {
+	used, ok := c.data.Used[key]
+	if !ok {
+		// caches that didn't record the last use yet start aging now
+		c.data.Used[key] = now
+		c.changed = true
+		return
+	}
+	if used < oldest {
+		remove()
+		delete(c.data.Used, key)
+		c.changed = true
+	}
+}
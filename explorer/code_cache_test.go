@@ -0,0 +1,188 @@
+package explorer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const codeCacheBundle = `
+functions:
+  - name: inc
+    args:
+      - name: x
+        type: int
+    mql: x + 1
+packs:
+  - uid: cached-pack
+    name: Cached
+    filters: asset.family.contains("unix")
+    props:
+      - uid: home
+        mql: "'/root'"
+    queries:
+      - uid: uses-functions
+        mql: inc(1) == 2
+      - uid: uses-props
+        mql: props.home
+        props:
+          - uid: home
+`
+
+const otherCodeCacheBundle = `
+packs:
+  - uid: other-pack
+    name: Other
+    queries:
+      - uid: other
+        mql: 1 + 1
+`
+
+func compileWithCodeCache(t *testing.T, cache *CodeCache, data string) *Bundle {
+	bundle, err := BundleFromYAML([]byte(data))
+	require.NoError(t, err)
+
+	_, err = bundle.Compile(WithCodeCache(context.Background(), cache))
+	require.NoError(t, err)
+	return bundle
+}
+
+func TestCodeCache(t *testing.T) {
+	dir := t.TempDir()
+	uncached := compileWithCodeCache(t, nil, codeCacheBundle)
+
+	cache := NewCodeCache(dir)
+	require.NoError(t, cache.Load())
+	compileWithCodeCache(t, cache, codeCacheBundle)
+	hits, misses := cache.Stats()
+	assert.Equal(t, 0, hits)
+	assert.NotZero(t, misses)
+	require.NoError(t, cache.Save())
+
+	t.Run("reuse code in the next run", func(t *testing.T) {
+		cache := NewCodeCache(dir)
+		require.NoError(t, cache.Load())
+		bundle := compileWithCodeCache(t, cache, codeCacheBundle)
+
+		hits, misses := cache.Stats()
+		assert.NotZero(t, hits)
+		assert.Equal(t, 0, misses)
+
+		for i := range bundle.Queries {
+			assert.Equal(t, uncached.Queries[i].CodeId, bundle.Queries[i].CodeId)
+			assert.Equal(t, uncached.Queries[i].Checksum, bundle.Queries[i].Checksum)
+			assert.Equal(t, uncached.Queries[i].Type, bundle.Queries[i].Type)
+		}
+		assert.Equal(t, uncached.Packs[0].ComputedFilters.Items, bundle.Packs[0].ComputedFilters.Items)
+	})
+
+	t.Run("reuse lint results in the next run", func(t *testing.T) {
+		cache := NewCodeCache(t.TempDir())
+		require.NoError(t, cache.Load())
+		bundle := compileWithCodeCache(t, cache, codeCacheBundle)
+		expected := bundle.LintWithCache(cache)
+		require.NoError(t, cache.Save())
+
+		cache = NewCodeCache(cache.Path)
+		require.NoError(t, cache.Load())
+		bundle = compileWithCodeCache(t, cache, codeCacheBundle)
+		_, misses := cache.Stats()
+		assert.Equal(t, expected, bundle.LintWithCache(cache))
+		_, lintMisses := cache.Stats()
+		assert.Equal(t, misses, lintMisses)
+	})
+
+	t.Run("changed functions are compiled again", func(t *testing.T) {
+		cache := NewCodeCache(dir)
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, `
+functions:
+  - name: inc
+    args:
+      - name: x
+        type: int
+    mql: x + 2
+packs:
+  - uid: cached-pack
+    name: Cached
+    queries:
+      - uid: uses-functions
+        mql: inc(1) == 2
+`)
+		hits, misses := cache.Stats()
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, 1, misses)
+	})
+
+	t.Run("code that wasn't used for a while is removed on save", func(t *testing.T) {
+		now := time.Now()
+		clock := func() time.Time { return now }
+
+		cache := NewCodeCache(t.TempDir())
+		cache.now = clock
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, codeCacheBundle)
+		_, expected := cache.Stats()
+		require.NoError(t, cache.Save())
+
+		// code that isn't used in a run is kept
+		now = now.Add(cache.MaxAge / 2)
+		cache = NewCodeCache(cache.Path)
+		cache.now = clock
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, otherCodeCacheBundle)
+		require.NoError(t, cache.Save())
+
+		cache = NewCodeCache(cache.Path)
+		cache.now = clock
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, codeCacheBundle)
+		hits, misses := cache.Stats()
+		assert.Equal(t, expected, hits)
+		assert.Equal(t, 0, misses)
+		require.NoError(t, cache.Save())
+
+		// until it wasn't used for longer than its max age
+		now = now.Add(cache.MaxAge + time.Hour)
+		cache = NewCodeCache(cache.Path)
+		cache.now = clock
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, otherCodeCacheBundle)
+		require.NoError(t, cache.Save())
+
+		cache = NewCodeCache(cache.Path)
+		cache.now = clock
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, codeCacheBundle)
+		hits, misses = cache.Stats()
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, expected, misses)
+	})
+
+	t.Run("the last use is only stored once a day", func(t *testing.T) {
+		now := time.Now()
+		cache := NewCodeCache(t.TempDir())
+		cache.now = func() time.Time { return now }
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, codeCacheBundle)
+		require.NoError(t, cache.Save())
+
+		require.NoError(t, cache.Load())
+		compileWithCodeCache(t, cache, codeCacheBundle)
+		assert.False(t, cache.changed)
+
+		now = now.Add(usedResolution)
+		compileWithCodeCache(t, cache, codeCacheBundle)
+		assert.True(t, cache.changed)
+	})
+
+	t.Run("code is only used after loading the cache", func(t *testing.T) {
+		cache := NewCodeCache(dir)
+		compileWithCodeCache(t, cache, codeCacheBundle)
+		hits, _ := cache.Stats()
+		assert.Equal(t, 0, hits)
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery"
 	"go.mondoo.com/cnquery/cli/progress"
 	"go.mondoo.com/cnquery/explorer"
@@ -39,7 +40,7 @@ func RunFilterQueries(
 	mqueries := map[string]*explorer.Mquery{}
 	for i := range queries {
 		query := queries[i]
-		code, err := query.Compile(nil)
+		code, err := query.Compile(nil, nil)
 		if err != nil {
 			errs = append(errs, err)
 			continue
@@ -138,7 +139,7 @@ func (e *instance) runCode(queries map[string]*explorer.ExecutionQuery, timeout
 			continue
 		}
 
-		if err := e.runQuery(query.Code, nil); err != nil {
+		if err := e.runQuery(query, nil); err != nil {
 			multierror.Append(errs, err)
 		}
 	}
@@ -151,6 +152,9 @@ func (e *instance) runCode(queries map[string]*explorer.ExecutionQuery, timeout
 type instance struct {
 	schema  *resources.Schema
 	runtime *resources.Runtime
+	// resolves the fields of all queries concurrently, within the limits of
+	// their resources
+	scheduler *llx.Scheduler
 	// raw list of executino queries mapped via CodeID
 	queries map[string]*explorer.ExecutionQuery
 	// an optional list of datapoints as an allow-list of data that will be returned
@@ -160,6 +164,8 @@ type instance struct {
 	datapointTracker map[string][]*explorer.ExecutionQuery
 	// all code executors that have been started
 	execs map[string]*llx.MQLExecutorV2
+	// timers of running queries with a timeout via CodeID
+	timers map[string]*queryTimer
 	// raw results from CodeID to result
 	results map[string]*llx.RawResult
 	// identifies which queries (CodeID) trigger other queries
@@ -175,18 +181,28 @@ type instance struct {
 	assetMrn         string
 }
 
+// queryTimer times out the results of one query
+type queryTimer struct {
+	timer     *time.Timer
+	checksums []string
+}
+
 func newInstance(schema *resources.Schema, runtime *resources.Runtime, progressReporter progress.Progress) *instance {
 	if progressReporter == nil {
 		progressReporter = progress.Noop{}
 	}
 
 	return &instance{
-		schema:           schema,
-		runtime:          runtime,
+		schema:  schema,
+		runtime: runtime,
+		scheduler: llx.NewScheduler(runtime, llx.SchedulerOptions{
+			Connection: connectionType(runtime),
+		}),
 		datapointTracker: map[string][]*explorer.ExecutionQuery{},
 		queries:          map[string]*explorer.ExecutionQuery{},
 		results:          map[string]*llx.RawResult{},
 		notifyQuery:      map[string][]*explorer.ExecutionQuery{},
+		timers:           map[string]*queryTimer{},
 		isAborted:        false,
 		isDone:           false,
 		done:             make(chan struct{}),
@@ -195,8 +211,24 @@ func newInstance(schema *resources.Schema, runtime *resources.Runtime, progressR
 	}
 }
 
-func (e *instance) runQuery(bundle *llx.CodeBundle, props map[string]*llx.Primitive) error {
-	exec, err := llx.NewExecutorV2(bundle.CodeV2, e.runtime, props, e.collect)
+// connectionType of the asset, which selects the concurrency limits of its
+// resources
+func connectionType(runtime *resources.Runtime) string {
+	asset := runtime.Motor.GetAsset()
+	if asset == nil || len(asset.Connections) == 0 {
+		return ""
+	}
+	return asset.Connections[0].Backend.Id()
+}
+
+func (e *instance) runQuery(query *explorer.ExecutionQuery, props map[string]*llx.Primitive) error {
+	bundle := query.Code
+	timeout, err := query.TimeoutDuration()
+	if err != nil {
+		return err
+	}
+
+	exec, err := llx.NewExecutorV2(bundle.CodeV2, e.scheduler, props, e.collect)
 	if err != nil {
 		return err
 	}
@@ -207,9 +239,83 @@ func (e *instance) runQuery(bundle *llx.CodeBundle, props map[string]*llx.Primit
 	}
 
 	e.execs[bundle.CodeV2.Id] = exec
+
+	if timeout > 0 {
+		e.startTimer(bundle, timeout)
+	}
 	return nil
 }
 
+// startTimer reports all results of the query that are still missing once
+// the timeout is up. The timer is stopped as soon as all its results are in.
+func (e *instance) startTimer(bundle *llx.CodeBundle, timeout time.Duration) {
+	id := bundle.CodeV2.Id
+	checksums := append(bundle.DatapointChecksums(), bundle.EntrypointChecksums()...)
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.hasResults(checksums) {
+		return
+	}
+
+	e.timers[id] = &queryTimer{
+		checksums: checksums,
+		timer: time.AfterFunc(timeout, func() {
+			e.mutex.Lock()
+			delete(e.timers, id)
+			e.mutex.Unlock()
+
+			e.timeout(checksums, timeout)
+		}),
+	}
+}
+
+// stopTimers stops the timers of all queries whose results are all in.
+// Must be called with the mutex held.
+func (e *instance) stopTimers() {
+	for id, timer := range e.timers {
+		if e.hasResults(timer.checksums) {
+			timer.timer.Stop()
+			delete(e.timers, id)
+		}
+	}
+}
+
+func (e *instance) hasResults(checksums []string) bool {
+	for i := range checksums {
+		if _, ok := e.results[checksums[i]]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// timeout reports all checksums without results as timed out. Results that
+// arrive after this are ignored.
+func (e *instance) timeout(checksums []string, after time.Duration) {
+	e.mutex.Lock()
+	var missing []string
+	for i := range checksums {
+		checksum := checksums[i]
+		if _, ok := e.results[checksum]; !ok {
+			missing = append(missing, checksum)
+		}
+	}
+	e.mutex.Unlock()
+
+	if len(missing) == 0 {
+		return
+	}
+
+	log.Warn().Strs("missing", missing).Dur("timeout", after).Msg("executor> query timed out")
+	for i := range missing {
+		e.collect(llx.TimeoutResult(missing[i], "", after))
+	}
+}
+
+// WaitUntilDone waits for all results to arrive. All results that are still
+// missing after the timeout are reported as timed out, so that the results
+// that were collected up to this point can still be stored.
 func (e *instance) WaitUntilDone(timeout time.Duration) error {
 	select {
 	case <-e.done:
@@ -217,14 +323,19 @@ func (e *instance) WaitUntilDone(timeout time.Duration) error {
 
 	case <-time.After(timeout):
 		e.mutex.Lock()
-		e.isAborted = true
-		isDone := e.isDone
+		checksums := make([]string, 0, len(e.datapointTracker))
+		for checksum := range e.datapointTracker {
+			checksums = append(checksums, checksum)
+		}
 		e.mutex.Unlock()
 
-		if isDone {
-			return nil
-		}
-		return errors.New("execution timed out after " + timeout.String())
+		e.timeout(checksums, timeout)
+
+		e.mutex.Lock()
+		e.isAborted = true
+		err := e.errors
+		e.mutex.Unlock()
+		return err
 	}
 }
 
@@ -303,7 +414,14 @@ func (e *instance) collect(res *llx.RawResult) {
 
 	e.mutex.Lock()
 
+	if existing, ok := e.results[res.CodeID]; ok {
+		if _, isTimeout := existing.Data.IsTimeout(); isTimeout {
+			e.mutex.Unlock()
+			return
+		}
+	}
 	e.results[res.CodeID] = res
+	e.stopTimers()
 	cur := len(e.results)
 	max := len(e.datapointTracker)
 	isDone := cur == max
@@ -346,7 +464,7 @@ func (e *instance) collect(res *llx.RawResult) {
 				break
 			}
 
-			err = e.runQuery(query.Code, props)
+			err = e.runQuery(query, props)
 			if err != nil {
 				fatalErr = err
 				break
@@ -0,0 +1,72 @@
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/cli/progress"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+)
+
+func newTestInstance(bundle *llx.CodeBundle) *instance {
+	e := &instance{
+		datapointTracker: map[string][]*explorer.ExecutionQuery{},
+		queries:          map[string]*explorer.ExecutionQuery{},
+		results:          map[string]*llx.RawResult{},
+		notifyQuery:      map[string][]*explorer.ExecutionQuery{},
+		timers:           map[string]*queryTimer{},
+		done:             make(chan struct{}, 1),
+		progressReporter: progress.Noop{},
+	}
+	for _, checksum := range append(bundle.DatapointChecksums(), bundle.EntrypointChecksums()...) {
+		e.datapointTracker[checksum] = nil
+	}
+	return e
+}
+
+func TestQueryTimeout(t *testing.T) {
+	bundle := MustCompile("mondoo.version")
+	checksum := MustGetOneDatapoint(bundle)
+
+	t.Run("missing results time out", func(t *testing.T) {
+		e := newTestInstance(bundle)
+		e.startTimer(bundle, 10*time.Millisecond)
+
+		require.Eventually(t, func() bool {
+			e.mutex.Lock()
+			defer e.mutex.Unlock()
+			return e.results[checksum] != nil
+		}, time.Second, 5*time.Millisecond)
+
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+		_, isTimeout := e.results[checksum].Data.IsTimeout()
+		assert.True(t, isTimeout)
+		assert.Empty(t, e.timers)
+	})
+
+	t.Run("timers stop once all results are in", func(t *testing.T) {
+		e := newTestInstance(bundle)
+		e.startTimer(bundle, 10*time.Millisecond)
+		require.Len(t, e.timers, 1)
+
+		e.collect(&llx.RawResult{CodeID: checksum, Data: llx.StringData("8.0.0")})
+		assert.Empty(t, e.timers)
+
+		time.Sleep(30 * time.Millisecond)
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+		_, isTimeout := e.results[checksum].Data.IsTimeout()
+		assert.False(t, isTimeout)
+	})
+
+	t.Run("queries with all results don't start a timer", func(t *testing.T) {
+		e := newTestInstance(bundle)
+		e.collect(&llx.RawResult{CodeID: checksum, Data: llx.StringData("8.0.0")})
+		e.startTimer(bundle, 10*time.Millisecond)
+		assert.Empty(t, e.timers)
+	})
+}
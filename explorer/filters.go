@@ -110,13 +110,18 @@ func (s *Filters) UnmarshalJSON(data []byte) error {
 }
 
 func (s *Filters) Compile(ownerMRN string) error {
+	return s.compile(ownerMRN, nil)
+}
+
+// compile the filters with an optional code cache
+func (s *Filters) compile(ownerMRN string, cache *CodeCache) error {
 	if s == nil || len(s.Items) == 0 {
 		return nil
 	}
 
 	res := make(map[string]*Mquery, len(s.Items))
 	for _, query := range s.Items {
-		query.RefreshAsFilter(ownerMRN)
+		query.refreshAsFilter(ownerMRN, cache)
 
 		if _, ok := res[query.CodeId]; ok {
 			continue
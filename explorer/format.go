@@ -0,0 +1,258 @@
+package explorer
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/mrn"
+	"go.mondoo.com/cnquery/resources/packs/all/info"
+	"gopkg.in/yaml.v3"
+)
+
+// FormattedFile is a bundle file with all of its MQL code formatted
+type FormattedFile struct {
+	Path      string
+	Original  string
+	Formatted string
+}
+
+// Changed is true if formatting changed the file
+func (f *FormattedFile) Changed() bool {
+	return f.Original != f.Formatted
+}
+
+// FormatBundleFiles formats the MQL code in all the given bundle files or
+// directories. Files are not written.
+func FormatBundleFiles(paths ...string) ([]*FormattedFile, error) {
+	files, err := walkBundleFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*FormattedFile, len(files))
+	for i := range files {
+		data, err := os.ReadFile(files[i])
+		if err != nil {
+			return nil, err
+		}
+		formatted, err := FormatBundle(string(data))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to format "+files[i])
+		}
+		res[i] = &FormattedFile{
+			Path:      files[i],
+			Original:  string(data),
+			Formatted: formatted,
+		}
+	}
+	return res, nil
+}
+
+// FormatBundle formats the code in all `mql` fields of a bundle's YAML text,
+// e.g. of queries, properties, and functions. Everything else is kept the
+// way it is written.
+func FormatBundle(text string) (string, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(text), &root); err != nil {
+		return "", err
+	}
+
+	// formatted code is compiled to make sure it does the same as before,
+	// which requires functions and properties that are defined in the bundle
+	conf := mqlc.NewConfig(info.Registry.Schema(), cnquery.DefaultFeatures)
+	var props map[string]*llx.Primitive
+	if bundle, err := BundleFromYAML([]byte(text)); err == nil {
+		functions := bundle.Functions
+		for i := range bundle.Packs {
+			functions = mergeFunctions(functions, bundle.Packs[i].Functions)
+		}
+		if fns, err := parseFunctions(functions); err == nil {
+			conf.Functions = fns
+		}
+		props = bundleProps(bundle)
+	}
+
+	var edits []yamlEdit
+	var walk func(node *yaml.Node) error
+	walk = func(node *yaml.Node) error {
+		if node.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key, value := node.Content[i], node.Content[i+1]
+				if key.Value != "mql" || value.Kind != yaml.ScalarNode {
+					continue
+				}
+
+				code := strings.TrimRight(value.Value, "\n")
+				formatted, err := mqlc.Format(code, props, conf)
+				if err != nil {
+					return errors.Wrap(err, "failed to format mql in line "+strconv.Itoa(value.Line))
+				}
+				if formatted == code {
+					continue
+				}
+
+				start, end := yamlScalarRange(text, value)
+				edits = append(edits, yamlEdit{
+					start: start,
+					end:   end,
+					text:  yamlScalar(formatted, blockHeader(text, start, value.Style), key.Column-1),
+				})
+			}
+		}
+
+		for i := range node.Content {
+			if err := walk(node.Content[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(&root); err != nil {
+		return "", err
+	}
+
+	// edits are applied from the end, so they don't move each other
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].start > edits[j].start
+	})
+	for i := range edits {
+		edit := edits[i]
+		text = text[:edit.start] + edit.text + text[edit.end:]
+	}
+	return text, nil
+}
+
+// bundleProps compiles all properties that are defined in a bundle, so
+// that the queries which use them can be compiled too
+func bundleProps(bundle *Bundle) map[string]*llx.Primitive {
+	res := map[string]*llx.Primitive{}
+	add := func(props []*Property) {
+		for i := range props {
+			prop := props[i]
+			name := prop.Uid
+			if name == "" {
+				if m, err := mrn.NewMRN(prop.Mrn); err == nil {
+					name = m.Basename()
+				}
+			}
+			if _, ok := res[name]; ok || name == "" || prop.Mql == "" {
+				continue
+			}
+			if _, err := prop.RefreshChecksumAndType(); err != nil {
+				continue
+			}
+			res[name] = &llx.Primitive{Type: prop.Type}
+		}
+	}
+
+	add(bundle.Props)
+	queries := bundle.Queries
+	for i := range bundle.Packs {
+		pack := bundle.Packs[i]
+		add(pack.Props)
+		queries = append(queries, pack.Queries...)
+		for j := range pack.Groups {
+			queries = append(queries, pack.Groups[j].Queries...)
+		}
+	}
+	for i := range queries {
+		add(queries[i].Props)
+	}
+	return res
+}
+
+type yamlEdit struct {
+	start int
+	end   int
+	text  string
+}
+
+// yamlScalarRange finds where the scalar is written in the text. Block
+// scalars start at their indicator and end with their last line of content.
+func yamlScalarRange(text string, node *yaml.Node) (int, int) {
+	start := YamlOffset(text, node)
+
+	switch node.Style {
+	case yaml.DoubleQuotedStyle:
+		for i := start + 1; i < len(text); i++ {
+			switch text[i] {
+			case '\\':
+				i++
+			case '"':
+				return start, i + 1
+			}
+		}
+		return start, len(text)
+
+	case yaml.SingleQuotedStyle:
+		for i := start + 1; i < len(text); i++ {
+			if text[i] != '\'' {
+				continue
+			}
+			if i+1 < len(text) && text[i+1] == '\'' {
+				i++
+				continue
+			}
+			return start, i + 1
+		}
+		return start, len(text)
+	}
+
+	n := len(strings.TrimRight(node.Value, "\n"))
+	if n == 0 {
+		return start, start
+	}
+	offsets := MapYamlValue(text, node)
+	return start, offsets[n-1] + 1
+}
+
+// blockHeader returns the indicators of a block scalar, e.g. |- or >,
+// without its indentation. It is empty for all other scalars.
+func blockHeader(text string, start int, style yaml.Style) string {
+	if style&(yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+		return ""
+	}
+	res := text[start : start+1]
+	for i := start + 1; i < len(text); i++ {
+		switch text[i] {
+		case '-', '+':
+			res += text[i : i+1]
+		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		default:
+			return res
+		}
+	}
+	return res
+}
+
+// yamlScalar writes code as a YAML scalar. Blocks keep their header, e.g.
+// |- or >. Code with multiple lines is written as a literal block, since
+// folding would join its lines.
+func yamlScalar(code string, header string, indent int) string {
+	multiline := strings.Contains(code, "\n")
+	if !multiline && header == "" {
+		if out, err := yaml.Marshal(code); err == nil && strings.Count(string(out), "\n") == 1 {
+			return strings.TrimSuffix(string(out), "\n")
+		}
+	}
+	if header == "" || multiline && header[0] == '>' {
+		header = "|" + strings.TrimLeft(header, "|>")
+	}
+
+	prefix := strings.Repeat(" ", indent+2)
+	var res strings.Builder
+	res.WriteString(header)
+	for _, line := range strings.Split(code, "\n") {
+		res.WriteString("\n")
+		if line != "" {
+			res.WriteString(prefix + line)
+		}
+	}
+	return res.String()
+}
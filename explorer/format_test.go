@@ -0,0 +1,99 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatBundle(t *testing.T) {
+	t.Run("format mql fields", func(t *testing.T) {
+		res, err := FormatBundle(`# keep this
+packs:
+  - uid: pack
+    queries:
+      - uid: root
+        # and this
+        mql: users.where(name=='root').list {uid}
+      - uid: quoted
+        mql: "mondoo.version!=''"
+      - uid: block
+        mql: |
+          mondoo.version=='1'
+          # comment
+          mondoo.build
+      - uid: clean
+        mql: mondoo.version == "1"
+    functions:
+      - name: isRoot
+        args:
+          - name: u
+            type: users.user
+        mql: u.uid==0
+`)
+		require.NoError(t, err)
+		assert.Equal(t, `# keep this
+packs:
+  - uid: pack
+    queries:
+      - uid: root
+        # and this
+        mql: users.where(name == "root").list { uid }
+      - uid: quoted
+        mql: mondoo.version != ""
+      - uid: block
+        mql: |
+          mondoo.version == "1"
+          // comment
+          mondoo.build
+      - uid: clean
+        mql: mondoo.version == "1"
+    functions:
+      - name: isRoot
+        args:
+          - name: u
+            type: users.user
+        mql: u.uid == 0
+`, res)
+
+		again, err := FormatBundle(res)
+		require.NoError(t, err)
+		assert.Equal(t, res, again)
+	})
+
+	t.Run("keep block indicators", func(t *testing.T) {
+		res, err := FormatBundle("queries:\n  - uid: a\n    mql: |-\n      mondoo.version=='1'\n  - uid: b\n    mql: >\n      mondoo.build=='1'\n  - uid: c\n    mql: >-\n      mondoo {version build}\n")
+		require.NoError(t, err)
+		assert.Equal(t, "queries:\n  - uid: a\n    mql: |-\n      mondoo.version == \"1\"\n  - uid: b\n    mql: >\n      mondoo.build == \"1\"\n  - uid: c\n    mql: |-\n      mondoo {\n        version\n        build\n      }\n", res)
+	})
+
+	t.Run("compile with properties", func(t *testing.T) {
+		res, err := FormatBundle(`packs:
+  - uid: pack
+    props:
+      - uid: min
+        mql: 1
+    queries:
+      - uid: a
+        props:
+          - uid: min
+        mql: props.min>mondoo.version.length
+`)
+		require.NoError(t, err)
+		assert.Contains(t, res, "mql: props.min > mondoo.version.length\n")
+	})
+
+	t.Run("bundle is valid after formatting", func(t *testing.T) {
+		res, err := FormatBundle("queries:\n  - uid: a\n    mql: \"{a: 1}['a']==1\"\n")
+		require.NoError(t, err)
+		bundle, err := BundleFromYAML([]byte(res))
+		require.NoError(t, err)
+		assert.Equal(t, `{a: 1}["a"] == 1`, bundle.Queries[0].Mql)
+	})
+
+	t.Run("syntax errors", func(t *testing.T) {
+		_, err := FormatBundle("queries:\n  - uid: a\n    mql: users.where(\n")
+		assert.Error(t, err)
+	})
+}
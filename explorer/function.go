@@ -0,0 +1,70 @@
+package explorer
+
+import (
+	"github.com/pkg/errors"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/mqlc/parser"
+)
+
+// Parse the function into a definition the compiler understands
+func (f *Function) Parse() (*parser.Function, error) {
+	if f.Name == "" {
+		return nil, errors.New("function is missing a name")
+	}
+
+	ast, err := parser.Parse(mqlc.Dedent(f.Mql))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse function '"+f.Name+"'")
+	}
+
+	res := &parser.Function{
+		Name: f.Name,
+		Body: ast.Expressions,
+	}
+	for i := range f.Args {
+		arg := f.Args[i]
+		if arg.Name == "" || arg.Type == "" {
+			return nil, errors.New("function '" + f.Name + "' has an argument without a name or type")
+		}
+		res.Params = append(res.Params, &parser.FunctionParam{
+			Name: arg.Name,
+			Type: arg.Type,
+		})
+	}
+
+	return res, nil
+}
+
+func parseFunctions(functions []*Function) ([]*parser.Function, error) {
+	if len(functions) == 0 {
+		return nil, nil
+	}
+
+	res := make([]*parser.Function, len(functions))
+	for i := range functions {
+		fun, err := functions[i].Parse()
+		if err != nil {
+			return nil, err
+		}
+		res[i] = fun
+	}
+	return res, nil
+}
+
+// mergeFunctions adds all functions from base that aren't defined yet
+func mergeFunctions(functions []*Function, base []*Function) []*Function {
+	existing := make(map[string]struct{}, len(functions))
+	for i := range functions {
+		existing[functions[i].Name] = struct{}{}
+	}
+
+	for i := range base {
+		fun := base[i]
+		if _, ok := existing[fun.Name]; ok {
+			continue
+		}
+		existing[fun.Name] = struct{}{}
+		functions = append(functions, fun)
+	}
+	return functions
+}
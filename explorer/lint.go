@@ -0,0 +1,141 @@
+package explorer
+
+import (
+	"sort"
+
+	"go.mondoo.com/cnquery"
+	llx "go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/mrn"
+	"go.mondoo.com/cnquery/resources/packs/all/info"
+)
+
+// QueryDiagnostic is a lint diagnostic for one query of a bundle
+type QueryDiagnostic struct {
+	*mqlc.Diagnostic
+	// Query is the MRN of the query this diagnostic belongs to
+	Query string
+}
+
+func (d *QueryDiagnostic) String() string {
+	return d.Query + ":" + d.Diagnostic.String()
+}
+
+// Lint analyzes all queries in the bundle and returns diagnostics for
+// problems which don't prevent them from compiling. Queries are only checked
+// against the platforms that their filters allow. The bundle must have been
+// compiled beforehand.
+func (p *Bundle) Lint() []*QueryDiagnostic {
+	return p.LintWithCache(nil)
+}
+
+// LintWithCache works like Lint, where queries that were linted before are
+// looked up in the code cache. The cache is optional.
+func (p *Bundle) LintWithCache(cache *CodeCache) []*QueryDiagnostic {
+	l := bundleLinter{
+		checked: map[string]struct{}{},
+		code:    cache,
+	}
+
+	for i := range p.Packs {
+		pack := p.Packs[i]
+		platforms := filterPlatforms(pack.Filters)
+
+		l.lintQueries(pack.Queries, pack.Functions, platforms)
+		for j := range pack.Groups {
+			group := pack.Groups[j]
+			groupPlatforms := platforms
+			if group.Filters != nil && len(group.Filters.Items) != 0 {
+				groupPlatforms = filterPlatforms(group.Filters)
+			}
+			l.lintQueries(group.Queries, pack.Functions, groupPlatforms)
+		}
+	}
+
+	// queries which are shared between packs don't know which platforms
+	// they run on, so we don't restrict them
+	l.lintQueries(p.Queries, p.Functions, nil)
+
+	return l.res
+}
+
+type bundleLinter struct {
+	checked map[string]struct{}
+	res     []*QueryDiagnostic
+	code    *CodeCache
+}
+
+func (l *bundleLinter) lintQueries(queries []*Mquery, functions []*Function, platforms []string) {
+	fns, err := parseFunctions(functions)
+	if err != nil {
+		// this is reported when the bundle is compiled
+		return
+	}
+	conf := mqlc.NewConfig(info.Registry.Schema(), cnquery.DefaultFeatures)
+	conf.Functions = fns
+
+	for i := range queries {
+		query := queries[i]
+		if query.Mql == "" || len(query.Variants) != 0 {
+			continue
+		}
+		if _, ok := l.checked[query.Mrn]; ok {
+			continue
+		}
+		l.checked[query.Mrn] = struct{}{}
+
+		queryPlatforms := platforms
+		if query.Filters != nil && len(query.Filters.Items) != 0 {
+			queryPlatforms = filterPlatforms(query.Filters)
+		}
+
+		props := queryProps(query)
+		var key string
+		if l.code != nil {
+			key = lintKey(codeKey(query.Mql, props, functions), queryPlatforms)
+		}
+		diagnostics, ok := l.code.getLint(key)
+		if !ok {
+			diagnostics = mqlc.Lint(query.Mql, props, conf, mqlc.LintOptions{Platforms: queryPlatforms})
+			l.code.addLint(key, diagnostics)
+		}
+		for j := range diagnostics {
+			l.res = append(l.res, &QueryDiagnostic{
+				Diagnostic: diagnostics[j],
+				Query:      query.Mrn,
+			})
+		}
+	}
+}
+
+// queryProps returns the typed properties of a compiled query
+func queryProps(query *Mquery) map[string]*llx.Primitive {
+	res := map[string]*llx.Primitive{}
+	for i := range query.Props {
+		prop := query.Props[i]
+		m, err := mrn.NewMRN(prop.Mrn)
+		if err != nil {
+			continue
+		}
+		res[m.Basename()] = &llx.Primitive{Type: prop.Type}
+	}
+	return res
+}
+
+func filterPlatforms(filters *Filters) []string {
+	if filters == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(filters.Items))
+	for k := range filters.Items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	mqls := make([]string, len(keys))
+	for i := range keys {
+		mqls[i] = filters.Items[keys[i]].Mql
+	}
+	return mqlc.FilterPlatforms(mqls)
+}
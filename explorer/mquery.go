@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -20,8 +21,14 @@ import (
 )
 
 // Compile a given query and return the bundle. Both v1 and v2 versions are compiled.
-// Both versions will be given the same code id.
-func (m *Mquery) Compile(props map[string]*llx.Primitive) (*llx.CodeBundle, error) {
+// Both versions will be given the same code id. Functions are made
+// available to the query, e.g. from the query pack it belongs to.
+func (m *Mquery) Compile(props map[string]*llx.Primitive, functions []*Function) (*llx.CodeBundle, error) {
+	return m.compile(props, functions, nil)
+}
+
+// compile the query, unless its code is in the cache. The cache is optional.
+func (m *Mquery) compile(props map[string]*llx.Primitive, functions []*Function, cache *CodeCache) (*llx.CodeBundle, error) {
 	if m.Mql == "" {
 		if m.Query == "" {
 			return nil, errors.New("query is not implemented '" + m.Mrn + "'")
@@ -30,13 +37,29 @@ func (m *Mquery) Compile(props map[string]*llx.Primitive) (*llx.CodeBundle, erro
 		m.Query = ""
 	}
 
+	var key string
+	if cache != nil {
+		key = codeKey(m.Mql, props, functions)
+		if code, ok := cache.get(key); ok {
+			return code, nil
+		}
+	}
+
+	fns, err := parseFunctions(functions)
+	if err != nil {
+		return nil, err
+	}
+
 	schema := info.Registry.Schema()
+	conf := mqlc.NewConfig(schema, cnquery.DefaultFeatures)
+	conf.Functions = fns
 
-	v2Code, err := mqlc.Compile(m.Mql, props, mqlc.NewConfig(schema, cnquery.DefaultFeatures))
+	v2Code, err := mqlc.Compile(m.Mql, props, conf)
 	if err != nil {
 		return nil, err
 	}
 
+	cache.add(key, v2Code)
 	return v2Code, nil
 }
 
@@ -183,13 +206,18 @@ func (m *Mquery) RefreshChecksum(
 			Add(m.Tags[k])
 	}
 
+	// only added if set, to keep the checksum of existing queries
+	if m.Timeout != "" {
+		c = c.Add("timeout").Add(m.Timeout)
+	}
+
 	m.Checksum = c.String()
 	return nil
 }
 
 // RefreshChecksumAndType by compiling the query and updating the Checksum field
-func (m *Mquery) RefreshChecksumAndType(queries map[string]*Mquery, props map[string]PropertyRef) (*llx.CodeBundle, error) {
-	return m.refreshChecksumAndType(queries, props)
+func (m *Mquery) RefreshChecksumAndType(queries map[string]*Mquery, props map[string]PropertyRef, functions []*Function) (*llx.CodeBundle, error) {
+	return m.refreshChecksumAndType(queries, props, functions, nil)
 }
 
 type QueryMap map[string]*Mquery
@@ -206,7 +234,7 @@ func (m QueryMap) GetQuery(ctx context.Context, mrn string) (*Mquery, error) {
 	return res, nil
 }
 
-func (m *Mquery) refreshChecksumAndType(queries map[string]*Mquery, props map[string]PropertyRef) (*llx.CodeBundle, error) {
+func (m *Mquery) refreshChecksumAndType(queries map[string]*Mquery, props map[string]PropertyRef, functions []*Function, cache *CodeCache) (*llx.CodeBundle, error) {
 	localProps := map[string]*llx.Primitive{}
 	for i := range m.Props {
 		prop := m.Props[i]
@@ -237,9 +265,9 @@ func (m *Mquery) refreshChecksumAndType(queries map[string]*Mquery, props map[st
 		return nil, m.RefreshChecksum(context.Background(), QueryMap(queries).GetQuery)
 	}
 
-	bundle, err := m.Compile(localProps)
+	bundle, err := m.compile(localProps, functions, cache)
 	if err != nil {
-		return bundle, errors.New("failed to compile query '" + m.Mql + "': " + err.Error())
+		return bundle, errors.Wrap(err, "failed to compile query '"+m.Mql+"'")
 	}
 
 	if bundle.GetCodeV2().GetId() == "" {
@@ -268,7 +296,11 @@ func (m *Mquery) refreshChecksumAndType(queries map[string]*Mquery, props map[st
 
 // RefreshAsFilter filters treats this query as an asset filter and sets its Mrn, Title, and Checksum
 func (m *Mquery) RefreshAsFilter(mrn string) (*llx.CodeBundle, error) {
-	bundle, err := m.refreshChecksumAndType(nil, nil)
+	return m.refreshAsFilter(mrn, nil)
+}
+
+func (m *Mquery) refreshAsFilter(mrn string, cache *CodeCache) (*llx.CodeBundle, error) {
+	bundle, err := m.refreshChecksumAndType(nil, nil, nil, cache)
 	if err != nil {
 		return bundle, err
 	}
@@ -386,6 +418,33 @@ func (m *Mquery) AddBase(base *Mquery) {
 	if m.Variants == nil {
 		m.Variants = base.Variants
 	}
+	if m.Timeout == "" {
+		m.Timeout = base.Timeout
+	}
+}
+
+// TimeoutDuration of the query, which is 0 if it has no timeout
+func (m *Mquery) TimeoutDuration() (time.Duration, error) {
+	return parseTimeout(m.Timeout)
+}
+
+// TimeoutDuration of the query, which is 0 if it has no timeout
+func (x *ExecutionQuery) TimeoutDuration() (time.Duration, error) {
+	return parseTimeout(x.Timeout)
+}
+
+func parseTimeout(timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return 0, nil
+	}
+	res, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, errors.New("invalid timeout '" + timeout + "', it must be a duration like 30s or 5m")
+	}
+	if res <= 0 {
+		return 0, errors.New("invalid timeout '" + timeout + "', it must be greater than 0")
+	}
+	return res, nil
 }
 
 func (r *Remediation) UnmarshalJSON(data []byte) error {
@@ -413,7 +472,7 @@ func (r *Remediation) MarshalJSON() ([]byte, error) {
 
 func ChecksumFilters(queries []*Mquery) (string, error) {
 	for i := range queries {
-		if _, err := queries[i].refreshChecksumAndType(nil, nil); err != nil {
+		if _, err := queries[i].refreshChecksumAndType(nil, nil, nil, nil); err != nil {
 			return "", errors.New("failed to compile query: " + err.Error())
 		}
 	}
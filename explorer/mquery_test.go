@@ -3,6 +3,7 @@ package explorer
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -118,3 +119,23 @@ func TestMquery_Remediation(t *testing.T) {
 		assert.Equal(t, initial, &back)
 	})
 }
+
+func TestMqueryTimeout(t *testing.T) {
+	q := &Mquery{Mql: "mondoo.version", Timeout: "30s"}
+	timeout, err := q.TimeoutDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeout)
+
+	timeout, err = (&Mquery{}).TimeoutDuration()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+
+	_, err = (&Mquery{Timeout: "soon"}).TimeoutDuration()
+	assert.EqualError(t, err, "invalid timeout 'soon', it must be a duration like 30s or 5m")
+
+	_, err = (&Mquery{Timeout: "-1s"}).TimeoutDuration()
+	assert.EqualError(t, err, "invalid timeout '-1s', it must be greater than 0")
+
+	c := (&Mquery{}).Merge(q)
+	assert.Equal(t, "30s", c.Timeout)
+}
@@ -0,0 +1,531 @@
+// Package packstore keeps query packs from a registry in a local directory,
+// so that they can be used by their namespace and UID without fetching them
+// every time.
+package packstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/pkg/errors"
+	"go.mondoo.com/cnquery/checksums"
+	"go.mondoo.com/cnquery/cli/config"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/mrn"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultNamespace of the registry, which has all public query packs
+	DefaultNamespace = "mondoohq"
+
+	indexFile = "packs.lock"
+)
+
+// DefaultPath is where query packs are installed by default
+func DefaultPath() (string, error) {
+	return config.HomePath("querypacks")
+}
+
+// reUid are the characters of a pack UID or namespace that can be used in
+// an MRN. Namespaces and UIDs are file names in the store, so "." and ".."
+// are refused too.
+var reUid = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+func checkUid(uid string) error {
+	if !reUid.MatchString(uid) || uid == "." || uid == ".." {
+		return errors.New("invalid query pack UID '" + uid + "'")
+	}
+	return nil
+}
+
+// InstalledPack is a query pack in the store
+type InstalledPack struct {
+	Uid string `json:"uid"`
+	// Namespace of the pack in the registry. It is empty for packs that
+	// were installed before namespaces were kept, whose bundle files are
+	// stored without one.
+	Namespace string `json:"namespace,omitempty"`
+	Mrn       string `json:"mrn"`
+	Name      string `json:"name,omitempty"`
+	Version   string `json:"version,omitempty"`
+	// Pin is the version constraint that updates stay within, if any
+	Pin string `json:"pin,omitempty"`
+	// Checksum of the bundle file of the pack
+	Checksum string `json:"checksum"`
+	// Signed is true if the pack was installed with a signature, which is
+	// stored next to its bundle file
+	Signed bool `json:"signed,omitempty"`
+}
+
+// namespace of the pack, which is read from its MRN if it wasn't kept
+func (p *InstalledPack) namespace() string {
+	if p.Namespace != "" {
+		return p.Namespace
+	}
+	ns, _ := mrn.GetResource(p.Mrn, explorer.CollectionIDNamespace)
+	return ns
+}
+
+// Ref is the "namespace/uid" of the pack, which identifies it in the store
+func (p *InstalledPack) Ref() string {
+	return p.namespace() + "/" + p.Uid
+}
+
+type index struct {
+	Packs []*InstalledPack `json:"packs"`
+}
+
+// SignedRegistry is a registry that also serves the detached signatures of
+// its bundles. Packs from other registries are installed without one.
+type SignedRegistry interface {
+	explorer.QueryHub
+	// GetBundleSignature returns the signature of the bundle of the pack
+	// with the given MRN, or nil if it isn't signed
+	GetBundleSignature(ctx context.Context, in *explorer.Mrn) (*explorer.BundleSignature, error)
+}
+
+// Store is a directory of query packs installed from a registry. Every pack
+// is stored as a bundle file in the folder of its namespace, next to an
+// index of all packs. Signatures of packs are stored next to their bundle
+// file.
+type Store struct {
+	Path     string
+	Registry explorer.QueryHub
+}
+
+// New creates a store in the given directory. The registry is only needed
+// to search, install, and update packs.
+func New(path string, registry explorer.QueryHub) *Store {
+	return &Store{Path: path, Registry: registry}
+}
+
+// PackRef identifies a pack in the registry and optionally pins its version
+type PackRef struct {
+	Mrn string
+	Pin string
+}
+
+// ParsePackRef reads a pack reference, which is a pack MRN, a UID in the
+// default namespace, or "namespace/uid". A version constraint can be added
+// with "@", e.g. "linux-security@^1.2".
+func ParsePackRef(s string) (PackRef, error) {
+	var res PackRef
+	if idx := strings.LastIndex(s, "@"); idx != -1 {
+		res.Pin = s[idx+1:]
+		s = s[:idx]
+		if _, err := semver.NewConstraint(res.Pin); err != nil {
+			return res, errors.Wrap(err, "invalid version constraint '"+res.Pin+"'")
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(s, "//"):
+		if !mrn.IsValid(s) {
+			return res, errors.New("invalid query pack MRN: " + s)
+		}
+		res.Mrn = s
+	case strings.Count(s, "/") == 1:
+		parts := strings.SplitN(s, "/", 2)
+		if err := checkUid(parts[0]); err != nil {
+			return res, err
+		}
+		if err := checkUid(parts[1]); err != nil {
+			return res, err
+		}
+		res.Mrn = explorer.NewQueryPackMrn(parts[0], parts[1])
+	case s != "" && !strings.Contains(s, "/"):
+		if err := checkUid(s); err != nil {
+			return res, err
+		}
+		res.Mrn = explorer.NewQueryPackMrn(DefaultNamespace, s)
+	default:
+		return res, errors.New("invalid query pack: " + s)
+	}
+	return res, nil
+}
+
+// List all installed packs, sorted by namespace and UID
+func (s *Store) List() ([]*InstalledPack, error) {
+	idx, err := s.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+	return idx.Packs, nil
+}
+
+// Search the packs of a namespace in the registry. The term is matched
+// against UIDs, names, and summaries; all packs are returned if it is empty.
+func (s *Store) Search(ctx context.Context, namespace string, term string) ([]*explorer.QueryPack, error) {
+	if s.Registry == nil {
+		return nil, errors.New("no registry to search query packs in")
+	}
+
+	ownerMrn := (&mrn.MRN{
+		ServiceName:          explorer.RegistryServiceName,
+		RelativeResourceName: explorer.CollectionIDNamespace + "/" + namespace,
+	}).String()
+	list, err := s.Registry.List(ctx, &explorer.ListReq{OwnerMrn: ownerMrn})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search query packs")
+	}
+
+	term = strings.ToLower(term)
+	res := []*explorer.QueryPack{}
+	for _, pack := range list.Items {
+		if term == "" ||
+			strings.Contains(strings.ToLower(packUid(pack)), term) ||
+			strings.Contains(strings.ToLower(pack.Name), term) ||
+			strings.Contains(strings.ToLower(pack.Summary), term) {
+			res = append(res, pack)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Mrn < res[j].Mrn
+	})
+	return res, nil
+}
+
+// Install a pack from the registry, replacing the installed version if
+// there is one
+func (s *Store) Install(ctx context.Context, ref PackRef) (*InstalledPack, error) {
+	idx, err := s.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := s.fetch(ctx, ref.Mrn, ref.Pin)
+	if err != nil {
+		return nil, err
+	}
+	s.set(idx, res)
+	return res, s.saveIndex(idx)
+}
+
+// PackUpdate is the outcome of updating an installed pack
+type PackUpdate struct {
+	Pack       *InstalledPack
+	OldVersion string
+	// HeldBack is true if the registry has a version outside of the pin,
+	// which was not installed
+	HeldBack   bool
+	NewVersion string
+}
+
+// Update the given installed packs, or all of them if none are given. Packs
+// are given as "namespace/uid" or as UID, see BundlePaths. Only packs whose
+// content changed are returned.
+func (s *Store) Update(ctx context.Context, refs ...string) ([]*PackUpdate, error) {
+	idx, err := s.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	packs := idx.Packs
+	if len(refs) != 0 {
+		packs = nil
+		for _, ref := range refs {
+			pack, err := idx.get(ref)
+			if err != nil {
+				return nil, err
+			}
+			packs = append(packs, pack)
+		}
+	}
+
+	res := []*PackUpdate{}
+	for _, pack := range packs {
+		nu, version, err := s.fetch(ctx, pack.Mrn, pack.Pin)
+		if err != nil {
+			var heldBack *errOutsidePin
+			if errors.As(err, &heldBack) {
+				res = append(res, &PackUpdate{Pack: pack, OldVersion: pack.Version, HeldBack: true, NewVersion: version})
+				continue
+			}
+			return res, err
+		}
+		if nu.Checksum == pack.Checksum && nu.Signed == pack.Signed {
+			continue
+		}
+
+		res = append(res, &PackUpdate{Pack: nu, OldVersion: pack.Version, NewVersion: nu.Version})
+		s.set(idx, nu)
+	}
+
+	return res, s.saveIndex(idx)
+}
+
+type errOutsidePin struct {
+	uid     string
+	version string
+	pin     string
+}
+
+func (e *errOutsidePin) Error() string {
+	return "version " + e.version + " of query pack " + e.uid + " doesn't match '" + e.pin + "'"
+}
+
+// fetch a pack from the registry and store its bundle file and its
+// signature, if the registry has one. It also returns the version of the
+// pack in the registry, even if it doesn't match the pin.
+func (s *Store) fetch(ctx context.Context, packMrn string, pin string) (*InstalledPack, string, error) {
+	if s.Registry == nil {
+		return nil, "", errors.New("no registry to install query packs from")
+	}
+
+	bundle, err := s.Registry.GetBundle(ctx, &explorer.Mrn{Mrn: packMrn})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to fetch query pack "+packMrn)
+	}
+
+	var pack *explorer.QueryPack
+	for i := range bundle.Packs {
+		if bundle.Packs[i].Mrn == packMrn {
+			pack = bundle.Packs[i]
+		}
+	}
+	if pack == nil {
+		return nil, "", errors.New("registry has no query pack " + packMrn)
+	}
+	uid := packUid(pack)
+	if err := checkUid(uid); err != nil {
+		return nil, "", errors.Wrap(err, "registry has an invalid query pack "+packMrn)
+	}
+	namespace, err := mrn.GetResource(pack.Mrn, explorer.CollectionIDNamespace)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "registry has an invalid query pack "+packMrn)
+	}
+	if err := checkUid(namespace); err != nil {
+		return nil, "", errors.Wrap(err, "registry has an invalid query pack "+packMrn)
+	}
+	res := &InstalledPack{
+		Uid:       uid,
+		Namespace: namespace,
+		Mrn:       pack.Mrn,
+		Name:      pack.Name,
+		Version:   pack.Version,
+		Pin:       pin,
+	}
+
+	if pin != "" {
+		constraint, err := semver.NewConstraint(pin)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "invalid version constraint '"+pin+"'")
+		}
+		v, err := semver.NewVersion(pack.Version)
+		if err != nil || !constraint.Check(v) {
+			return nil, pack.Version, &errOutsidePin{uid: res.Ref(), version: pack.Version, pin: pin}
+		}
+	}
+
+	raw, err := bundle.ToYAML()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var sig *explorer.BundleSignature
+	if registry, ok := s.Registry.(SignedRegistry); ok {
+		sig, err = registry.GetBundleSignature(ctx, &explorer.Mrn{Mrn: packMrn})
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to fetch the signature of query pack "+packMrn)
+		}
+		if err := checkSignature(raw, sig, packMrn); err != nil {
+			return nil, "", err
+		}
+	}
+
+	path := s.bundlePath(res)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, "", errors.Wrap(err, "failed to create query pack directory")
+	}
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return nil, "", errors.Wrap(err, "failed to store query pack "+res.Ref())
+	}
+	sigPath := explorer.SignatureFilePath(path)
+	if sig != nil {
+		err = sig.Save(sigPath)
+	} else if err = os.Remove(sigPath); os.IsNotExist(err) {
+		err = nil
+	}
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to store the signature of query pack "+res.Ref())
+	}
+
+	res.Checksum = checksums.New.Add(string(raw)).String()
+	res.Signed = sig != nil
+	return res, pack.Version, nil
+}
+
+// checkSignature verifies the signature of a bundle file before it is
+// stored. Any key is trusted here, since scans check it with their policy.
+func checkSignature(raw []byte, sig *explorer.BundleSignature, source string) error {
+	if sig == nil {
+		return nil
+	}
+	bundle, err := explorer.BundleFromYAML(raw)
+	if err != nil {
+		return errors.Wrap(err, "failed to load query pack "+source)
+	}
+	var anyKey *explorer.SignaturePolicy
+	return anyKey.Check(bundle, sig, source)
+}
+
+// BundlePaths are the bundle files of the given installed packs. Packs are
+// given as "namespace/uid", or as UID if only one namespace has a pack with
+// it. Their content is verified against the checksums they were installed
+// with, and against their signatures with the given policy.
+func (s *Store) BundlePaths(policy *explorer.SignaturePolicy, refs ...string) ([]string, error) {
+	idx, err := s.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(refs))
+	for i, ref := range refs {
+		pack, err := idx.get(ref)
+		if err != nil {
+			return nil, err
+		}
+		name := pack.Ref()
+
+		if err := checkUid(pack.Uid); err != nil {
+			return nil, err
+		}
+		if err := checkUid(pack.namespace()); err != nil {
+			return nil, err
+		}
+
+		path := s.bundlePath(pack)
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read query pack "+name)
+		}
+		if checksums.New.Add(string(raw)).String() != pack.Checksum {
+			return nil, errors.New("query pack " + name + " was modified after it was installed, install it again")
+		}
+
+		sig, err := explorer.LoadBundleSignature(explorer.SignatureFilePath(path))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the signature of query pack "+name)
+		}
+		if sig == nil && pack.Signed {
+			return nil, errors.New("the signature of query pack " + name + " was removed after it was installed, install it again")
+		}
+		bundle, err := explorer.BundleFromYAML(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load query pack "+name)
+		}
+		if err := policy.Check(bundle, sig, path); err != nil {
+			return nil, err
+		}
+		res[i] = path
+	}
+	return res, nil
+}
+
+func (s *Store) bundlePath(pack *InstalledPack) string {
+	if pack.Namespace == "" {
+		return filepath.Join(s.Path, pack.Uid+".mql.yaml")
+	}
+	return filepath.Join(s.Path, pack.Namespace, pack.Uid+".mql.yaml")
+}
+
+// set adds or replaces a pack in the index. The bundle file of a replaced
+// pack is removed if the new one is stored elsewhere.
+func (s *Store) set(idx *index, pack *InstalledPack) {
+	old := idx.set(pack)
+	if old == nil {
+		return
+	}
+	if path := s.bundlePath(old); path != s.bundlePath(pack) {
+		os.Remove(path)
+		os.Remove(explorer.SignatureFilePath(path))
+	}
+}
+
+func (s *Store) loadIndex() (*index, error) {
+	raw, err := os.ReadFile(filepath.Join(s.Path, indexFile))
+	if os.IsNotExist(err) {
+		return &index{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &index{}
+	if err := yaml.Unmarshal(raw, res); err != nil {
+		return nil, errors.Wrap(err, "failed to read installed query packs")
+	}
+	return res, nil
+}
+
+func (s *Store) saveIndex(idx *index) error {
+	sort.Slice(idx.Packs, func(i, j int) bool {
+		return idx.Packs[i].Ref() < idx.Packs[j].Ref()
+	})
+	raw, err := yaml.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Path, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create query pack directory")
+	}
+	return os.WriteFile(filepath.Join(s.Path, indexFile), raw, 0o644)
+}
+
+// get finds a pack by "namespace/uid", or by its UID alone. A UID alone is
+// refused if packs of several namespaces have it.
+func (i *index) get(ref string) (*InstalledPack, error) {
+	namespace, uid := "", ref
+	if idx := strings.Index(ref, "/"); idx != -1 {
+		namespace, uid = ref[:idx], ref[idx+1:]
+	}
+
+	var found []*InstalledPack
+	for _, pack := range i.Packs {
+		if pack.Uid == uid && (namespace == "" || pack.namespace() == namespace) {
+			found = append(found, pack)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, errors.New("query pack " + ref + " is not installed")
+	case 1:
+		return found[0], nil
+	default:
+		refs := make([]string, len(found))
+		for j := range found {
+			refs[j] = found[j].Ref()
+		}
+		return nil, errors.New("query pack " + ref + " is installed from several namespaces, use one of: " + strings.Join(refs, ", "))
+	}
+}
+
+// set adds a pack to the index or replaces the pack with the same MRN,
+// which is returned
+func (i *index) set(pack *InstalledPack) *InstalledPack {
+	for j := range i.Packs {
+		if i.Packs[j].Mrn == pack.Mrn {
+			old := i.Packs[j]
+			i.Packs[j] = pack
+			return old
+		}
+	}
+	i.Packs = append(i.Packs, pack)
+	return nil
+}
+
+// packUid is the UID of a pack, which registries only keep in its MRN
+func packUid(pack *explorer.QueryPack) string {
+	if pack.Uid != "" {
+		return pack.Uid
+	}
+	uid, _ := mrn.GetResource(pack.Mrn, explorer.MRN_RESOURCE_QUERYPACK)
+	return uid
+}
@@ -0,0 +1,229 @@
+package packstore
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/internal/datalakes/inmemory"
+)
+
+// testRegistry is a registry on top of an in-memory datalake. Its services
+// are what a registry serves via its query hub.
+func testRegistry(t *testing.T) (*explorer.LocalServices, explorer.QueryHub) {
+	_, services, err := inmemory.NewServices()
+	require.NoError(t, err)
+	return services, services
+}
+
+// signedRegistry signs every bundle it serves
+type signedRegistry struct {
+	explorer.QueryHub
+	signer *explorer.BundleSigner
+}
+
+func (r *signedRegistry) GetBundleSignature(ctx context.Context, in *explorer.Mrn) (*explorer.BundleSignature, error) {
+	bundle, err := r.GetBundle(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := bundle.ToYAML()
+	if err != nil {
+		return nil, err
+	}
+	bundle, err = explorer.BundleFromYAML(raw)
+	if err != nil {
+		return nil, err
+	}
+	return r.signer.Sign(bundle)
+}
+
+// newSigner creates a signer and a policy that requires its signature
+func newSigner(t *testing.T) (*explorer.BundleSigner, *explorer.SignaturePolicy) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	signer, err := explorer.NewBundleSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+	require.NoError(t, err)
+
+	der, err = x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	policy := &explorer.SignaturePolicy{RequireSignature: true}
+	require.NoError(t, policy.AddTrusted(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+	return signer, policy
+}
+
+func publish(t *testing.T, services *explorer.LocalServices, namespace string, uid string, version string) {
+	bundle, err := explorer.BundleFromYAML([]byte(`
+packs:
+- uid: ` + uid + `
+  name: Pack ` + uid + `
+  version: ` + version + `
+  queries:
+  - uid: ` + uid + `-query
+    title: Query
+    mql: 1 + 1
+`))
+	require.NoError(t, err)
+	bundle.OwnerMrn = "//registry.mondoo.com/namespace/" + namespace
+
+	_, err = services.SetBundle(context.Background(), bundle)
+	require.NoError(t, err)
+}
+
+func TestParsePackRef(t *testing.T) {
+	ref, err := ParsePackRef("linux")
+	require.NoError(t, err)
+	assert.Equal(t, PackRef{Mrn: "//registry.mondoo.com/namespace/mondoohq/querypacks/linux"}, ref)
+
+	ref, err = ParsePackRef("acme/linux@^1.2")
+	require.NoError(t, err)
+	assert.Equal(t, PackRef{Mrn: "//registry.mondoo.com/namespace/acme/querypacks/linux", Pin: "^1.2"}, ref)
+
+	_, err = ParsePackRef("linux@nope")
+	assert.Error(t, err)
+
+	for _, invalid := range []string{"..", "acme/..", "../linux", "lin ux", "acme/lin:ux"} {
+		_, err = ParsePackRef(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	services, registry := testRegistry(t)
+	publish(t, services, DefaultNamespace, "linux", "1.0.0")
+	publish(t, services, DefaultNamespace, "windows", "1.0.0")
+
+	store := New(t.TempDir(), registry)
+
+	packs, err := store.Search(ctx, DefaultNamespace, "LIN")
+	require.NoError(t, err)
+	require.Len(t, packs, 1)
+	assert.Equal(t, "Pack linux", packs[0].Name)
+
+	ref, err := ParsePackRef("linux@^1.0")
+	require.NoError(t, err)
+	installed, err := store.Install(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", installed.Version)
+
+	list, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "mondoohq/linux", list[0].Ref())
+	assert.Equal(t, "^1.0", list[0].Pin)
+
+	paths, err := store.BundlePaths(nil, "linux")
+	require.NoError(t, err)
+	bundle, err := explorer.BundleFromPaths(paths...)
+	require.NoError(t, err)
+	require.Len(t, bundle.Packs, 1)
+	_, err = bundle.Compile(ctx)
+	require.NoError(t, err)
+
+	t.Run("updates stay within the pin", func(t *testing.T) {
+		publish(t, services, DefaultNamespace, "linux", "1.1.0")
+		updates, err := store.Update(ctx)
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		assert.Equal(t, "1.0.0", updates[0].OldVersion)
+		assert.Equal(t, "1.1.0", updates[0].Pack.Version)
+
+		updates, err = store.Update(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, updates)
+
+		publish(t, services, DefaultNamespace, "linux", "2.0.0")
+		updates, err = store.Update(ctx, "linux")
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		assert.True(t, updates[0].HeldBack)
+		assert.Equal(t, "2.0.0", updates[0].NewVersion)
+
+		list, err := store.List()
+		require.NoError(t, err)
+		assert.Equal(t, "1.1.0", list[0].Version)
+	})
+
+	t.Run("modified packs are not used", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(paths[0], []byte("packs: []"), 0o644))
+		_, err := store.BundlePaths(nil, "linux")
+		assert.ErrorContains(t, err, "was modified after it was installed")
+	})
+
+	t.Run("packs must be installed", func(t *testing.T) {
+		_, err := store.BundlePaths(nil, "macos")
+		assert.ErrorContains(t, err, "query pack macos is not installed")
+	})
+
+	t.Run("packs of other namespaces are kept apart", func(t *testing.T) {
+		publish(t, services, "acme", "linux", "3.0.0")
+		_, err := store.Install(ctx, PackRef{Mrn: explorer.NewQueryPackMrn("acme", "linux")})
+		require.NoError(t, err)
+
+		list, err := store.List()
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, "acme/linux", list[0].Ref())
+		assert.Equal(t, "3.0.0", list[0].Version)
+		assert.Equal(t, "mondoohq/linux", list[1].Ref())
+
+		_, err = store.BundlePaths(nil, "linux")
+		assert.ErrorContains(t, err, "query pack linux is installed from several namespaces, use one of: acme/linux, mondoohq/linux")
+
+		acme, err := store.BundlePaths(nil, "acme/linux")
+		require.NoError(t, err)
+		assert.NotEqual(t, paths, acme)
+		bundle, err := explorer.BundleFromPaths(acme...)
+		require.NoError(t, err)
+		require.Len(t, bundle.Packs, 1)
+		assert.Equal(t, "3.0.0", bundle.Packs[0].Version)
+	})
+
+	t.Run("unsigned packs are refused if signatures are required", func(t *testing.T) {
+		_, err := store.Install(ctx, PackRef{Mrn: explorer.NewQueryPackMrn(DefaultNamespace, "windows")})
+		require.NoError(t, err)
+		_, err = store.BundlePaths(&explorer.SignaturePolicy{RequireSignature: true}, "windows")
+		assert.ErrorContains(t, err, "is not signed")
+	})
+}
+
+func TestStoreSignatures(t *testing.T) {
+	ctx := context.Background()
+	services, registry := testRegistry(t)
+	publish(t, services, DefaultNamespace, "linux", "1.0.0")
+	signer, policy := newSigner(t)
+	store := New(t.TempDir(), &signedRegistry{QueryHub: registry, signer: signer})
+
+	ref, err := ParsePackRef("linux")
+	require.NoError(t, err)
+	installed, err := store.Install(ctx, ref)
+	require.NoError(t, err)
+	assert.True(t, installed.Signed)
+
+	paths, err := store.BundlePaths(policy, "linux")
+	require.NoError(t, err)
+	_, err = explorer.BundleFromPathsWithPolicy(policy, paths...)
+	require.NoError(t, err)
+
+	t.Run("other keys are not trusted", func(t *testing.T) {
+		_, other := newSigner(t)
+		_, err := store.BundlePaths(other, "linux")
+		assert.ErrorContains(t, err, "is not signed by a trusted key")
+	})
+
+	t.Run("removed signatures are noticed", func(t *testing.T) {
+		require.NoError(t, os.Remove(explorer.SignatureFilePath(paths[0])))
+		_, err := store.BundlePaths(nil, "linux")
+		assert.ErrorContains(t, err, "was removed after it was installed")
+	})
+}
@@ -31,17 +31,36 @@ func (p *Property) RefreshMRN(ownerMRN string) error {
 
 // Compile a given property and return the bundle.
 func (p *Property) Compile(props map[string]*llx.Primitive) (*llx.CodeBundle, error) {
+	return p.compile(props, nil)
+}
+
+// compile the property, unless its code is in the cache. The cache is optional.
+func (p *Property) compile(props map[string]*llx.Primitive, cache *CodeCache) (*llx.CodeBundle, error) {
+	var key string
+	if cache != nil {
+		key = codeKey(p.Mql, props, nil)
+		if code, ok := cache.get(key); ok {
+			return code, nil
+		}
+	}
+
 	schema := info.Registry.Schema()
-	return mqlc.Compile(p.Mql, props, mqlc.NewConfig(schema, cnquery.DefaultFeatures))
+	code, err := mqlc.Compile(p.Mql, props, mqlc.NewConfig(schema, cnquery.DefaultFeatures))
+	if err != nil {
+		return code, err
+	}
+
+	cache.add(key, code)
+	return code, nil
 }
 
 // RefreshChecksumAndType by compiling the query and updating the Checksum field
 func (p *Property) RefreshChecksumAndType() (*llx.CodeBundle, error) {
-	return p.refreshChecksumAndType()
+	return p.refreshChecksumAndType(nil)
 }
 
-func (p *Property) refreshChecksumAndType() (*llx.CodeBundle, error) {
-	bundle, err := p.Compile(nil)
+func (p *Property) refreshChecksumAndType(cache *CodeCache) (*llx.CodeBundle, error) {
+	bundle, err := p.compile(nil, cache)
 	if err != nil {
 		return bundle, errors.New("failed to compile property '" + p.Mql + "': " + err.Error())
 	}
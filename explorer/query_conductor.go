@@ -148,7 +148,7 @@ func (s *LocalServices) Resolve(ctx context.Context, req *ResolveReq) (*Resolved
 		props.Add(bundle.Props...)
 
 		for i := range pack.Queries {
-			err := s.addQueryToJob(ctx, pack.Queries[i], &job, props, supportedFilters, bundleMap)
+			err := s.addQueryToJob(ctx, pack.Queries[i], &job, props, pack.Functions, supportedFilters, bundleMap)
 			if err != nil {
 				return nil, err
 			}
@@ -162,7 +162,7 @@ func (s *LocalServices) Resolve(ctx context.Context, req *ResolveReq) (*Resolved
 			}
 
 			for i := range group.Queries {
-				err := s.addQueryToJob(ctx, group.Queries[i], &job, props, supportedFilters, bundleMap)
+				err := s.addQueryToJob(ctx, group.Queries[i], &job, props, pack.Functions, supportedFilters, bundleMap)
 				if err != nil {
 					return nil, err
 				}
@@ -183,7 +183,7 @@ func (s *LocalServices) Resolve(ctx context.Context, req *ResolveReq) (*Resolved
 	return res, err
 }
 
-func (s *LocalServices) addQueryToJob(ctx context.Context, query *Mquery, job *ExecutionJob, propsCache PropsCache, supportedFilters map[string]struct{}, bundle *BundleMap) error {
+func (s *LocalServices) addQueryToJob(ctx context.Context, query *Mquery, job *ExecutionJob, propsCache PropsCache, functions []*Function, supportedFilters map[string]struct{}, bundle *BundleMap) error {
 	if !query.Filters.Supports(supportedFilters) {
 		return nil
 	}
@@ -231,7 +231,7 @@ func (s *LocalServices) addQueryToJob(ctx context.Context, query *Mquery, job *E
 	if len(query.Variants) != 0 {
 		for i := range query.Variants {
 			ref := query.Variants[i].Mrn
-			err := s.addQueryToJob(ctx, bundle.Queries[ref], job, propsCache, supportedFilters, bundle)
+			err := s.addQueryToJob(ctx, bundle.Queries[ref], job, propsCache, functions, supportedFilters, bundle)
 			if err != nil {
 				return err
 			}
@@ -239,7 +239,7 @@ func (s *LocalServices) addQueryToJob(ctx context.Context, query *Mquery, job *E
 		return nil
 	}
 
-	codeBundle, err := query.Compile(props)
+	codeBundle, err := query.Compile(props, functions)
 	if err != nil {
 		return err
 	}
@@ -249,6 +249,7 @@ func (s *LocalServices) addQueryToJob(ctx context.Context, query *Mquery, job *E
 		Checksum:   query.Checksum,
 		Code:       codeBundle,
 		Properties: propRefs,
+		Timeout:    query.Timeout,
 	}
 
 	code := equery.Code.CodeV2
@@ -233,20 +233,25 @@ func (s *LocalServices) DefaultPacks(ctx context.Context, req *DefaultPacksReq)
 		return s.Upstream.DefaultPacks(ctx, req)
 	}
 
-	registryEndpoint := os.Getenv("REGISTRY_URL")
-	if registryEndpoint == "" {
-		registryEndpoint = defaultRegistryUrl
-	}
-
 	// Note, this does not use the proxy config override from the mondoo.yml since we only get here when
 	// it is used without upstream config
-	client, err := NewQueryHubClient(registryEndpoint, ranger.DefaultHttpClient())
+	client, err := NewRegistryClient(ranger.DefaultHttpClient())
 	if err != nil {
 		return nil, err
 	}
 	return client.DefaultPacks(ctx, req)
 }
 
+// NewRegistryClient connects to the public registry of query packs, or the
+// one set via REGISTRY_URL
+func NewRegistryClient(httpClient ranger.HTTPClient) (*QueryHubClient, error) {
+	registryEndpoint := os.Getenv("REGISTRY_URL")
+	if registryEndpoint == "" {
+		registryEndpoint = defaultRegistryUrl
+	}
+	return NewQueryHubClient(registryEndpoint, httpClient)
+}
+
 // HELPER METHODS
 // =================
 
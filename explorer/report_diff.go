@@ -0,0 +1,241 @@
+package explorer
+
+import (
+	"sort"
+
+	llx "go.mondoo.com/cnquery/llx"
+)
+
+// ReportDiff are the changes between two report collections, e.g. of two
+// scans of the same assets on different days
+type ReportDiff struct {
+	Assets []*AssetDiff
+}
+
+// AssetDiff are the changes of one asset's results. The asset is added if
+// it was only scanned the second time, and removed if it was only scanned
+// the first time.
+type AssetDiff struct {
+	Mrn     string
+	Name    string
+	Kind    llx.DeltaKind
+	Results []*ResultDiff
+	labels  map[string]string
+}
+
+// ResultDiff is how the result of one query code ID changed
+type ResultDiff struct {
+	CodeID string
+	// Query is the title or MQL of the query the result belongs to
+	Query string
+	// Label of the result in the query, e.g. "packages.list"
+	Label    string
+	Kind     llx.DeltaKind
+	OldError string
+	NewError string
+	Deltas   []*llx.Delta
+}
+
+// Labels of the asset's results, which name the fields of blocks
+func (a *AssetDiff) Labels() map[string]string {
+	return a.labels
+}
+
+// IsEmpty is true if nothing changed
+func (d *ReportDiff) IsEmpty() bool {
+	return len(d.Assets) == 0
+}
+
+// DiffReports compares two report collections. Assets are matched by their
+// MRN, or by their name if the MRNs differ, e.g. for local scans, which
+// create a new MRN every time. Their results are matched by code ID.
+func DiffReports(old *ReportCollection, new *ReportCollection) *ReportDiff {
+	res := &ReportDiff{}
+
+	// assets are matched by MRN first, so that names only match the rest
+	oldMrns := map[string]string{}
+	for mrn := range new.GetAssets() {
+		if _, ok := old.GetAssets()[mrn]; ok {
+			oldMrns[mrn] = mrn
+		}
+	}
+	matched := map[string]struct{}{}
+	for _, mrn := range oldMrns {
+		matched[mrn] = struct{}{}
+	}
+
+	// names are only used if they are unique
+	oldByName := map[string][]string{}
+	for mrn, asset := range old.GetAssets() {
+		if _, ok := matched[mrn]; !ok && asset.Name != "" {
+			oldByName[asset.Name] = append(oldByName[asset.Name], mrn)
+		}
+	}
+	newNames := map[string]int{}
+	for mrn, asset := range new.GetAssets() {
+		if _, ok := oldMrns[mrn]; !ok {
+			newNames[asset.Name]++
+		}
+	}
+	for mrn, asset := range new.GetAssets() {
+		if _, ok := oldMrns[mrn]; ok {
+			continue
+		}
+		if candidates := oldByName[asset.Name]; len(candidates) == 1 && newNames[asset.Name] == 1 {
+			oldMrns[mrn] = candidates[0]
+			matched[candidates[0]] = struct{}{}
+		}
+	}
+
+	for _, newMrn := range sortedKeys(new.GetAssets()) {
+		newAsset := new.Assets[newMrn]
+		oldMrn, ok := oldMrns[newMrn]
+		if !ok {
+			res.Assets = append(res.Assets, &AssetDiff{Mrn: newMrn, Name: newAsset.Name, Kind: llx.DeltaAdded})
+			continue
+		}
+
+		diff := diffAssetReports(old, oldMrn, new, newMrn)
+		if len(diff.Results) == 0 {
+			continue
+		}
+		diff.Name = newAsset.Name
+		res.Assets = append(res.Assets, diff)
+	}
+
+	for _, mrn := range sortedKeys(old.GetAssets()) {
+		if _, ok := matched[mrn]; ok {
+			continue
+		}
+		res.Assets = append(res.Assets, &AssetDiff{Mrn: mrn, Name: old.Assets[mrn].Name, Kind: llx.DeltaRemoved})
+	}
+
+	return res
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// resultInfo names the results of an asset via its resolved pack
+type resultInfo struct {
+	queries map[string]string
+	labels  map[string]string
+}
+
+func (r *resultInfo) add(collection *ReportCollection, assetMrn string) {
+	titles := map[string]string{}
+	if bundle := collection.GetBundle(); bundle != nil {
+		for _, query := range bundle.Queries {
+			titles[query.CodeId] = queryTitle(query)
+		}
+		for _, pack := range bundle.Packs {
+			for _, query := range pack.Queries {
+				titles[query.CodeId] = queryTitle(query)
+			}
+		}
+	}
+
+	resolved := collection.GetResolved()[assetMrn]
+	for codeID, query := range resolved.GetExecutionJob().GetQueries() {
+		code := query.GetCode()
+		title := titles[codeID]
+		if title == "" {
+			title = code.GetSource()
+		}
+
+		for _, checksum := range code.GetCodeV2().GetChecksums() {
+			if _, ok := r.queries[checksum]; !ok {
+				r.queries[checksum] = title
+			}
+		}
+		for checksum, label := range code.GetLabels().GetLabels() {
+			r.labels[checksum] = label
+		}
+	}
+}
+
+func queryTitle(query *Mquery) string {
+	if query.Title != "" {
+		return query.Title
+	}
+	return query.Mql
+}
+
+func diffAssetReports(old *ReportCollection, oldMrn string, new *ReportCollection, newMrn string) *AssetDiff {
+	info := resultInfo{queries: map[string]string{}, labels: map[string]string{}}
+	info.add(old, oldMrn)
+	info.add(new, newMrn)
+
+	res := &AssetDiff{Mrn: newMrn, Kind: llx.DeltaChanged, labels: info.labels}
+
+	oldData := old.GetReports()[oldMrn].GetData()
+	newData := new.GetReports()[newMrn].GetData()
+
+	ids := sortedKeys(oldData)
+	for id := range newData {
+		if _, ok := oldData[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	for _, id := range ids {
+		diff := diffResults(oldData[id], newData[id], info.labels)
+		if diff == nil {
+			continue
+		}
+		diff.CodeID = id
+		diff.Query = info.queries[id]
+		diff.Label = info.labels[id]
+		res.Results = append(res.Results, diff)
+	}
+
+	sort.SliceStable(res.Results, func(i, j int) bool {
+		a, b := res.Results[i], res.Results[j]
+		if a.Query != b.Query {
+			return a.Query < b.Query
+		}
+		if a.Label != b.Label {
+			return a.Label < b.Label
+		}
+		return a.CodeID < b.CodeID
+	})
+
+	return res
+}
+
+// diffResults compares two results and returns nil if they are the same
+func diffResults(old *llx.Result, new *llx.Result, labels map[string]string) *ResultDiff {
+	switch {
+	case old == nil && new == nil:
+		return nil
+	case old == nil:
+		return &ResultDiff{
+			Kind:     llx.DeltaAdded,
+			NewError: new.Error,
+			Deltas:   llx.DiffPrimitives(nil, new.Data, labels),
+		}
+	case new == nil:
+		return &ResultDiff{
+			Kind:     llx.DeltaRemoved,
+			OldError: old.Error,
+			Deltas:   llx.DiffPrimitives(old.Data, nil, labels),
+		}
+	}
+
+	deltas := llx.DiffPrimitives(old.Data, new.Data, labels)
+	if len(deltas) == 0 && old.Error == new.Error {
+		return nil
+	}
+	return &ResultDiff{
+		Kind:     llx.DeltaChanged,
+		OldError: old.Error,
+		NewError: new.Error,
+		Deltas:   deltas,
+	}
+}
@@ -0,0 +1,63 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+)
+
+func testReportCollection(assets map[string]string, data map[string]map[string]*llx.Primitive) *ReportCollection {
+	res := &ReportCollection{
+		Assets:  map[string]*Asset{},
+		Reports: map[string]*Report{},
+	}
+	for mrn, name := range assets {
+		res.Assets[mrn] = &Asset{Mrn: mrn, Name: name}
+		report := &Report{EntityMrn: mrn, Data: map[string]*llx.Result{}}
+		for id, value := range data[name] {
+			report.Data[id] = &llx.Result{CodeId: id, Data: value}
+		}
+		res.Reports[mrn] = report
+	}
+	return res
+}
+
+func TestDiffReports(t *testing.T) {
+	old := testReportCollection(
+		map[string]string{"//assets/1": "web", "//assets/2": "db"},
+		map[string]map[string]*llx.Primitive{
+			"web": {"a": llx.StringPrimitive("1.0"), "b": llx.BoolPrimitive(true), "c": llx.IntPrimitive(1)},
+			"db":  {"a": llx.StringPrimitive("1.0")},
+		},
+	)
+	// local scans create new MRNs, so the web asset is matched by name
+	new := testReportCollection(
+		map[string]string{"//assets/3": "web", "//assets/4": "cache"},
+		map[string]map[string]*llx.Primitive{
+			"web":   {"a": llx.StringPrimitive("1.1"), "c": llx.IntPrimitive(1), "d": llx.IntPrimitive(4)},
+			"cache": {"a": llx.StringPrimitive("1.0")},
+		},
+	)
+
+	diff := DiffReports(old, new)
+	require.Len(t, diff.Assets, 3)
+
+	web := diff.Assets[0]
+	assert.Equal(t, "//assets/3", web.Mrn)
+	assert.Equal(t, llx.DeltaChanged, web.Kind)
+	require.Len(t, web.Results, 3)
+	assert.Equal(t, "a", web.Results[0].CodeID)
+	assert.Equal(t, llx.DeltaChanged, web.Results[0].Kind)
+	require.Len(t, web.Results[0].Deltas, 1)
+	assert.Equal(t, "b", web.Results[1].CodeID)
+	assert.Equal(t, llx.DeltaRemoved, web.Results[1].Kind)
+	assert.Equal(t, "d", web.Results[2].CodeID)
+	assert.Equal(t, llx.DeltaAdded, web.Results[2].Kind)
+
+	assert.Equal(t, &AssetDiff{Mrn: "//assets/4", Name: "cache", Kind: llx.DeltaAdded}, diff.Assets[1])
+	assert.Equal(t, &AssetDiff{Mrn: "//assets/2", Name: "db", Kind: llx.DeltaRemoved}, diff.Assets[2])
+
+	assert.True(t, DiffReports(old, old).IsEmpty())
+}
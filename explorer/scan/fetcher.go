@@ -2,11 +2,9 @@ package scan
 
 import (
 	"context"
-	"io"
 	"net/http"
 
 	"github.com/pkg/errors"
-	"go.mondoo.com/cnquery"
 	"go.mondoo.com/cnquery/explorer"
 )
 
@@ -20,8 +18,11 @@ func newFetcher() *fetcher {
 	}
 }
 
+// fetchBundles downloads all bundles and checks them against the signature
+// policy of the context
 func (f *fetcher) fetchBundles(ctx context.Context, urls ...string) (*explorer.Bundle, error) {
 	var res *explorer.Bundle = &explorer.Bundle{}
+	policy := explorer.GetSignaturePolicy(ctx)
 
 	for i := range urls {
 		url := urls[i]
@@ -30,7 +31,7 @@ func (f *fetcher) fetchBundles(ctx context.Context, urls ...string) (*explorer.B
 			continue
 		}
 
-		cur, err := f.fetchBundle(url)
+		cur, err := f.fetchBundle(url, policy)
 		if err != nil {
 			return nil, err
 		}
@@ -48,35 +49,12 @@ func (f *fetcher) fetchBundles(ctx context.Context, urls ...string) (*explorer.B
 	return res, nil
 }
 
-func (f *fetcher) fetchBundle(url string) (*explorer.Bundle, error) {
+func (f *fetcher) fetchBundle(url string, policy *explorer.SignaturePolicy) (*explorer.Bundle, error) {
 	client := http.Client{
 		CheckRedirect: func(r *http.Request, via []*http.Request) error {
 			r.URL.Opaque = r.URL.Path
 			return nil
 		},
 	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to set up request to fetch bundle")
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; cnquery/"+cnquery.Version+"; +http://www.mondoo.com)")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, errors.New("failed to fetch policy bundle from " + url + ": " + resp.Status)
-	}
-
-	raw, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	return explorer.BundleFromYAML(raw)
+	return explorer.FetchBundle(&client, url, policy)
 }
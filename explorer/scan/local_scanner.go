@@ -19,6 +19,7 @@ import (
 	"go.mondoo.com/cnquery/explorer"
 	"go.mondoo.com/cnquery/explorer/executor"
 	"go.mondoo.com/cnquery/internal/datalakes/inmemory"
+	"go.mondoo.com/cnquery/internal/datalakes/sqlite"
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/logger"
 	"go.mondoo.com/cnquery/motor"
@@ -43,6 +44,10 @@ type LocalScanner struct {
 	spaceMrn    string
 	plugins     []ranger.ClientPlugin
 	httpClient  *http.Client
+
+	// datalake is the path of a database that keeps bundles and results
+	// after the scan, which are only kept in memory if it is empty
+	datalake string
 }
 
 type ScannerOption func(*LocalScanner)
@@ -59,6 +64,14 @@ func WithUpstream(apiEndpoint string, spaceMrn string, plugins []ranger.ClientPl
 	}
 }
 
+// WithDatalake stores all bundles, resolved packs and results in a database
+// at the given path, so they are kept after the scan
+func WithDatalake(path string) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.datalake = path
+	}
+}
+
 func NewLocalScanner(opts ...ScannerOption) *LocalScanner {
 	ls := &LocalScanner{
 		fetcher: newFetcher(),
@@ -363,7 +376,7 @@ func (s *LocalScanner) runMotorizedAsset(job *AssetJob) (*AssetReport, error) {
 	var res *AssetReport
 	var scanErr error
 
-	runtimeErr := inmemory.WithDb(func(db *inmemory.Db, services *explorer.LocalServices) error {
+	runtimeErr := s.withDatalake(func(db explorer.DataLake, services *explorer.LocalServices) error {
 		if job.UpstreamConfig.ApiEndpoint != "" && !job.UpstreamConfig.Incognito {
 			log.Debug().Msg("using API endpoint " + s.apiEndpoint)
 			upstream, err := explorer.NewRemoteServices(s.apiEndpoint, s.plugins, s.httpClient)
@@ -397,8 +410,21 @@ func (s *LocalScanner) runMotorizedAsset(job *AssetJob) (*AssetReport, error) {
 	return res, scanErr
 }
 
+// withDatalake runs f with the scanner's datalake, which is kept in memory
+// unless a path was configured
+func (s *LocalScanner) withDatalake(f func(db explorer.DataLake, services *explorer.LocalServices) error) error {
+	if s.datalake == "" {
+		return inmemory.WithDb(func(db *inmemory.Db, services *explorer.LocalServices) error {
+			return f(db, services)
+		})
+	}
+	return sqlite.WithDb(s.datalake, func(db *sqlite.Db, services *explorer.LocalServices) error {
+		return f(db, services)
+	})
+}
+
 type localAssetScanner struct {
-	db       *inmemory.Db
+	db       explorer.DataLake
 	services *explorer.LocalServices
 	job      *AssetJob
 	fetcher  *fetcher
@@ -0,0 +1,256 @@
+package explorer
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alecthomas/participle/lexer"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/mrn"
+	"gopkg.in/yaml.v3"
+)
+
+// QueryError is an error in one query of a bundle
+type QueryError struct {
+	// Mrn of the query
+	Mrn string
+	Err error
+}
+
+func (e *QueryError) Error() string {
+	return "failed to validate query '" + e.Mrn + "': " + e.Err.Error()
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// BundleError collects all errors that were found while compiling a bundle
+type BundleError struct {
+	Errors []error
+}
+
+func (e *BundleError) Error() string {
+	var msg strings.Builder
+	for i := range e.Errors {
+		msg.WriteString(e.Errors[i].Error())
+		msg.WriteString("\n")
+	}
+	return msg.String()
+}
+
+// QuerySource is the place in a bundle file where a query's code is defined
+type QuerySource struct {
+	File string
+	// Code of the query, as it is written in the file
+	Code string
+	text string
+	// offsets maps every byte of the code to its offset in the file
+	offsets []int
+}
+
+// Excerpt of the file with a caret that points to the given position in
+// the query's code
+func (s *QuerySource) Excerpt(pos lexer.Position, length int) string {
+	if pos.Offset >= len(s.offsets) {
+		return ""
+	}
+	end := pos.Offset + length
+	if end >= len(s.offsets) {
+		end = len(s.offsets) - 1
+	}
+
+	start := s.offsets[pos.Offset]
+	return mqlc.Excerpt(s.File, s.text, start, s.offsets[end]-start)
+}
+
+// QuerySources maps the UIDs of queries to their sources
+type QuerySources map[string]*QuerySource
+
+// LoadQuerySources finds where all queries are defined in the given bundle
+// files or directories
+func LoadQuerySources(paths ...string) (QuerySources, error) {
+	files, err := walkBundleFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	res := QuerySources{}
+	for i := range files {
+		data, err := os.ReadFile(files[i])
+		if err != nil {
+			return nil, err
+		}
+		if err := res.Add(files[i], string(data)); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Add all queries that are defined in a bundle file
+func (s QuerySources) Add(file string, text string) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(text), &root); err != nil {
+		return err
+	}
+	if len(root.Content) == 0 {
+		return nil
+	}
+	bundle := root.Content[0]
+
+	s.addQueries(file, text, YamlValue(bundle, "queries"))
+	for _, pack := range YamlItems(YamlValue(bundle, "packs")) {
+		s.addQueries(file, text, YamlValue(pack, "queries"))
+		for _, group := range YamlItems(YamlValue(pack, "groups")) {
+			s.addQueries(file, text, YamlValue(group, "queries"))
+		}
+	}
+	return nil
+}
+
+func (s QuerySources) addQueries(file string, text string, node *yaml.Node) {
+	for _, query := range YamlItems(node) {
+		uid := YamlValue(query, "uid")
+		mql := YamlValue(query, "mql")
+		if mql == nil {
+			mql = YamlValue(query, "query")
+		}
+		if uid == nil || mql == nil {
+			continue
+		}
+
+		s[uid.Value] = &QuerySource{
+			File:    file,
+			Code:    mql.Value,
+			text:    text,
+			offsets: MapYamlValue(text, mql),
+		}
+	}
+}
+
+// Lookup the source of a query by its MRN or UID
+func (s QuerySources) Lookup(id string) *QuerySource {
+	if res, ok := s[id]; ok {
+		return res
+	}
+	if uid, err := mrn.GetResource(id, MRN_RESOURCE_QUERY); err == nil {
+		return s[uid]
+	}
+	return nil
+}
+
+// Explain an error from compiling a bundle. Errors in the code of queries
+// come with an excerpt that points to where they happened, either in the
+// bundle file or in the query itself.
+func (s QuerySources) Explain(err error) string {
+	errs := []error{err}
+	var bundleErr *BundleError
+	if errors.As(err, &bundleErr) {
+		errs = bundleErr.Errors
+	}
+
+	var res strings.Builder
+	for i := range errs {
+		res.WriteString(errs[i].Error())
+		res.WriteString("\n")
+		if excerpt := s.excerpt(errs[i]); excerpt != "" {
+			res.WriteString(excerpt)
+			res.WriteString("\n")
+		}
+	}
+	return res.String()
+}
+
+func (s QuerySources) excerpt(err error) string {
+	var compileErr *mqlc.CompileError
+	if !errors.As(err, &compileErr) || compileErr.Pos.Line == 0 {
+		return ""
+	}
+
+	var queryErr *QueryError
+	if errors.As(err, &queryErr) {
+		// we can only map the error back if the code wasn't changed,
+		// e.g. by dedenting it
+		if source := s.Lookup(queryErr.Mrn); source != nil && source.Code == compileErr.Source {
+			return source.Excerpt(compileErr.Pos, compileErr.Length)
+		}
+	}
+	return compileErr.Excerpt()
+}
+
+// MapYamlValue maps every byte of a YAML node's value to its offset in the
+// YAML text, including one extra entry for the end of the value. Values may
+// be quoted, indented, or folded over multiple lines, so we walk through the
+// text and match it with the value char by char.
+func MapYamlValue(text string, node *yaml.Node) []int {
+	res := make([]int, len(node.Value)+1)
+	j := YamlOffset(text, node)
+
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		// block scalars start on the line after their indicator
+		if idx := strings.IndexByte(text[j:], '\n'); idx != -1 {
+			j += idx + 1
+		}
+	}
+
+	value := node.Value
+	for i := 0; i < len(value); i++ {
+		// skip quotes, escapes, line breaks, and indentation; if we still
+		// don't find the char, it is escaped and we approximate its position
+		k := j
+		for k < len(text) && text[k] != value[i] && strings.IndexByte(" \t\r\n\"'\\", text[k]) != -1 {
+			k++
+		}
+		if k < len(text) && text[k] == value[i] {
+			j = k
+		}
+		res[i] = j
+		j++
+	}
+	res[len(value)] = j
+	return res
+}
+
+// YamlOffset is the offset of a YAML node in its text
+func YamlOffset(text string, node *yaml.Node) int {
+	// lines and columns of nodes start at 1, columns count runes
+	j := 0
+	for line := 1; line < node.Line && j < len(text); line++ {
+		idx := strings.IndexByte(text[j:], '\n')
+		if idx == -1 {
+			return len(text)
+		}
+		j += idx + 1
+	}
+	for col := 1; col < node.Column && j < len(text) && text[j] != '\n'; col++ {
+		_, size := utf8.DecodeRuneInString(text[j:])
+		j += size
+	}
+	return j
+}
+
+// YamlValue returns the value of a key in a YAML mapping, or nil if the
+// node isn't a mapping or doesn't have the key
+func YamlValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// YamlItems returns the items of a YAML sequence, or nil if the node
+// isn't a sequence
+func YamlItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
@@ -180,6 +180,10 @@ func (db *Db) listQueryPacks() (map[string]struct{}, error) {
 func (db *Db) GetBundle(ctx context.Context, mrn string) (*explorer.Bundle, error) {
 	x, ok := db.cache.Get(dbIDAsset + mrn)
 	if !ok {
+		// every query pack is a bundle of its own, e.g. for registries
+		if pack, err := db.GetQueryPack(ctx, mrn); err == nil {
+			return &explorer.Bundle{OwnerMrn: pack.OwnerMrn, Packs: []*explorer.QueryPack{pack}}, nil
+		}
 		return nil, errors.New("failed to find asset " + mrn)
 	}
 
@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/explorer"
+	"google.golang.org/protobuf/proto"
+)
+
+type wrapAsset struct {
+	mrn             string
+	ResolvedPack    *explorer.ResolvedPack
+	ResolvedVersion explorer.ResolvedVersion
+	Bundle          *explorer.Bundle
+}
+
+// EnsureAsset makes sure an asset exists
+func (db *Db) EnsureAsset(ctx context.Context, mrn string) error {
+	_, _, err := ensureAssetObject(ctx, db.sql, mrn)
+	return err
+}
+
+func ensureAssetObject(ctx context.Context, q querier, mrn string) (wrapAsset, bool, error) {
+	log.Debug().Str("mrn", mrn).Msg("assets> ensure asset")
+
+	assetw, ok, err := getAsset(ctx, q, mrn)
+	if err != nil || ok {
+		return assetw, false, err
+	}
+
+	assetw = wrapAsset{
+		mrn: mrn,
+		Bundle: &explorer.Bundle{
+			OwnerMrn: mrn,
+		},
+	}
+	if err := setAssetBundle(ctx, q, mrn, assetw.Bundle); err != nil {
+		return wrapAsset{}, false, errors.New("failed to create asset '" + mrn + "': " + err.Error())
+	}
+
+	return assetw, true, nil
+}
+
+// getAsset retrieves an asset with its bundle and resolved pack. It returns
+// false if the asset doesn't exist.
+func getAsset(ctx context.Context, q querier, mrn string) (wrapAsset, bool, error) {
+	var rawBundle, rawResolved []byte
+	var version string
+	err := q.QueryRowContext(ctx,
+		"SELECT bundle, resolved_pack, resolved_version FROM assets WHERE mrn = ?", mrn,
+	).Scan(&rawBundle, &rawResolved, &version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return wrapAsset{}, false, nil
+	}
+	if err != nil {
+		return wrapAsset{}, false, err
+	}
+
+	res := wrapAsset{
+		mrn:             mrn,
+		Bundle:          &explorer.Bundle{},
+		ResolvedVersion: explorer.ResolvedVersion(version),
+	}
+	if err := proto.Unmarshal(rawBundle, res.Bundle); err != nil {
+		return wrapAsset{}, false, errors.New("failed to read bundle of asset '" + mrn + "': " + err.Error())
+	}
+	if rawResolved != nil {
+		res.ResolvedPack = &explorer.ResolvedPack{}
+		if err := proto.Unmarshal(rawResolved, res.ResolvedPack); err != nil {
+			return wrapAsset{}, false, errors.New("failed to read resolved pack of asset '" + mrn + "': " + err.Error())
+		}
+	}
+
+	return res, true, nil
+}
+
+// setAssetBundle stores the bundle of an asset, creating the asset if it
+// doesn't exist yet
+func setAssetBundle(ctx context.Context, q querier, mrn string, bundle *explorer.Bundle) error {
+	raw, err := proto.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx,
+		"INSERT INTO assets (mrn, bundle) VALUES (?, ?) ON CONFLICT (mrn) DO UPDATE SET bundle = excluded.bundle",
+		mrn, raw)
+	return err
+}
@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+)
+
+// migrations turn the database schema from one version into the next. The
+// version of a database is the number of migrations that ran on it, so new
+// migrations are only ever appended to this list.
+var migrations = []string{
+	// 1: queries, packs, assets and their results
+	`
+CREATE TABLE queries (
+	mrn  TEXT PRIMARY KEY,
+	data BLOB NOT NULL
+);
+
+CREATE TABLE query_packs (
+	mrn       TEXT PRIMARY KEY,
+	owner_mrn TEXT NOT NULL,
+	data      BLOB NOT NULL
+);
+CREATE INDEX query_packs_owner ON query_packs (owner_mrn);
+
+CREATE TABLE assets (
+	mrn              TEXT PRIMARY KEY,
+	bundle           BLOB NOT NULL,
+	resolved_pack    BLOB,
+	resolved_version TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE resolved_packs (
+	mrn              TEXT PRIMARY KEY,
+	filters_checksum TEXT NOT NULL,
+	data             BLOB NOT NULL
+);
+
+CREATE TABLE data (
+	asset_mrn TEXT NOT NULL,
+	checksum  TEXT NOT NULL,
+	value     BLOB,
+	PRIMARY KEY (asset_mrn, checksum)
+);
+
+CREATE TABLE data_history (
+	asset_mrn  TEXT NOT NULL,
+	checksum   TEXT NOT NULL,
+	value      BLOB NOT NULL,
+	created_at INTEGER NOT NULL
+);
+CREATE INDEX data_history_datum ON data_history (asset_mrn, checksum, created_at);
+`,
+}
+
+// migrate the database to the latest schema. Every migration runs in its
+// own transaction, so a failed migration leaves the previous version intact.
+func migrate(ctx context.Context, db *sql.DB) error {
+	var version int
+	if err := db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return errors.New("failed to read datalake version: " + err.Error())
+	}
+
+	if version > len(migrations) {
+		return errors.New("datalake was created by a newer version (schema version " +
+			strconv.Itoa(version) + ", supported up to " + strconv.Itoa(len(migrations)) + ")")
+	}
+
+	for i := version; i < len(migrations); i++ {
+		log.Debug().Int("version", i+1).Msg("datalake> migrate schema")
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+			tx.Rollback()
+			return errors.New("failed to migrate datalake to version " + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+		// pragmas don't support placeholders
+		if _, err := tx.ExecContext(ctx, "PRAGMA user_version = "+strconv.Itoa(i+1)); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,377 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func (db *Db) SetResolvedPack(mrn string, filtersChecksum string, resolved *explorer.ResolvedPack) error {
+	raw, err := proto.Marshal(resolved)
+	if err != nil {
+		return err
+	}
+	_, err = db.sql.Exec(
+		"INSERT INTO resolved_packs (mrn, filters_checksum, data) VALUES (?, ?, ?) "+
+			"ON CONFLICT (mrn) DO UPDATE SET filters_checksum = excluded.filters_checksum, data = excluded.data",
+		mrn, filtersChecksum, raw)
+	if err != nil {
+		return errors.New("failed to save resolved pack '" + mrn + "': " + err.Error())
+	}
+	return nil
+}
+
+func (db *Db) SetAssetResolvedPack(ctx context.Context, assetMrn string, resolved *explorer.ResolvedPack, version explorer.ResolvedVersion) error {
+	return db.inTx(ctx, func(tx *sql.Tx) error {
+		assetw, ok, err := getAsset(ctx, tx, assetMrn)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("cannot find asset '" + assetMrn + "'")
+		}
+
+		if assetw.ResolvedPack != nil && assetw.ResolvedPack.GraphExecutionChecksum == resolved.GraphExecutionChecksum && string(assetw.ResolvedVersion) == string(version) {
+			log.Debug().
+				Str("asset", assetMrn).
+				Msg("resolverj.db> asset resolved query pack is already cached (and unchanged)")
+			return nil
+		}
+
+		job := resolved.ExecutionJob
+		for checksum := range job.Datapoints {
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO data (asset_mrn, checksum) VALUES (?, ?) ON CONFLICT DO NOTHING",
+				assetMrn, checksum)
+			if err != nil {
+				log.Error().
+					Err(err).
+					Str("asset", assetMrn).
+					Str("query checksum", checksum).
+					Msg("resolver.db> failed to set asset resolved pack, failed to initialize data value")
+				return errors.New("failed to create asset scoring job (failed to init data)")
+			}
+		}
+
+		raw, err := proto.Marshal(resolved)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			"UPDATE assets SET resolved_pack = ?, resolved_version = ? WHERE mrn = ?",
+			raw, string(version), assetMrn)
+		if err != nil {
+			return errors.New("failed to save resolved pack for asset '" + assetMrn + "': " + err.Error())
+		}
+		return nil
+	})
+}
+
+func (db *Db) GetResolvedPack(mrn string) (*explorer.ResolvedPack, error) {
+	res := &explorer.ResolvedPack{}
+	ok, err := getProto(context.Background(), db.sql, res, "SELECT data FROM resolved_packs WHERE mrn = ?", mrn)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("query '" + mrn + "' not found")
+	}
+	return res, nil
+}
+
+var errTypesDontMatch = errors.New("types don't match")
+
+// UpdateData sets the list of data value for a given asset and returns a list of updated IDs
+func (db *Db) UpdateData(ctx context.Context, assetMrn string, data map[string]*llx.Result) (map[string]types.Type, error) {
+	resolved, err := db.GetResolvedPack(assetMrn)
+	if err != nil {
+		return nil, errors.New("cannot find collectorJob to store data: " + err.Error())
+	}
+	executionJob := resolved.ExecutionJob
+
+	res := make(map[string]types.Type, len(data))
+	var errList error
+	err = db.inTx(ctx, func(tx *sql.Tx) error {
+		now := db.nowProvider()
+		for dpChecksum, val := range data {
+			info, ok := executionJob.Datapoints[dpChecksum]
+			if !ok {
+				return errors.New("cannot find this datapoint to store values: " + dpChecksum)
+			}
+
+			if val.Data != nil && !val.Data.IsNil() && val.Data.Type != "" &&
+				val.Data.Type != info.Type && types.Type(info.Type) != types.Unset {
+				log.Warn().
+					Str("checksum", dpChecksum).
+					Str("asset", assetMrn).
+					Interface("data", val.Data).
+					Str("expected", types.Type(info.Type).Label()).
+					Str("received", types.Type(val.Data.Type).Label()).
+					Msg("resolver.db> failed to store data, types don't match")
+
+				errList = multierror.Append(errList, fmt.Errorf("failed to store data for %q, %w: expected %s, got %s",
+					dpChecksum, errTypesDontMatch, types.Type(info.Type).Label(), types.Type(val.Data.Type).Label()))
+
+				continue
+			}
+
+			err := setDatum(ctx, tx, assetMrn, dpChecksum, val, now, db.historyLimit)
+			if err != nil {
+				errList = multierror.Append(errList, err)
+				continue
+			}
+
+			// TODO: we don't know which data was updated and which wasn't yet, so
+			// we currently always notify...
+			res[dpChecksum] = types.Type(info.Type)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if errList != nil {
+		return nil, errList
+	}
+
+	return res, nil
+}
+
+// setDatum stores the current value of a datapoint and adds it to its
+// history, which keeps the newest values up to the limit, unless it is 0
+func setDatum(ctx context.Context, tx *sql.Tx, assetMrn string, checksum string, value *llx.Result, now time.Time, historyLimit int) error {
+	raw, err := proto.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO data (asset_mrn, checksum, value) VALUES (?, ?, ?) "+
+			"ON CONFLICT (asset_mrn, checksum) DO UPDATE SET value = excluded.value",
+		assetMrn, checksum, raw)
+	if err == nil {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO data_history (asset_mrn, checksum, value, created_at) VALUES (?, ?, ?, ?)",
+			assetMrn, checksum, raw, now.UnixNano())
+	}
+	if err == nil && historyLimit > 0 {
+		_, err = tx.ExecContext(ctx,
+			"DELETE FROM data_history WHERE asset_mrn = ? AND checksum = ? AND rowid NOT IN "+
+				"(SELECT rowid FROM data_history WHERE asset_mrn = ? AND checksum = ? "+
+				"ORDER BY created_at DESC, rowid DESC LIMIT ?)",
+			assetMrn, checksum, assetMrn, checksum, historyLimit)
+	}
+	if err != nil {
+		return errors.New("failed to save asset data for asset '" + assetMrn + "' and checksum '" + checksum + "': " + err.Error())
+	}
+	return nil
+}
+
+// GetReport retrieves all scores and data for a given asset
+func (db *Db) GetReport(ctx context.Context, assetMrn string, packMrn string) (*explorer.Report, error) {
+	assetw, ok, err := getAsset(ctx, db.sql, assetMrn)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("cannot find asset '" + assetMrn + "'")
+	}
+	if assetw.ResolvedPack == nil {
+		return nil, errors.New("asset '" + assetMrn + "' has no resolved pack")
+	}
+
+	rows, err := db.sql.QueryContext(ctx, "SELECT checksum, value FROM data WHERE asset_mrn = ?", assetMrn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	datapoints := assetw.ResolvedPack.ExecutionJob.Datapoints
+	data := map[string]*llx.Result{}
+	for rows.Next() {
+		var id string
+		var raw []byte
+		if err := rows.Scan(&id, &raw); err != nil {
+			return nil, err
+		}
+		if _, ok := datapoints[id]; !ok {
+			continue
+		}
+
+		if raw == nil {
+			data[id] = &llx.Result{
+				Data:   llx.NilPrimitive,
+				CodeId: id,
+			}
+			continue
+		}
+
+		datum := &llx.Result{}
+		if err := proto.Unmarshal(raw, datum); err != nil {
+			return nil, err
+		}
+		data[id] = datum
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &explorer.Report{
+		PackMrn:   packMrn,
+		EntityMrn: assetMrn,
+		Data:      data,
+	}, nil
+}
+
+// Datum is the value of a datapoint at the time it was stored
+type Datum struct {
+	Result  *llx.Result
+	Created time.Time
+}
+
+// GetDataHistory retrieves all values that were stored for a datapoint of
+// an asset, from oldest to newest
+func (db *Db) GetDataHistory(ctx context.Context, assetMrn string, checksum string) ([]Datum, error) {
+	rows, err := db.sql.QueryContext(ctx,
+		"SELECT value, created_at FROM data_history WHERE asset_mrn = ? AND checksum = ? ORDER BY created_at, rowid",
+		assetMrn, checksum)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []Datum{}
+	for rows.Next() {
+		var raw []byte
+		var created int64
+		if err := rows.Scan(&raw, &created); err != nil {
+			return nil, err
+		}
+		datum := Datum{Result: &llx.Result{}, Created: time.Unix(0, created)}
+		if err := proto.Unmarshal(raw, datum.Result); err != nil {
+			return nil, err
+		}
+		res = append(res, datum)
+	}
+
+	return res, rows.Err()
+}
+
+// SetProps will override properties for a given entity (asset, space, org)
+func (db *Db) SetProps(ctx context.Context, req *explorer.PropsReq) error {
+	return db.inTx(ctx, func(tx *sql.Tx) error {
+		asset, ok, err := getAsset(ctx, tx, req.EntityMrn)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("failed to find entity " + req.EntityMrn)
+		}
+
+		allProps := make(map[string]*explorer.Property, len(asset.Bundle.Props))
+		for i := range asset.Bundle.Props {
+			cur := asset.Bundle.Props[i]
+			if cur.Mrn != "" {
+				allProps[cur.Mrn] = cur
+			}
+			if cur.Uid != "" {
+				allProps[cur.Uid] = cur
+			}
+		}
+
+		for i := range req.Props {
+			cur := req.Props[i]
+			id := cur.Mrn
+			if id == "" {
+				id = cur.Uid
+			}
+			if id == "" {
+				return errors.New("cannot set property without MRN: " + cur.Mql)
+			}
+
+			if cur.Mql == "" {
+				delete(allProps, id)
+			}
+			allProps[id] = cur
+		}
+
+		asset.Bundle.Props = []*explorer.Property{}
+		for k, v := range allProps {
+			// since props can be in the list with both UIDs and MRNs, in the case
+			// where a property sets both we want to ignore one entry to avoid duplicates
+			if v.Mrn != "" && v.Uid != "" && k == v.Uid {
+				continue
+			}
+			asset.Bundle.Props = append(asset.Bundle.Props, v)
+		}
+
+		return setAssetBundle(ctx, tx, req.EntityMrn, asset.Bundle)
+	})
+}
+
+// ReportCollectionAt retrieves the results of all assets as they were at the
+// given time, i.e. the latest value of every datapoint that was stored up to
+// then. Assets without any results at that time are not included.
+func (db *Db) ReportCollectionAt(ctx context.Context, t time.Time) (*explorer.ReportCollection, error) {
+	rows, err := db.sql.QueryContext(ctx,
+		"SELECT h.asset_mrn, h.checksum, h.value FROM data_history h "+
+			"WHERE h.rowid = (SELECT rowid FROM data_history "+
+			"WHERE asset_mrn = h.asset_mrn AND checksum = h.checksum AND created_at <= ? "+
+			"ORDER BY created_at DESC, rowid DESC LIMIT 1)",
+		t.UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := &explorer.ReportCollection{
+		Assets:   map[string]*explorer.Asset{},
+		Reports:  map[string]*explorer.Report{},
+		Resolved: map[string]*explorer.ResolvedPack{},
+	}
+	for rows.Next() {
+		var assetMrn, checksum string
+		var raw []byte
+		if err := rows.Scan(&assetMrn, &checksum, &raw); err != nil {
+			return nil, err
+		}
+
+		datum := &llx.Result{}
+		if err := proto.Unmarshal(raw, datum); err != nil {
+			return nil, err
+		}
+
+		report, ok := res.Reports[assetMrn]
+		if !ok {
+			report = &explorer.Report{EntityMrn: assetMrn, Data: map[string]*llx.Result{}}
+			res.Reports[assetMrn] = report
+			res.Assets[assetMrn] = &explorer.Asset{Mrn: assetMrn}
+		}
+		report.Data[checksum] = datum
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for assetMrn := range res.Assets {
+		assetw, ok, err := getAsset(ctx, db.sql, assetMrn)
+		if err != nil {
+			return nil, err
+		}
+		if ok && assetw.ResolvedPack != nil {
+			res.Resolved[assetMrn] = assetw.ResolvedPack
+		}
+	}
+
+	return res, nil
+}
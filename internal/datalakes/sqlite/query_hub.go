@@ -0,0 +1,252 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/mrn"
+	"google.golang.org/protobuf/proto"
+)
+
+// QueryExists checks if the given MRN exists
+func (db *Db) QueryExists(ctx context.Context, mrn string) (bool, error) {
+	var exists bool
+	err := db.sql.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM queries WHERE mrn = ?)", mrn).Scan(&exists)
+	return exists, err
+}
+
+// GetQuery retrieves a given query
+func (db *Db) GetQuery(ctx context.Context, mrn string) (*explorer.Mquery, error) {
+	return getQuery(ctx, db.sql, mrn)
+}
+
+func getQuery(ctx context.Context, q querier, mrn string) (*explorer.Mquery, error) {
+	res := &explorer.Mquery{}
+	ok, err := getProto(ctx, q, res, "SELECT data FROM queries WHERE mrn = ?", mrn)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("query '" + mrn + "' not found")
+	}
+	return res, nil
+}
+
+// SetQuery stores a given query
+// Note: the query must be defined, it cannot be nil
+func (db *Db) SetQuery(ctx context.Context, mrn string, mquery *explorer.Mquery) error {
+	return setQuery(ctx, db.sql, mrn, mquery)
+}
+
+func setQuery(ctx context.Context, q querier, mrn string, mquery *explorer.Mquery) error {
+	raw, err := proto.Marshal(mquery)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx,
+		"INSERT INTO queries (mrn, data) VALUES (?, ?) ON CONFLICT (mrn) DO UPDATE SET data = excluded.data",
+		mrn, raw)
+	if err != nil {
+		return errors.New("failed to save query '" + mrn + "': " + err.Error())
+	}
+	return nil
+}
+
+// SetQueryPack stores a given pack in the datalake
+func (db *Db) SetQueryPack(ctx context.Context, obj *explorer.QueryPack, filters []*explorer.Mquery) error {
+	return db.inTx(ctx, func(tx *sql.Tx) error {
+		for i := range filters {
+			filter := filters[i]
+			if err := setQuery(ctx, tx, filter.Mrn, filter); err != nil {
+				return err
+			}
+		}
+
+		raw, err := proto.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO query_packs (mrn, owner_mrn, data) VALUES (?, ?, ?) "+
+				"ON CONFLICT (mrn) DO UPDATE SET owner_mrn = excluded.owner_mrn, data = excluded.data",
+			obj.Mrn, obj.OwnerMrn, raw)
+		if err != nil {
+			return errors.New("failed to save query pack '" + obj.Mrn + "': " + err.Error())
+		}
+		return nil
+	})
+}
+
+// GetQueryPack retrieves the pack
+func (db *Db) GetQueryPack(ctx context.Context, mrn string) (*explorer.QueryPack, error) {
+	return getQueryPack(ctx, db.sql, mrn)
+}
+
+func getQueryPack(ctx context.Context, q querier, mrn string) (*explorer.QueryPack, error) {
+	res := &explorer.QueryPack{}
+	ok, err := getProto(ctx, q, res, "SELECT data FROM query_packs WHERE mrn = ?", mrn)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("query pack '" + mrn + "' not found")
+	}
+	return res, nil
+}
+
+// GetQueryPackFilters retrieves the query pack filters
+func (db *Db) GetQueryPackFilters(ctx context.Context, in string) ([]*explorer.Mquery, error) {
+	// if it's an asset
+	if _, err := mrn.GetResource(in, explorer.MRN_RESOURCE_ASSET); err != nil {
+		return nil, errors.New("can only retrieve query pack filters for assets")
+	}
+
+	asset, ok, err := getAsset(ctx, db.sql, in)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("failed to find asset " + in)
+	}
+
+	return asset.Bundle.Filters(), nil
+}
+
+// DeleteQueryPack removes a given mrn
+// Note: the MRN has to be valid
+func (db *Db) DeleteQueryPack(ctx context.Context, mrn string) error {
+	_, err := db.sql.ExecContext(ctx, "DELETE FROM query_packs WHERE mrn = ?", mrn)
+	return err
+}
+
+// ListQueryPacks for a given owner
+// Note: Owner MRN is required
+func (db *Db) ListQueryPacks(ctx context.Context, ownerMrn string, name string) ([]*explorer.QueryPack, error) {
+	rows, err := db.sql.QueryContext(ctx, "SELECT data FROM query_packs WHERE owner_mrn = ? ORDER BY mrn", ownerMrn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*explorer.QueryPack{}
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, err
+		}
+		obj := &explorer.QueryPack{}
+		if err := proto.Unmarshal(raw, obj); err != nil {
+			return nil, err
+		}
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
+
+// GetBundle retrieves and if necessary updates the pack. Used for assets,
+// which have multiple query packs associated with them.
+func (db *Db) GetBundle(ctx context.Context, mrn string) (*explorer.Bundle, error) {
+	asset, ok, err := getAsset(ctx, db.sql, mrn)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// every query pack is a bundle of its own, e.g. for registries
+		if pack, err := db.GetQueryPack(ctx, mrn); err == nil {
+			return &explorer.Bundle{OwnerMrn: pack.OwnerMrn, Packs: []*explorer.QueryPack{pack}}, nil
+		}
+		return nil, errors.New("failed to find asset " + mrn)
+	}
+
+	res := asset.Bundle
+
+	// query variants aren't part of the packs of an asset's bundle, so they
+	// are added from the stored queries, same as in the in-memory datalake
+	skipMrn := map[string]struct{}{}
+	for i := range res.Packs {
+		pack := res.Packs[i]
+		for j := range pack.Groups {
+			group := pack.Groups[j]
+			for k := range group.Queries {
+				query := group.Queries[k]
+				for l := range query.Variants {
+					mrn := query.Variants[l].Mrn
+					if _, ok := skipMrn[mrn]; ok {
+						continue
+					}
+
+					skipMrn[mrn] = struct{}{}
+					q, _ := db.GetQuery(ctx, mrn)
+					if q != nil {
+						res.Queries = append(res.Queries, q)
+					}
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// MutateBundle runs the given mutation on a bundle, typically an asset.
+// If it cannot find the owner, it will create it.
+func (db *Db) MutateBundle(ctx context.Context, mutation *explorer.BundleMutationDelta, createIfMissing bool) (*explorer.Bundle, error) {
+	var res *explorer.Bundle
+	err := db.inTx(ctx, func(tx *sql.Tx) error {
+		asset, ok, err := getAsset(ctx, tx, mutation.OwnerMrn)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if !createIfMissing {
+				return errors.New("failed to find asset " + mutation.OwnerMrn)
+			}
+
+			asset, _, err = ensureAssetObject(ctx, tx, mutation.OwnerMrn)
+			if err != nil {
+				return err
+			}
+		}
+
+		existing := map[string]*explorer.QueryPack{}
+		for i := range asset.Bundle.Packs {
+			cur := asset.Bundle.Packs[i]
+			existing[cur.Mrn] = cur
+		}
+
+		for _, delta := range mutation.Deltas {
+			switch delta.Action {
+			case explorer.AssignmentDelta_ADD:
+				pack, err := getQueryPack(ctx, tx, delta.Mrn)
+				if err != nil {
+					return errors.New("failed to find query pack for assignment: " + delta.Mrn)
+				}
+
+				existing[delta.Mrn] = pack
+
+			case explorer.AssignmentDelta_DELETE:
+				delete(existing, delta.Mrn)
+
+			default:
+				return errors.New("cannot mutate bundle, the action is unknown")
+			}
+		}
+
+		packs := make([]*explorer.QueryPack, 0, len(existing))
+		for _, qp := range existing {
+			packs = append(packs, qp)
+		}
+
+		asset.Bundle.Packs = packs
+		res = asset.Bundle
+		return setAssetBundle(ctx, tx, mutation.OwnerMrn, asset.Bundle)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,142 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mondoo.com/cnquery/explorer"
+	"google.golang.org/protobuf/proto"
+	_ "modernc.org/sqlite"
+)
+
+// DefaultHistoryLimit is the number of past values that are kept for every
+// datapoint of an asset, if no other limit is set
+const DefaultHistoryLimit = 100
+
+// Db is a datalake that keeps all bundles, resolved packs and results in
+// a SQLite database on disk, so they are available in later runs.
+type Db struct {
+	sql          *sql.DB
+	services     *explorer.LocalServices // bidirectional connection between db + services
+	uuid         string                  // used for all object identifiers to prevent clashes (eg in-memory pubsub)
+	nowProvider  func() time.Time
+	historyLimit int
+}
+
+// NewServices opens the database at path, creating and migrating it if
+// necessary, and creates a new set of backend services on top of it
+func NewServices(path string) (*Db, *explorer.LocalServices, error) {
+	sqlDb, err := open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := migrate(context.Background(), sqlDb); err != nil {
+		sqlDb.Close()
+		return nil, nil, err
+	}
+
+	db := &Db{
+		sql:          sqlDb,
+		uuid:         uuid.New().String(),
+		nowProvider:  time.Now,
+		historyLimit: DefaultHistoryLimit,
+	}
+
+	services := explorer.NewLocalServices(db, db.uuid)
+	db.services = services // close the connection between db and services
+
+	return db, services, nil
+}
+
+// WithDb creates a new set of backend services and closes the database once
+// the function is done
+func WithDb(path string, f func(*Db, *explorer.LocalServices) error) error {
+	db, ls, err := NewServices(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return f(db, ls)
+}
+
+func open(path string) (*sql.DB, error) {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, errors.New("failed to create datalake directory: " + err.Error())
+		}
+	}
+
+	// scans of multiple assets write at the same time, so they wait for
+	// each other instead of failing
+	params := url.Values{}
+	params.Add("_pragma", "busy_timeout(10000)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", "foreign_keys(1)")
+
+	res, err := sql.Open("sqlite", "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, errors.New("failed to open datalake: " + err.Error())
+	}
+	return res, nil
+}
+
+// Close the database
+func (db *Db) Close() error {
+	return db.sql.Close()
+}
+
+func (db *Db) SetNowProvider(f func() time.Time) {
+	db.nowProvider = f
+}
+
+// SetHistoryLimit sets how many values are kept for every datapoint of an
+// asset. Older values are deleted once new ones are stored. The history of
+// all datapoints is kept if the limit is 0.
+func (db *Db) SetHistoryLimit(limit int) {
+	db.historyLimit = limit
+}
+
+// querier is implemented by both the database and its transactions
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// inTx runs f in a transaction, which is committed if f succeeds
+func (db *Db) inTx(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// getProto reads a single blob and unmarshals it into res. It returns false
+// if no row was found.
+func getProto(ctx context.Context, q querier, res proto.Message, query string, args ...interface{}) (bool, error) {
+	var raw []byte
+	err := q.QueryRowContext(ctx, query, args...).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := proto.Unmarshal(raw, res); err != nil {
+		return false, err
+	}
+	return true, nil
+}
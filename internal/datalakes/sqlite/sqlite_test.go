@@ -0,0 +1,156 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+func TestPersistence(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "datalake.db")
+	assetMrn := "//explorer.api.mondoo.app/assets/abc"
+	packMrn := "//local.cnquery.io/run/local-execution/querypacks/pack"
+
+	resolved := &explorer.ResolvedPack{
+		GraphExecutionChecksum: "graph",
+		ExecutionJob: &explorer.ExecutionJob{
+			Datapoints: map[string]*explorer.DataQueryInfo{
+				"dp1": {Type: string(types.Int)},
+				"dp2": {Type: string(types.String)},
+			},
+		},
+	}
+
+	err := WithDb(path, func(db *Db, services *explorer.LocalServices) error {
+		now := time.Unix(1700000000, 0)
+		db.SetNowProvider(func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		})
+
+		require.NoError(t, db.SetQueryPack(ctx, &explorer.QueryPack{Mrn: packMrn, OwnerMrn: "//local.cnquery.io/run/local-execution"}, nil))
+		bundle, err := db.MutateBundle(ctx, &explorer.BundleMutationDelta{
+			OwnerMrn: assetMrn,
+			Deltas:   map[string]*explorer.AssignmentDelta{packMrn: {Mrn: packMrn, Action: explorer.AssignmentDelta_ADD}},
+		}, true)
+		require.NoError(t, err)
+		require.Len(t, bundle.Packs, 1)
+
+		require.NoError(t, db.SetProps(ctx, &explorer.PropsReq{
+			EntityMrn: assetMrn,
+			Props:     []*explorer.Property{{Uid: "home", Mql: "'/root'"}},
+		}))
+
+		require.NoError(t, db.SetResolvedPack(assetMrn, "filters", resolved))
+		require.NoError(t, db.SetAssetResolvedPack(ctx, assetMrn, resolved, explorer.V2Code))
+
+		_, err = db.UpdateData(ctx, assetMrn, map[string]*llx.Result{
+			"dp1": &llx.Result{Data: llx.IntPrimitive(1), CodeId: "dp1"},
+		})
+		require.NoError(t, err)
+		_, err = db.UpdateData(ctx, assetMrn, map[string]*llx.Result{
+			"dp1": &llx.Result{Data: llx.IntPrimitive(2), CodeId: "dp1"},
+		})
+		require.NoError(t, err)
+
+		_, err = db.UpdateData(ctx, assetMrn, map[string]*llx.Result{
+			"dp2": &llx.Result{Data: llx.IntPrimitive(3), CodeId: "dp2"},
+		})
+		assert.ErrorIs(t, err, errTypesDontMatch)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// everything is still there after the database was closed
+	err = WithDb(path, func(db *Db, services *explorer.LocalServices) error {
+		bundle, err := db.GetBundle(ctx, assetMrn)
+		require.NoError(t, err)
+		require.Len(t, bundle.Packs, 1)
+		assert.Equal(t, packMrn, bundle.Packs[0].Mrn)
+		require.Len(t, bundle.Props, 1)
+		assert.Equal(t, "'/root'", bundle.Props[0].Mql)
+
+		report, err := db.GetReport(ctx, assetMrn, packMrn)
+		require.NoError(t, err)
+		require.Len(t, report.Data, 2)
+		assert.Equal(t, llx.IntPrimitive(2).Value, report.Data["dp1"].Data.Value)
+		assert.Equal(t, llx.NilPrimitive, report.Data["dp2"].Data)
+
+		history, err := db.GetDataHistory(ctx, assetMrn, "dp1")
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, llx.IntPrimitive(1).Value, history[0].Result.Data.Value)
+		assert.Equal(t, llx.IntPrimitive(2).Value, history[1].Result.Data.Value)
+
+		// reports of the past only contain what was known then
+		before, err := db.ReportCollectionAt(ctx, history[1].Created.Add(-1))
+		require.NoError(t, err)
+		assert.Equal(t, llx.IntPrimitive(1).Value, before.Reports[assetMrn].Data["dp1"].Data.Value)
+		assert.Equal(t, resolved.GraphExecutionChecksum, before.Resolved[assetMrn].GraphExecutionChecksum)
+
+		empty, err := db.ReportCollectionAt(ctx, history[0].Created.Add(-1))
+		require.NoError(t, err)
+		assert.Empty(t, empty.Assets)
+
+		// an unchanged resolved pack keeps the data
+		require.NoError(t, db.SetAssetResolvedPack(ctx, assetMrn, resolved, explorer.V2Code))
+		report, err = db.GetReport(ctx, assetMrn, packMrn)
+		require.NoError(t, err)
+		assert.Equal(t, llx.IntPrimitive(2).Value, report.Data["dp1"].Data.Value)
+
+		t.Run("only the newest values are kept", func(t *testing.T) {
+			db.SetHistoryLimit(2)
+			defer db.SetHistoryLimit(DefaultHistoryLimit)
+
+			_, err := db.UpdateData(ctx, assetMrn, map[string]*llx.Result{
+				"dp1": &llx.Result{Data: llx.IntPrimitive(4), CodeId: "dp1"},
+			})
+			require.NoError(t, err)
+
+			history, err := db.GetDataHistory(ctx, assetMrn, "dp1")
+			require.NoError(t, err)
+			require.Len(t, history, 2)
+			assert.Equal(t, llx.IntPrimitive(2).Value, history[0].Result.Data.Value)
+			assert.Equal(t, llx.IntPrimitive(4).Value, history[1].Result.Data.Value)
+		})
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestMigrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "datalake.db")
+	db, _, err := NewServices(path)
+	require.NoError(t, err)
+
+	var version int
+	require.NoError(t, db.sql.QueryRow("PRAGMA user_version").Scan(&version))
+	assert.Equal(t, len(migrations), version)
+	require.NoError(t, db.Close())
+
+	t.Run("migrated databases are opened as they are", func(t *testing.T) {
+		db, _, err := NewServices(path)
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+	})
+
+	t.Run("databases of newer versions are not opened", func(t *testing.T) {
+		raw, err := sql.Open("sqlite", path)
+		require.NoError(t, err)
+		_, err = raw.Exec("PRAGMA user_version = 1000")
+		require.NoError(t, err)
+		require.NoError(t, raw.Close())
+
+		_, _, err = NewServices(path)
+		assert.ErrorContains(t, err, "newer version")
+	})
+}
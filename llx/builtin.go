@@ -14,8 +14,19 @@ type chunkHandlerV2 struct {
 	f        func(*blockExecutor, *RawData, *Chunk, uint64) (*RawData, uint64, error)
 	Label    string
 	Typ      types.Type
+	// static is set for comparisons whose result doesn't depend on the values
+	static staticResult
 }
 
+// staticResult of a comparison that always has the same result
+type staticResult byte
+
+const (
+	notStatic staticResult = iota
+	staticTrue
+	staticFalse
+)
+
 // BuiltinFunctions for all builtin types
 var BuiltinFunctionsV2 map[types.Type]map[string]chunkHandlerV2
 
@@ -23,30 +34,38 @@ func init() {
 	BuiltinFunctionsV2 = map[types.Type]map[string]chunkHandlerV2{
 		types.Nil: {
 			// == / !=
-			string("==" + types.Nil):          {f: chunkEqTrueV2, Label: "=="},
-			string("!=" + types.Nil):          {f: chunkNeqFalseV2, Label: "!="},
-			string("==" + types.Bool):         {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Bool):         {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Int):          {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Int):          {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Float):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Float):        {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.String):       {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.String):       {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Regex):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Regex):        {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Time):         {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Time):         {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Dict):         {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Dict):         {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.ArrayLike):    {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):    {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.MapLike):      {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.MapLike):      {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.ResourceLike): {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ResourceLike): {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.FunctionLike): {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.FunctionLike): {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Nil):          {f: chunkEqTrueV2, Label: "==", static: staticTrue},
+			string("!=" + types.Nil):          {f: chunkNeqFalseV2, Label: "!=", static: staticFalse},
+			string("==" + types.Bool):         {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Bool):         {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Int):          {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Int):          {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Float):        {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Float):        {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.String):       {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.String):       {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Regex):        {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Regex):        {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Time):         {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Time):         {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Version):      {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Version):      {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.IP):           {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.IP):           {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.CIDR):         {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.CIDR):         {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Duration):     {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Duration):     {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Dict):         {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Dict):         {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.ArrayLike):    {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ArrayLike):    {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.MapLike):      {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.MapLike):      {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.ResourceLike): {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ResourceLike): {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.FunctionLike): {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.FunctionLike): {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 		},
 		types.Bool: {
 			// == / !=
@@ -54,30 +73,30 @@ func init() {
 			string("!=" + types.Nil):                 {f: boolNotNilV2, Label: "!="},
 			string("==" + types.Bool):                {f: boolCmpBoolV2, Label: "=="},
 			string("!=" + types.Bool):                {f: boolNotBoolV2, Label: "!="},
-			string("==" + types.Int):                 {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Int):                 {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Float):               {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Float):               {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Int):                 {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Int):                 {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Float):               {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Float):               {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			string("==" + types.String):              {f: boolCmpStringV2, Label: "=="},
 			string("!=" + types.String):              {f: boolNotStringV2, Label: "!="},
-			string("==" + types.Regex):               {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Regex):               {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.Time):                {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Time):                {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Regex):               {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Regex):               {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.Time):                {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Time):                {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			string("==" + types.Dict):                {f: boolCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: boolNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			string("==" + types.Array(types.Bool)):   {f: boolCmpBoolarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Bool)):   {f: boolNotBoolarrayV2, Label: "!="},
 			string("==" + types.Array(types.String)): {f: boolCmpStringarrayV2, Label: "=="},
 			string("!=" + types.Array(types.String)): {f: boolNotStringarrayV2, Label: "!="},
-			string("==" + types.MapLike):             {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.MapLike):             {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.ResourceLike):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ResourceLike):        {f: chunkNeqTrueV2, Label: "!="},
-			string("==" + types.FunctionLike):        {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.FunctionLike):        {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.MapLike):             {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.MapLike):             {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.ResourceLike):        {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ResourceLike):        {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
+			string("==" + types.FunctionLike):        {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.FunctionLike):        {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			//
 			string("&&" + types.Bool):      {f: boolAndBoolV2, Label: "&&"},
 			string("||" + types.Bool):      {f: boolOrBoolV2, Label: "||"},
@@ -112,8 +131,8 @@ func init() {
 			string("!=" + types.Regex):               {f: intNotRegexV2, Label: "!="},
 			string("==" + types.Dict):                {f: intCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: intNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			string("==" + types.Array(types.Int)):    {f: intCmpIntarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Int)):    {f: intNotIntarrayV2, Label: "!="},
 			string("==" + types.Array(types.Float)):  {f: intCmpFloatarrayV2, Label: "=="},
@@ -133,6 +152,7 @@ func init() {
 			string("*" + types.Dict):                 {f: intTimesDictV2, Label: "*", Typ: types.Float},
 			string("/" + types.Dict):                 {f: intDividedDictV2, Label: "/", Typ: types.Float},
 			string("*" + types.Time):                 {f: intTimesTimeV2, Label: "*", Typ: types.Time},
+			string("*" + types.Duration):             {f: intTimesDurationV2, Label: "*", Typ: types.Duration},
 			string("<" + types.Int):                  {f: intLTIntV2, Label: "<"},
 			string("<=" + types.Int):                 {f: intLTEIntV2, Label: "<="},
 			string(">" + types.Int):                  {f: intGTIntV2, Label: ">"},
@@ -180,8 +200,8 @@ func init() {
 			string("!=" + types.Regex):               {f: floatNotRegexV2, Label: "!="},
 			string("==" + types.Dict):                {f: floatCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: floatNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			string("==" + types.Array(types.Int)):    {f: floatCmpIntarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Int)):    {f: floatNotIntarrayV2, Label: "!="},
 			string("==" + types.Array(types.Float)):  {f: floatCmpFloatarrayV2, Label: "=="},
@@ -201,6 +221,7 @@ func init() {
 			string("*" + types.Dict):                 {f: floatTimesDictV2, Label: "*", Typ: types.Float},
 			string("/" + types.Dict):                 {f: floatDividedDictV2, Label: "/", Typ: types.Float},
 			string("*" + types.Time):                 {f: floatTimesTimeV2, Label: "*", Typ: types.Time},
+			string("*" + types.Duration):             {f: floatTimesDurationV2, Label: "*", Typ: types.Duration},
 			string("<" + types.Int):                  {f: floatLTIntV2, Label: "<"},
 			string("<=" + types.Int):                 {f: floatLTEIntV2, Label: "<="},
 			string(">" + types.Int):                  {f: floatGTIntV2, Label: ">"},
@@ -252,8 +273,8 @@ func init() {
 			string("!=" + types.Float):               {f: stringNotFloatV2, Label: "!="},
 			string("==" + types.Dict):                {f: stringCmpDictV2, Label: "=="},
 			string("!=" + types.Dict):                {f: stringNotDictV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			string("==" + types.Array(types.String)): {f: stringCmpStringarrayV2, Label: "=="},
 			string("!=" + types.Array(types.String)): {f: stringNotStringarrayV2, Label: "!="},
 			string("==" + types.Array(types.Bool)):   {f: stringCmpBoolarrayV2, Label: "=="},
@@ -278,6 +299,12 @@ func init() {
 			string("<=" + types.Dict):                {f: stringLTEDictV2, Label: "<="},
 			string(">" + types.Dict):                 {f: stringGTDictV2, Label: ">"},
 			string(">=" + types.Dict):                {f: stringGTEDictV2, Label: ">="},
+			string("==" + types.Version):             {f: versionCmpVersionV2, Label: "=="},
+			string("!=" + types.Version):             {f: versionNotVersionV2, Label: "!="},
+			string("<" + types.Version):              {f: versionLTVersionV2, Label: "<"},
+			string("<=" + types.Version):             {f: versionLTEVersionV2, Label: "<="},
+			string(">" + types.Version):              {f: versionGTVersionV2, Label: ">"},
+			string(">=" + types.Version):             {f: versionGTEVersionV2, Label: ">="},
 			string("&&" + types.Bool):                {f: stringAndBoolV2, Label: "&&"},
 			string("||" + types.Bool):                {f: stringOrBoolV2, Label: "||"},
 			string("&&" + types.Int):                 {f: stringAndIntV2, Label: "&&"},
@@ -304,19 +331,27 @@ func init() {
 			string("contains" + types.Array(types.Int)):    {f: stringContainsArrayIntV2, Label: "contains"},
 			string("contains" + types.Regex):               {f: stringContainsRegex, Label: "contains"},
 			string("contains" + types.Array(types.Regex)):  {f: stringContainsArrayRegex, Label: "contains"},
-			string("find"):      {f: stringFindV2, Label: "find"},
-			string("camelcase"): {f: stringCamelcaseV2, Label: "camelcase"},
-			string("downcase"):  {f: stringDowncaseV2, Label: "downcase"},
-			string("upcase"):    {f: stringUpcaseV2, Label: "upcase"},
-			string("length"):    {f: stringLengthV2, Label: "length"},
-			string("lines"):     {f: stringLinesV2, Label: "lines"},
-			string("split"):     {f: stringSplitV2, Label: "split"},
-			string("trim"):      {f: stringTrimV2, Label: "trim"},
+			string("find"):       {f: stringFindV2, Label: "find"},
+			string("camelcase"):  {f: stringCamelcaseV2, Label: "camelcase"},
+			string("downcase"):   {f: stringDowncaseV2, Label: "downcase"},
+			string("upcase"):     {f: stringUpcaseV2, Label: "upcase"},
+			string("length"):     {f: stringLengthV2, Label: "length"},
+			string("lines"):      {f: stringLinesV2, Label: "lines"},
+			string("split"):      {f: stringSplitV2, Label: "split"},
+			string("trim"):       {f: stringTrimV2, Label: "trim"},
+			string("replace"):    {f: stringReplaceV2, Label: "replace"},
+			string("replaceAll"): {f: stringReplaceAllV2, Label: "replaceAll"},
+			string("substr"):     {f: stringSubstrV2, Label: "substr"},
+			string("startsWith"): {f: stringStartsWithV2, Label: "startsWith"},
+			string("endsWith"):   {f: stringEndsWithV2, Label: "endsWith"},
+			string("padLeft"):    {f: stringPadLeftV2, Label: "padLeft"},
+			string("padRight"):   {f: stringPadRightV2, Label: "padRight"},
+			string("format"):     {f: stringFormatV2, Label: "format"},
 		},
 		types.StringSlice: {
 			// TODO: implement the remaining calls for this type
-			// string("==" + types.Nil):                 {f: chunkEqFalseV2, Label: "=="},
-			// string("!=" + types.Nil):                 {f: chunkNeqFalseV2, Label: "!="},
+			// string("==" + types.Nil):                 {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			// string("!=" + types.Nil):                 {f: chunkNeqFalseV2, Label: "!=", static: staticFalse},
 			string("==" + types.String): {f: stringsliceEqString, Label: "=="},
 			// string("!=" + types.String):              {f: stringNotStringV2, Label: "!="},
 			// string("==" + types.Regex):               {f: stringCmpRegexV2, Label: "=="},
@@ -337,8 +372,8 @@ func init() {
 			string("!=" + types.Nil):                 {f: stringNotNilV2, Label: "!="},
 			string("==" + types.Regex):               {f: stringCmpStringV2, Label: "=="},
 			string("!=" + types.Regex):               {f: stringNotStringV2, Label: "!="},
-			string("==" + types.Bool):                {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Bool):                {f: chunkNeqFalseV2, Label: "!="},
+			string("==" + types.Bool):                {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Bool):                {f: chunkNeqFalseV2, Label: "!=", static: staticFalse},
 			string("==" + types.Int):                 {f: regexCmpIntV2, Label: "=="},
 			string("!=" + types.Int):                 {f: regexNotIntV2, Label: "!="},
 			string("==" + types.Float):               {f: regexCmpFloatV2, Label: "=="},
@@ -347,8 +382,8 @@ func init() {
 			string("!=" + types.Dict):                {f: regexNotDictV2, Label: "!="},
 			string("==" + types.String):              {f: regexCmpStringV2, Label: "=="},
 			string("!=" + types.String):              {f: regexNotStringV2, Label: "!="},
-			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.ArrayLike):           {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.ArrayLike):           {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			string("==" + types.Array(types.Regex)):  {f: stringCmpStringarrayV2, Label: "=="},
 			string("!=" + types.Array(types.Regex)):  {f: stringNotStringarrayV2, Label: "!="},
 			string("==" + types.Array(types.Int)):    {f: regexCmpIntarrayV2, Label: "=="},
@@ -408,14 +443,113 @@ func init() {
 			string("*" + types.Int):        {f: timeTimesIntV2, Label: "*", Typ: types.Time},
 			string("*" + types.Float):      {f: timeTimesFloatV2, Label: "*", Typ: types.Time},
 			string("*" + types.Dict):       {f: timeTimesDictV2, Label: "*", Typ: types.Time},
+			string("+" + types.Duration):   {f: timePlusDurationV2, Label: "+", Typ: types.Time},
+			string("-" + types.Duration):   {f: timeMinusDurationV2, Label: "-", Typ: types.Time},
+			string("==" + types.Duration):  {f: durationCmpDurationV2, Label: "=="},
+			string("!=" + types.Duration):  {f: durationNotDurationV2, Label: "!="},
+			string("<" + types.Duration):   {f: durationLTDurationV2, Label: "<"},
+			string("<=" + types.Duration):  {f: durationLTEDurationV2, Label: "<="},
+			string(">" + types.Duration):   {f: durationGTDurationV2, Label: ">"},
+			string(">=" + types.Duration):  {f: durationGTEDurationV2, Label: ">="},
 			// fields
 			string("seconds"): {f: timeSecondsV2, Label: "seconds"},
 			string("minutes"): {f: timeMinutesV2, Label: "minutes"},
 			string("hours"):   {f: timeHoursV2, Label: "hours"},
 			string("days"):    {f: timeDaysV2, Label: "days"},
 			string("unix"):    {f: timeUnixV2, Label: "unix"},
+			string("format"):  {f: timeFormatV2, Label: "format"},
+		},
+		types.Duration: {
+			string("==" + types.Nil):      {f: durationCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):      {f: durationNotNilV2, Label: "!="},
+			string("==" + types.Duration): {f: durationCmpDurationV2, Label: "=="},
+			string("!=" + types.Duration): {f: durationNotDurationV2, Label: "!="},
+			string("<" + types.Duration):  {f: durationLTDurationV2, Label: "<"},
+			string("<=" + types.Duration): {f: durationLTEDurationV2, Label: "<="},
+			string(">" + types.Duration):  {f: durationGTDurationV2, Label: ">"},
+			string(">=" + types.Duration): {f: durationGTEDurationV2, Label: ">="},
+			string("==" + types.Time):     {f: durationCmpDurationV2, Label: "=="},
+			string("!=" + types.Time):     {f: durationNotDurationV2, Label: "!="},
+			string("<" + types.Time):      {f: durationLTDurationV2, Label: "<"},
+			string("<=" + types.Time):     {f: durationLTEDurationV2, Label: "<="},
+			string(">" + types.Time):      {f: durationGTDurationV2, Label: ">"},
+			string(">=" + types.Time):     {f: durationGTEDurationV2, Label: ">="},
+			string("+" + types.Duration):  {f: durationPlusDurationV2, Label: "+", Typ: types.Duration},
+			string("-" + types.Duration):  {f: durationMinusDurationV2, Label: "-", Typ: types.Duration},
+			string("+" + types.Time):      {f: durationPlusTimeV2, Label: "+", Typ: types.Time},
+			string("*" + types.Int):       {f: durationTimesIntV2, Label: "*", Typ: types.Duration},
+			string("*" + types.Float):     {f: durationTimesFloatV2, Label: "*", Typ: types.Duration},
+			string("/" + types.Int):       {f: durationDividedIntV2, Label: "/", Typ: types.Duration},
+			string("/" + types.Float):     {f: durationDividedFloatV2, Label: "/", Typ: types.Duration},
+			// fields
+			string("seconds"): {f: durationSecondsV2, Label: "seconds"},
+			string("minutes"): {f: durationMinutesV2, Label: "minutes"},
+			string("hours"):   {f: durationHoursV2, Label: "hours"},
+			string("days"):    {f: durationDaysV2, Label: "days"},
+			string("months"):  {f: durationMonthsV2, Label: "months"},
+			string("years"):   {f: durationYearsV2, Label: "years"},
+		},
+		types.Version: {
+			string("==" + types.Nil):     {f: versionCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):     {f: versionNotNilV2, Label: "!="},
+			string("==" + types.Version): {f: versionCmpVersionV2, Label: "=="},
+			string("!=" + types.Version): {f: versionNotVersionV2, Label: "!="},
+			string("<" + types.Version):  {f: versionLTVersionV2, Label: "<"},
+			string("<=" + types.Version): {f: versionLTEVersionV2, Label: "<="},
+			string(">" + types.Version):  {f: versionGTVersionV2, Label: ">"},
+			string(">=" + types.Version): {f: versionGTEVersionV2, Label: ">="},
+			string("==" + types.String):  {f: versionCmpVersionV2, Label: "=="},
+			string("!=" + types.String):  {f: versionNotVersionV2, Label: "!="},
+			string("<" + types.String):   {f: versionLTVersionV2, Label: "<"},
+			string("<=" + types.String):  {f: versionLTEVersionV2, Label: "<="},
+			string(">" + types.String):   {f: versionGTVersionV2, Label: ">"},
+			string(">=" + types.String):  {f: versionGTEVersionV2, Label: ">="},
+			// fields
+			string("inRange"): {f: versionInRangeV2, Label: "inRange"},
+		},
+		types.IP: {
+			string("==" + types.Nil):    {f: ipCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):    {f: ipNotNilV2, Label: "!="},
+			string("==" + types.IP):     {f: ipCmpIPV2, Label: "=="},
+			string("!=" + types.IP):     {f: ipNotIPV2, Label: "!="},
+			string("<" + types.IP):      {f: ipLTIPV2, Label: "<"},
+			string("<=" + types.IP):     {f: ipLTEIPV2, Label: "<="},
+			string(">" + types.IP):      {f: ipGTIPV2, Label: ">"},
+			string(">=" + types.IP):     {f: ipGTEIPV2, Label: ">="},
+			string("==" + types.String): {f: ipCmpIPV2, Label: "=="},
+			string("!=" + types.String): {f: ipNotIPV2, Label: "!="},
+			string("<" + types.String):  {f: ipLTIPV2, Label: "<"},
+			string("<=" + types.String): {f: ipLTEIPV2, Label: "<="},
+			string(">" + types.String):  {f: ipGTIPV2, Label: ">"},
+			string(">=" + types.String): {f: ipGTEIPV2, Label: ">="},
+			// fields
+			string("isPrivate"):     {f: ipIsPrivateV2, Label: "isPrivate"},
+			string("isLoopback"):    {f: ipIsLoopbackV2, Label: "isLoopback"},
+			string("isUnspecified"): {f: ipIsUnspecifiedV2, Label: "isUnspecified"},
+			string("version"):       {f: ipVersionV2, Label: "version"},
+		},
+		types.CIDR: {
+			string("==" + types.Nil):    {f: ipCmpNilV2, Label: "=="},
+			string("!=" + types.Nil):    {f: ipNotNilV2, Label: "!="},
+			string("==" + types.CIDR):   {f: cidrCmpCIDRV2, Label: "=="},
+			string("!=" + types.CIDR):   {f: cidrNotCIDRV2, Label: "!="},
+			string("==" + types.String): {f: cidrCmpCIDRV2, Label: "=="},
+			string("!=" + types.String): {f: cidrNotCIDRV2, Label: "!="},
+			// fields
+			string("contains"):     {f: cidrContainsV2, Label: "contains"},
+			string("overlaps"):     {f: cidrOverlapsV2, Label: "overlaps"},
+			string("prefixLength"): {f: cidrPrefixLengthV2, Label: "prefixLength"},
+			string("version"):      {f: cidrVersionV2, Label: "version"},
+			string("isPrivate"):    {f: cidrIsPrivateV2, Label: "isPrivate"},
+			string("isLoopback"):   {f: cidrIsLoopbackV2, Label: "isLoopback"},
 		},
 		types.Dict: {
+			string("==" + types.Version):             {f: versionCmpVersionV2, Label: "=="},
+			string("!=" + types.Version):             {f: versionNotVersionV2, Label: "!="},
+			string("<" + types.Version):              {f: versionLTVersionV2, Label: "<"},
+			string("<=" + types.Version):             {f: versionLTEVersionV2, Label: "<="},
+			string(">" + types.Version):              {f: versionGTVersionV2, Label: ">"},
+			string(">=" + types.Version):             {f: versionGTEVersionV2, Label: ">="},
 			string("==" + types.Nil):                 {f: dictCmpNilV2, Label: "=="},
 			string("!=" + types.Nil):                 {f: dictNotNilV2, Label: "!="},
 			string("==" + types.Bool):                {f: dictCmpBoolV2, Label: "=="},
@@ -496,6 +630,14 @@ func init() {
 			"lines":                           {f: dictLinesV2, Label: "lines"},
 			"split":                           {f: dictSplitV2, Label: "split"},
 			"trim":                            {f: dictTrimV2, Label: "trim"},
+			"replace":                         {f: dictReplaceV2, Label: "replace"},
+			"replaceAll":                      {f: dictReplaceAllV2, Label: "replaceAll"},
+			"substr":                          {f: dictSubstrV2, Label: "substr"},
+			"startsWith":                      {f: dictStartsWithV2, Label: "startsWith"},
+			"endsWith":                        {f: dictEndsWithV2, Label: "endsWith"},
+			"padLeft":                         {f: dictPadLeftV2, Label: "padLeft"},
+			"padRight":                        {f: dictPadRightV2, Label: "padRight"},
+			"format":                          {f: dictFormatV2, Label: "format"},
 			"keys":                            {f: dictKeysV2, Label: "keys"},
 			"values":                          {f: dictValuesV2, Label: "values"},
 			"where":                           {f: dictWhereV2, Label: "where"},
@@ -506,6 +648,25 @@ func init() {
 			"$one":                            {f: dictOneV2},
 			"map":                             {f: dictMapV2},
 			"flat":                            {f: dictFlat},
+			"sort":                            {f: arraySortV2},
+			"sortDesc":                        {f: arraySortDescV2},
+			"fieldSort":                       {f: arrayFieldSortV2},
+			"fieldSortDesc":                   {f: arrayFieldSortDescV2},
+			"reverse":                         {f: arrayReverseV2},
+			"take":                            {f: arrayTakeV2},
+			"skip":                            {f: arraySkipV2},
+			"sum":                             {f: arrayAggregateV2("sum")},
+			"min":                             {f: arrayAggregateV2("min")},
+			"max":                             {f: arrayAggregateV2("max")},
+			"avg":                             {f: arrayAggregateV2("avg")},
+			"median":                          {f: arrayAggregateV2("median")},
+			"fieldSum":                        {f: arrayFieldAggregateV2("sum")},
+			"fieldMin":                        {f: arrayFieldAggregateV2("min")},
+			"fieldMax":                        {f: arrayFieldAggregateV2("max")},
+			"fieldAvg":                        {f: arrayFieldAggregateV2("avg")},
+			"fieldMedian":                     {f: arrayFieldAggregateV2("median")},
+			"groupBy":                         {f: arrayGroupByV2},
+			"countBy":                         {f: arrayCountByV2},
 			"difference":                      {f: dictDifferenceV2},
 			"containsNone":                    {f: dictContainsNoneV2},
 			string("contains" + types.String): {f: dictContainsStringV2, Label: "contains"},
@@ -537,6 +698,25 @@ func init() {
 			"duplicates":             {f: arrayDuplicatesV2},
 			"fieldDuplicates":        {f: arrayFieldDuplicatesV2},
 			"unique":                 {f: arrayUniqueV2},
+			"sort":                   {f: arraySortV2},
+			"sortDesc":               {f: arraySortDescV2},
+			"fieldSort":              {f: arrayFieldSortV2},
+			"fieldSortDesc":          {f: arrayFieldSortDescV2},
+			"reverse":                {f: arrayReverseV2},
+			"take":                   {f: arrayTakeV2},
+			"skip":                   {f: arraySkipV2},
+			"sum":                    {f: arrayAggregateV2("sum")},
+			"min":                    {f: arrayAggregateV2("min")},
+			"max":                    {f: arrayAggregateV2("max")},
+			"avg":                    {f: arrayAggregateV2("avg")},
+			"median":                 {f: arrayAggregateV2("median")},
+			"fieldSum":               {f: arrayFieldAggregateV2("sum")},
+			"fieldMin":               {f: arrayFieldAggregateV2("min")},
+			"fieldMax":               {f: arrayFieldAggregateV2("max")},
+			"fieldAvg":               {f: arrayFieldAggregateV2("avg")},
+			"fieldMedian":            {f: arrayFieldAggregateV2("median")},
+			"groupBy":                {f: arrayGroupByV2},
+			"countBy":                {f: arrayCountByV2},
 			"difference":             {f: arrayDifferenceV2},
 			"containsNone":           {f: arrayContainsNoneV2},
 			"==":                     {Compiler: compileArrayOpArray("=="), f: tarrayCmpTarrayV2, Label: "=="},
@@ -644,8 +824,8 @@ func init() {
 		},
 		types.ResourceLike: {
 			// == / !=
-			string("==" + types.Nil): {f: chunkEqFalseV2, Label: "=="},
-			string("!=" + types.Nil): {f: chunkNeqTrueV2, Label: "!="},
+			string("==" + types.Nil): {f: chunkEqFalseV2, Label: "==", static: staticFalse},
+			string("!=" + types.Nil): {f: chunkNeqTrueV2, Label: "!=", static: staticTrue},
 			// fields
 			"where":     {f: resourceWhereV2},
 			"$whereNot": {f: resourceWhereNotV2},
@@ -655,7 +835,15 @@ func init() {
 				return e.runBlock(bind, chunk.Function.Args[0], chunk.Function.Args[1:], ref)
 			}},
 			// TODO: [#32] unique builtin fields that need a long-term support in LR
-			string(types.Resource("parse") + ".date"): {f: resourceDateV2},
+			string(types.Resource("parse") + ".date"):  {f: resourceDateV2},
+			string(types.Resource("time") + ".parse"):  {f: resourceDateV2},
+			string(types.Resource("time") + ".second"): {f: resourceTimeUnitV2},
+			string(types.Resource("time") + ".minute"): {f: resourceTimeUnitV2},
+			string(types.Resource("time") + ".hour"):   {f: resourceTimeUnitV2},
+			string(types.Resource("time") + ".day"):    {f: resourceTimeUnitV2},
+			string(types.Resource("time") + ".week"):   {f: resourceTimeUnitV2},
+			string(types.Resource("time") + ".month"):  {f: resourceTimeUnitV2},
+			string(types.Resource("time") + ".year"):   {f: resourceTimeUnitV2},
 		},
 	}
 
@@ -711,10 +899,20 @@ func runResourceFunction(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint
 	// log.Debug().Str("wid", wid).Msg("exec> add watcher id ")
 	e.watcherIds.Store(wid)
 
-	// watch this field in the resource
-	err := e.ctx.runtime.WatchAndUpdate(rr, chunk.Id, wid, func(fieldData interface{}, fieldError error) {
+	fieldType := types.Unset
+	if field := resource.Fields[chunk.Id]; field != nil {
+		fieldType = types.Type(field.Type)
+	}
+
+	callback := func(fieldData interface{}, fieldError error) {
+		// list sources are only read page by page if nothing else needs all
+		// of their items
+		if source, ok := fieldData.(ListSource); ok && !e.streamsList(ref) {
+			fieldData, fieldError = collectList(source, e.ctx.listWindow())
+		}
+
 		data := &RawData{
-			Type:  types.Type(resource.Fields[chunk.Id].Type),
+			Type:  fieldType,
 			Value: fieldData,
 			Error: fieldError,
 		}
@@ -732,14 +930,25 @@ func runResourceFunction(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint
 		}
 
 		e.triggerChain(ref, data)
-	})
+	}
+
+	// watch this field in the resource; a scheduler resolves it in the
+	// background and traces which other field it waited for
+	if scheduler, ok := e.ctx.runtime.(*Scheduler); ok {
+		var parent *Span
+		if scheduler.opts.Trace {
+			parent = e.dependencySpan(ref)
+		}
+		span := scheduler.WatchAndUpdateAfter(parent, rr, chunk.Id, wid, callback)
+		if span != nil {
+			e.spans.Store(ref, span)
+		}
+		return nil, 0, nil
+	}
+
+	err := e.ctx.runtime.WatchAndUpdate(rr, chunk.Id, wid, callback)
 	if err != nil {
 		if _, ok := err.(resources.NotReadyError); !ok {
-			fieldType := types.Unset
-			if field := resource.Fields[chunk.Id]; field != nil {
-				fieldType = types.Type(field.Type)
-			}
-
 			e.cache.Store(ref, &stepCache{
 				Result: &RawData{
 					Type:  fieldType,
@@ -767,6 +976,26 @@ func BuiltinFunctionV2(typ types.Type, name string) (*chunkHandlerV2, error) {
 	return &fh, nil
 }
 
+// StaticComparison checks if the given comparison operator on a type always
+// has the same result, independent of the values that are compared. This
+// happens e.g. when comparing a bool to a regex, which is always false.
+// It returns the result of the comparison and true if it is static.
+func StaticComparison(typ types.Type, name string) (bool, bool) {
+	fh, err := BuiltinFunctionV2(typ, name)
+	if err != nil {
+		return false, false
+	}
+
+	switch fh.static {
+	case staticTrue:
+		return true, true
+	case staticFalse:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
 // this is called for objects that call a function
 func (e *blockExecutor) runBoundFunction(bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	log.Trace().Uint64("ref", ref).Str("id", chunk.Id).Msg("exec> run bound function")
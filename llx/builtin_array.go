@@ -2,7 +2,11 @@ package llx
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"go.mondoo.com/cnquery/types"
@@ -18,6 +22,14 @@ func arrayGetFirstIndexV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uin
 		return &RawData{Type: bind.Type[1:]}, 0, nil
 	}
 
+	if source, ok := bind.Value.(ListSource); ok {
+		page, err := source.Page(0, 1)
+		if err != nil || len(page) == 0 {
+			return &RawData{Type: bind.Type[1:], Error: err}, 0, nil
+		}
+		return &RawData{Type: bind.Type[1:], Value: page[0]}, 0, nil
+	}
+
 	arr, ok := bind.Value.([]interface{})
 	if !ok {
 		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
@@ -145,7 +157,13 @@ func arrayBlockListV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64)
 		allResults := make([]interface{}, len(arr))
 
 		for i, rd := range results {
-			allResults[i] = rd.toRawData().Value
+			block := rd.toRawData().Value.(map[string]interface{})
+			// blocks keep the resource they ran on, like the blocks of single
+			// resources, so that they can be matched across results
+			if resource, ok := arr[i].(Resource); ok {
+				block["_"] = &RawData{Type: bind.Type.Child(), Value: resource}
+			}
+			allResults[i] = block
 		}
 		if len(errs) > 0 {
 			dedupErrs := []error{}
@@ -194,6 +212,14 @@ func arrayLengthV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*
 		return &RawData{Type: types.Int, Error: bind.Error}, 0, nil
 	}
 
+	if source, ok := bind.Value.(ListSource); ok {
+		n, err := countList(source, e.ctx.listWindow())
+		if err != nil {
+			return &RawData{Type: types.Int, Error: err}, 0, nil
+		}
+		return IntData(int64(n)), 0, nil
+	}
+
 	arr, ok := bind.Value.([]interface{})
 	if !ok {
 		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
@@ -206,6 +232,14 @@ func arrayNotEmptyV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64)
 		return BoolFalse, 0, nil
 	}
 
+	if source, ok := bind.Value.(ListSource); ok {
+		page, err := source.Page(0, 1)
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: err}, 0, nil
+		}
+		return BoolData(len(page) != 0), 0, nil
+	}
+
 	arr, ok := bind.Value.([]interface{})
 	if !ok {
 		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
@@ -229,12 +263,25 @@ func _arrayWhereV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, in
 		return &RawData{Type: items.Type}, 0, nil
 	}
 
-	list := items.Value.([]interface{})
-	if len(list) == 0 {
-		return items, 0, nil
+	arg1 := chunk.Function.Args[1]
+	source, isSource := items.Value.(ListSource)
+	if isSource && types.Type(arg1.Type).Underlying() != types.FunctionLike {
+		list, err := collectList(source, e.ctx.listWindow())
+		if err != nil {
+			return &RawData{Type: items.Type, Error: err}, 0, nil
+		}
+		items = &RawData{Type: items.Type, Value: list}
+		isSource = false
+	}
+
+	var list []interface{}
+	if !isSource {
+		list = items.Value.([]interface{})
+		if len(list) == 0 {
+			return items, 0, nil
+		}
 	}
 
-	arg1 := chunk.Function.Args[1]
 	if types.Type(arg1.Type).Underlying() != types.FunctionLike {
 		right := arg1.RawData().Value
 		var res []interface{}
@@ -263,6 +310,35 @@ func _arrayWhereV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, in
 
 	ct := items.Type.Child()
 
+	// list sources and long lists are filtered one page after the other,
+	// which stops early once the function called on the filtered list has
+	// enough items
+	if isSource || len(list) > e.ctx.listWindow() {
+		if !isSource {
+			source = sliceSource(list)
+		}
+		limit := e.streamLimit(ref)
+		resList := []interface{}{}
+		err = e.streamFunctionBlocks(source, ct, fref, func(item interface{}, res arrayBlockCallResult) bool {
+			if res.isTruthy() == !invert {
+				resList = append(resList, item)
+			}
+			return limit == 0 || len(resList) < limit
+		}, func(err error) {
+			data := &RawData{
+				Type:  bind.Type,
+				Value: resList,
+				Error: err,
+			}
+			e.cache.Store(ref, &stepCache{
+				Result:   data,
+				IsStatic: false,
+			})
+			e.triggerChain(ref, data)
+		})
+		return nil, 0, err
+	}
+
 	argsList := make([][]*RawData, len(list))
 	for i := range list {
 		argsList[i] = []*RawData{
@@ -371,9 +447,13 @@ func arrayMapV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 		return &RawData{Type: items.Type}, 0, nil
 	}
 
-	list := items.Value.([]interface{})
-	if len(list) == 0 {
-		return items, 0, nil
+	source, isSource := items.Value.(ListSource)
+	var list []interface{}
+	if !isSource {
+		list = items.Value.([]interface{})
+		if len(list) == 0 {
+			return items, 0, nil
+		}
 	}
 
 	arg1 := chunk.Function.Args[1]
@@ -389,6 +469,36 @@ func arrayMapV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 
 	ct := items.Type.Child()
 
+	// list sources and long lists are mapped one page after the other
+	if isSource || len(list) > e.ctx.listWindow() {
+		if !isSource {
+			source = sliceSource(list)
+		}
+		epChecksum := e.ctx.code.Checksums[e.ctx.code.Block(fref).Entrypoints[0]]
+		mappedType := types.Unset
+		resList := []interface{}{}
+		err = e.streamFunctionBlocks(source, ct, fref, func(item interface{}, res arrayBlockCallResult) bool {
+			if epValIface, ok := res.entrypoints[epChecksum]; ok {
+				epVal := epValIface.(*RawData)
+				mappedType = epVal.Type
+				resList = append(resList, epVal.Value)
+			}
+			return true
+		}, func(err error) {
+			data := &RawData{
+				Type:  types.Array(mappedType),
+				Value: resList,
+				Error: err,
+			}
+			e.cache.Store(ref, &stepCache{
+				Result:   data,
+				IsStatic: false,
+			})
+			e.triggerChain(ref, data)
+		})
+		return nil, 0, err
+	}
+
 	argsList := make([][]*RawData, len(list))
 	for i := range list {
 		argsList[i] = []*RawData{
@@ -792,6 +902,621 @@ func arrayContainsNoneV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint
 	return &RawData{Type: bind.Type, Value: res}, 0, nil
 }
 
+// compareSortValues compares two values for sorting. It returns a negative
+// number if left sorts before right, a positive number if it sorts after and
+// 0 if both are equal. Values are compared by their runtime type, which allows
+// us to sort dict values as well. Numbers are compared across int and float.
+// Values of different types are grouped by type. Nil values always come last.
+func compareSortValues(left interface{}, right interface{}) int {
+	lr, rr := sortRank(left), sortRank(right)
+	if lr != rr {
+		return lr - rr
+	}
+
+	switch l := left.(type) {
+	case bool:
+		r := right.(bool)
+		if l == r {
+			return 0
+		}
+		if !l {
+			return -1
+		}
+		return 1
+	case int64, float64:
+		lf, rf := sortFloat(left), sortFloat(right)
+		if lf < rf {
+			return -1
+		}
+		if lf > rf {
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(l, right.(string))
+	case *time.Time:
+		r := right.(*time.Time)
+		if l == nil || r == nil {
+			if l == r {
+				return 0
+			}
+			if l == nil {
+				return 1
+			}
+			return -1
+		}
+		if l.Before(*r) {
+			return -1
+		}
+		if l.After(*r) {
+			return 1
+		}
+		return 0
+	case Version:
+		// versions of different ecosystems keep their textual order
+		cmp, err := l.Compare(right.(Version))
+		if err != nil {
+			return strings.Compare(l.Value, right.(Version).Value)
+		}
+		return cmp
+	case Duration:
+		return l.Compare(right.(Duration))
+	}
+
+	return 0
+}
+
+func sortRank(v interface{}) int {
+	switch v.(type) {
+	case bool:
+		return 1
+	case int64, float64:
+		return 2
+	case string:
+		return 3
+	case *time.Time:
+		return 4
+	case Version:
+		return 5
+	case Duration:
+		return 6
+	case nil:
+		return 8
+	default:
+		return 7
+	}
+}
+
+func sortFloat(v interface{}) float64 {
+	switch x := v.(type) {
+	case int64:
+		return float64(x)
+	case float64:
+		return x
+	}
+	return 0
+}
+
+// sortIndexes returns the stable sort order of all given keys
+func sortIndexes(keys []interface{}, desc bool) []int {
+	idx := make([]int, len(keys))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool {
+		left, right := keys[idx[i]], keys[idx[j]]
+		// nil values are always sorted to the end of the list
+		if left == nil || right == nil {
+			return right == nil && left != nil
+		}
+		if desc {
+			return compareSortValues(right, left) < 0
+		}
+		return compareSortValues(left, right) < 0
+	})
+
+	return idx
+}
+
+func _arraySortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, desc bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	arr, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	idx := sortIndexes(arr, desc)
+	res := make([]interface{}, len(arr))
+	for i := range idx {
+		res[i] = arr[idx[i]]
+	}
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+func arraySortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arraySortV2(e, bind, chunk, ref, false)
+}
+
+func arraySortDescV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arraySortV2(e, bind, chunk, ref, true)
+}
+
+// runArrayKeyBlocks runs the function block of calls like sort(..) or
+// groupBy(..) for every entry of the array and collects the key that every
+// block computes. Once all keys are in, done turns them into the result of
+// this call. Empty arrays are handed to onEmpty instead.
+func runArrayKeyBlocks(e *blockExecutor, chunk *Chunk, ref uint64,
+	onEmpty func(items *RawData) *RawData,
+	done func(items *RawData, list []interface{}, keys []interface{}) *RawData,
+) (*RawData, uint64, error) {
+	itemsRef := chunk.Function.Args[0]
+	items, rref, err := e.resolveValue(itemsRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if items.Value == nil {
+		return onEmpty(items), 0, nil
+	}
+
+	list, ok := items.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + items.Type.Label() + " into array")
+	}
+	if len(list) == 0 {
+		return onEmpty(items), 0, nil
+	}
+
+	arg1 := chunk.Function.Args[1]
+	fref, ok := arg1.RefV2()
+	if !ok {
+		return nil, 0, errors.New("Failed to retrieve function reference of '" + chunk.Id + "' call")
+	}
+
+	dref, err := e.ensureArgsResolved(chunk.Function.Args[2:], ref)
+	if dref != 0 || err != nil {
+		return nil, dref, err
+	}
+
+	ct := items.Type.Child()
+
+	argsList := make([][]*RawData, len(list))
+	for i := range list {
+		argsList[i] = []*RawData{
+			{
+				Type:  ct,
+				Value: list[i],
+			},
+		}
+	}
+
+	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
+		f := e.ctx.code.Block(fref)
+		epChecksum := e.ctx.code.Checksums[f.Entrypoints[0]]
+
+		keys := make([]interface{}, len(list))
+		for i, res := range results {
+			if epVal, ok := res.entrypoints[epChecksum].(*RawData); ok {
+				keys[i] = epVal.Value
+			}
+		}
+
+		data := done(items, list, keys)
+		if len(errs) > 0 {
+			data.Error = multierror.Append(data.Error, errs...)
+		}
+
+		e.cache.Store(ref, &stepCache{
+			Result:   data,
+			IsStatic: false,
+		})
+		e.triggerChain(ref, data)
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nil, 0, nil
+}
+
+// Takes an array and a function block, which computes the key that every
+// entry is sorted by. Entries with the same key keep their original order.
+func _arrayFieldSortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, desc bool) (*RawData, uint64, error) {
+	return runArrayKeyBlocks(e, chunk, ref,
+		func(items *RawData) *RawData {
+			return &RawData{Type: items.Type, Value: items.Value}
+		},
+		func(items *RawData, list []interface{}, keys []interface{}) *RawData {
+			idx := sortIndexes(keys, desc)
+			res := make([]interface{}, len(list))
+			for i := range idx {
+				res[i] = list[idx[i]]
+			}
+			return &RawData{Type: items.Type, Value: res}
+		})
+}
+
+func arrayFieldSortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayFieldSortV2(e, bind, chunk, ref, false)
+}
+
+func arrayFieldSortDescV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayFieldSortV2(e, bind, chunk, ref, true)
+}
+
+func arrayReverseV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	arr, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	res := make([]interface{}, len(arr))
+	for i := range arr {
+		res[len(arr)-1-i] = arr[i]
+	}
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+// arraySliceCount resolves the number of entries that a take/skip call
+// was made with and makes sure it is a usable count
+func arraySliceCount(e *blockExecutor, chunk *Chunk, ref uint64) (int, uint64, error) {
+	args := chunk.Function.Args
+	if len(args) != 1 {
+		return 0, 0, errors.New("called `" + chunk.Id + "` with " + strconv.Itoa(len(args)) + " arguments, only 1 supported.")
+	}
+
+	arg, rref, err := e.resolveValue(args[0], ref)
+	if err != nil || rref > 0 {
+		return 0, rref, err
+	}
+
+	n, ok := arg.Value.(int64)
+	if !ok {
+		return 0, 0, errors.New("called `" + chunk.Id + "` with wrong type (got: " + arg.Type.Label() + ", expected: int)")
+	}
+	if n < 0 {
+		return 0, 0, errors.New("called `" + chunk.Id + "` with a negative number of entries")
+	}
+
+	return int(n), 0, nil
+}
+
+func arrayTakeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	n, rref, err := arraySliceCount(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	arr, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	if n > len(arr) {
+		n = len(arr)
+	}
+	res := make([]interface{}, n)
+	copy(res, arr[:n])
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+func arraySkipV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	n, rref, err := arraySliceCount(e, chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	arr, ok := bind.Value.([]interface{})
+	if !ok {
+		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+	}
+
+	if n > len(arr) {
+		n = len(arr)
+	}
+	res := make([]interface{}, len(arr)-n)
+	copy(res, arr[n:])
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+// aggregateValues computes the aggregation (sum, min, max, avg, median) over
+// all given values. Null values are ignored. If there is nothing left to
+// aggregate, the result is null.
+func aggregateValues(agg string, values []interface{}, typ types.Type) *RawData {
+	vals := make([]interface{}, 0, len(values))
+	for i := range values {
+		if t, ok := values[i].(*time.Time); ok && t == nil {
+			continue
+		}
+		if values[i] != nil {
+			vals = append(vals, values[i])
+		}
+	}
+	if len(vals) == 0 {
+		return &RawData{Type: typ}
+	}
+
+	_, isTime := vals[0].(*time.Time)
+	switch vals[0].(type) {
+	case Version, Duration:
+		// versions and durations can be ordered, but not summed up
+		if agg != "min" && agg != "max" {
+			return &RawData{
+				Type:  typ,
+				Error: errors.New("cannot compute " + agg + " of " + typ.Label() + " values"),
+			}
+		}
+	default:
+		for i := range vals {
+			switch vals[i].(type) {
+			case int64, float64:
+				if !isTime {
+					continue
+				}
+			case *time.Time:
+				if isTime {
+					continue
+				}
+			}
+			return &RawData{
+				Type:  typ,
+				Error: errors.New("cannot compute " + agg + " of mixed or non-numeric values"),
+			}
+		}
+	}
+
+	switch agg {
+	case "min", "max":
+		res := vals[0]
+		for _, v := range vals[1:] {
+			cmp := compareSortValues(v, res)
+			if (agg == "min" && cmp < 0) || (agg == "max" && cmp > 0) {
+				res = v
+			}
+		}
+		return &RawData{Type: typ, Value: res}
+
+	case "sum":
+		if isTime {
+			res := TimeData(DurationToTime(0))
+			for _, v := range vals {
+				res = opTimePlusTime(res.Value, v)
+			}
+			res.Type = typ
+			return res
+		}
+		return &RawData{Type: typ, Value: sumNumbers(vals)}
+
+	case "avg":
+		if isTime {
+			return &RawData{Type: typ, Value: avgTimes(vals)}
+		}
+		return &RawData{Type: typ, Value: sortFloat(sumNumbers(vals)) / float64(len(vals))}
+
+	case "median":
+		sorted := make([]interface{}, len(vals))
+		idx := sortIndexes(vals, false)
+		for i := range idx {
+			sorted[i] = vals[idx[i]]
+		}
+
+		mid := len(sorted) / 2
+		if isTime {
+			if len(sorted)%2 == 1 {
+				return &RawData{Type: typ, Value: sorted[mid]}
+			}
+			return &RawData{Type: typ, Value: avgTimes(sorted[mid-1 : mid+1])}
+		}
+		if len(sorted)%2 == 1 {
+			return &RawData{Type: typ, Value: sortFloat(sorted[mid])}
+		}
+		return &RawData{Type: typ, Value: (sortFloat(sorted[mid-1]) + sortFloat(sorted[mid])) / 2}
+	}
+
+	return &RawData{Type: typ, Error: errors.New("unknown aggregation '" + agg + "'")}
+}
+
+// sumNumbers adds up all numbers. The sum stays an int as long as all
+// values are ints, as soon as we see a float it widens to float.
+func sumNumbers(vals []interface{}) interface{} {
+	var isum int64
+	var fsum float64
+	isFloat := false
+	for _, v := range vals {
+		switch x := v.(type) {
+		case int64:
+			isum += x
+		case float64:
+			fsum += x
+			isFloat = true
+		}
+	}
+
+	if isFloat {
+		return fsum + float64(isum)
+	}
+	return isum
+}
+
+// avgTimes computes the average of times or durations. Infinite times
+// dominate the result, unless they cancel each other out.
+func avgTimes(vals []interface{}) *time.Time {
+	var sum float64
+	var hasPast, hasFuture bool
+	for _, v := range vals {
+		t := v.(*time.Time)
+		switch *t {
+		case NeverPastTime:
+			hasPast = true
+		case NeverFutureTime:
+			hasFuture = true
+		default:
+			sum += float64(t.Unix())
+		}
+	}
+
+	switch {
+	case hasPast && hasFuture:
+		return nil
+	case hasPast:
+		return &NeverPastTime
+	case hasFuture:
+		return &NeverFutureTime
+	}
+
+	res := time.Unix(int64(sum/float64(len(vals))), 0)
+	return &res
+}
+
+func arrayAggregateV2(agg string) func(*blockExecutor, *RawData, *Chunk, uint64) (*RawData, uint64, error) {
+	return func(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+		typ := types.Type(chunk.Function.Type)
+		if bind.Value == nil {
+			return &RawData{Type: typ, Error: bind.Error}, 0, nil
+		}
+
+		arr, ok := bind.Value.([]interface{})
+		if !ok {
+			return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into array")
+		}
+
+		return aggregateValues(agg, arr, typ), 0, nil
+	}
+}
+
+// Takes an array and a function block, which computes the values that are
+// aggregated, e.g. `files.sum(size)`
+func arrayFieldAggregateV2(agg string) func(*blockExecutor, *RawData, *Chunk, uint64) (*RawData, uint64, error) {
+	return func(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+		typ := types.Type(chunk.Function.Type)
+		return runArrayKeyBlocks(e, chunk, ref,
+			func(items *RawData) *RawData {
+				return &RawData{Type: typ}
+			},
+			func(items *RawData, list []interface{}, keys []interface{}) *RawData {
+				return aggregateValues(agg, keys, typ)
+			})
+	}
+}
+
+// groupKey turns the key of a groupBy or countBy call into the string we
+// use for the resulting map
+func groupKey(key interface{}) (string, error) {
+	switch x := key.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return x, nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64), nil
+	case *time.Time:
+		if x == nil {
+			return "null", nil
+		}
+		return x.Format(time.RFC3339), nil
+	case Version:
+		return x.String(), nil
+	case Duration:
+		return x.String(), nil
+	default:
+		return "", errors.New("cannot group by values of type " + fmt.Sprintf("%T", key))
+	}
+}
+
+// groupMap collects the entries of a groupBy or countBy call into a map of
+// type typ. Every entry i is stored under keys[i], where add combines it
+// with the value that is already stored for that key.
+func groupMap(typ types.Type, keys []interface{}, add func(cur interface{}, i int) interface{}) *RawData {
+	if typ.Key() == types.Int {
+		res := map[int]interface{}{}
+		for i := range keys {
+			key, ok := keys[i].(int64)
+			if !ok {
+				return &RawData{Type: typ, Error: errors.New("cannot group by " + fmt.Sprintf("%v", keys[i]) + ", expected an int key")}
+			}
+			res[int(key)] = add(res[int(key)], i)
+		}
+		return &RawData{Type: typ, Value: res}
+	}
+
+	res := map[string]interface{}{}
+	for i := range keys {
+		key, err := groupKey(keys[i])
+		if err != nil {
+			return &RawData{Type: typ, Error: err}
+		}
+		res[key] = add(res[key], i)
+	}
+	return &RawData{Type: typ, Value: res}
+}
+
+func emptyGroupMap(typ types.Type, items *RawData) *RawData {
+	if items.Value == nil {
+		return &RawData{Type: typ}
+	}
+	if typ.Key() == types.Int {
+		return &RawData{Type: typ, Value: map[int]interface{}{}}
+	}
+	return &RawData{Type: typ, Value: map[string]interface{}{}}
+}
+
+// Takes an array and a function block, which computes the key that every
+// entry is grouped by. All entries in a group keep their original order.
+func arrayGroupByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	return runArrayKeyBlocks(e, chunk, ref,
+		func(items *RawData) *RawData {
+			return emptyGroupMap(typ, items)
+		},
+		func(items *RawData, list []interface{}, keys []interface{}) *RawData {
+			return groupMap(typ, keys, func(cur interface{}, i int) interface{} {
+				group, _ := cur.([]interface{})
+				return append(group, list[i])
+			})
+		})
+}
+
+// Takes an array and a function block, which computes the key that every
+// entry is counted by
+func arrayCountByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	return runArrayKeyBlocks(e, chunk, ref,
+		func(items *RawData) *RawData {
+			return emptyGroupMap(typ, items)
+		},
+		func(items *RawData, list []interface{}, keys []interface{}) *RawData {
+			return groupMap(typ, keys, func(cur interface{}, i int) interface{} {
+				cnt, _ := cur.(int64)
+				return cnt + 1
+			})
+		})
+}
+
 func compileArrayOpArray(op string) func(types.Type, types.Type) (string, error) {
 	return func(left types.Type, right types.Type) (string, error) {
 		name := string(left.Child()) + op + string(right)
@@ -0,0 +1,58 @@
+package llx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+)
+
+func TestArrayGroupBy(t *testing.T) {
+	t.Run("int keys", func(t *testing.T) {
+		res, _ := runList(t, "files.list.groupBy(size / 40)")
+		require.NoError(t, res.Error)
+		groups, ok := res.Value.(map[int]interface{})
+		require.True(t, ok, "groupBy with int keys returns an int map")
+		assert.Len(t, groups, 3)
+		assert.Len(t, groups[2], 20)
+
+		res, _ = runList(t, "files.list.groupBy(size / 40)[1].length")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(40), res.Value)
+	})
+
+	t.Run("string keys", func(t *testing.T) {
+		res, _ := runList(t, "files.list.groupBy(size > 89)")
+		require.NoError(t, res.Error)
+		groups, ok := res.Value.(map[string]interface{})
+		require.True(t, ok, "groupBy with bool keys returns a string map")
+		assert.Len(t, groups["true"], 10)
+		assert.Len(t, groups["false"], 90)
+	})
+
+	t.Run("countBy", func(t *testing.T) {
+		res, _ := runList(t, "files.list.countBy(size / 30)[3]")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(10), res.Value)
+
+		res, _ = runList(t, "files.list.countBy(size / 30).keys.length")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(4), res.Value)
+
+		res, _ = runList(t, "files.list.countBy(size / 30).where(key > 1).length")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(2), res.Value)
+	})
+}
+
+func TestArrayBlockList(t *testing.T) {
+	res, _ := runList(t, "files.list { size }")
+	require.NoError(t, res.Error)
+	blocks := res.Value.([]interface{})
+	require.Len(t, blocks, 100)
+
+	self, ok := blocks[3].(map[string]interface{})["_"].(*llx.RawData)
+	require.True(t, ok, "blocks keep the resource they ran on")
+	assert.Equal(t, "file", self.Value.(llx.Resource).MqlName())
+}
@@ -3,6 +3,7 @@ package llx
 import (
 	"errors"
 	"strconv"
+	"time"
 
 	"go.mondoo.com/cnquery/types"
 )
@@ -31,6 +32,10 @@ func init() {
 		"switch":         switchCallV2,
 		"score":          scoreCallV2,
 		"typeof":         typeofCallV2,
+		"version":        versionCallV2,
+		"ip":             ipCallV2,
+		"cidr":           cidrCallV2,
+		"duration":       durationCallV2,
 		"{}":             blockV2,
 		"return":         returnCallV2,
 		"createResource": globalCreateResource,
@@ -179,6 +184,128 @@ func typeofCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64,
 	return StringData(res.Type.Label()), 0, nil
 }
 
+// versionCallV2 creates a version from a string and an optional ecosystem
+func versionCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) != 1 && len(f.Args) != 2 {
+		return nil, 0, errors.New("Called `version` with " + strconv.Itoa(len(f.Args)) + " arguments, expected one or two")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+	if res.Value == nil {
+		return &RawData{Type: types.Version}, 0, nil
+	}
+
+	var ecosystem string
+	if len(f.Args) == 2 {
+		eco, dref, err := e.resolveValue(f.Args[1], ref)
+		if err != nil || dref != 0 || eco == nil {
+			return eco, dref, err
+		}
+		if eco.Value != nil {
+			ecosystem = eco.Value.(string)
+		}
+	}
+
+	var value string
+	switch x := res.Value.(type) {
+	case string:
+		value = x
+	case Version:
+		value = x.Value
+		if ecosystem == "" {
+			ecosystem = x.Ecosystem
+		}
+	default:
+		return nil, 0, errors.New("Called `version` with " + res.Type.Label() + ", expected a string")
+	}
+
+	v, err := NewVersion(value, ecosystem)
+	if err != nil {
+		return &RawData{Type: types.Version, Error: err}, 0, nil
+	}
+	return VersionData(v), 0, nil
+}
+
+// ipCallV2 creates an IP address from a string
+func ipCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	return parseCallV2(e, f, ref, types.IP, func(s string) (string, error) {
+		ip, err := ParseIP(s)
+		return ip.String(), err
+	})
+}
+
+// cidrCallV2 creates a network from a string in CIDR notation
+func cidrCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	return parseCallV2(e, f, ref, types.CIDR, func(s string) (string, error) {
+		network, err := ParseCIDR(s)
+		return network.String(), err
+	})
+}
+
+// durationCallV2 creates a duration from a string like 90d or PT24H, from
+// a number of seconds, or from a time that is used as a duration
+func durationCallV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
+	if len(f.Args) != 1 {
+		return nil, 0, errors.New("Called `duration` with " + strconv.Itoa(len(f.Args)) + " arguments, expected one")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+	if res.Value == nil {
+		return &RawData{Type: types.Duration}, 0, nil
+	}
+
+	switch x := res.Value.(type) {
+	case string:
+		d, err := ParseDuration(x)
+		if err != nil {
+			return &RawData{Type: types.Duration, Error: err}, 0, nil
+		}
+		return DurationData(d), 0, nil
+	case int64:
+		return DurationData(Duration{Seconds: x}), 0, nil
+	case float64:
+		return DurationData(Duration{Seconds: int64(x)}), 0, nil
+	case *time.Time:
+		return DurationData(durationOf(x)), 0, nil
+	case Duration:
+		return DurationData(x), 0, nil
+	default:
+		return nil, 0, errors.New("Called `duration` with " + res.Type.Label() + ", expected a string")
+	}
+}
+
+// parseCallV2 turns the string that is passed to a global function into
+// a value of the given type
+func parseCallV2(e *blockExecutor, f *Function, ref uint64, typ types.Type, parse func(string) (string, error)) (*RawData, uint64, error) {
+	if len(f.Args) != 1 {
+		return nil, 0, errors.New("Called `" + typ.Label() + "` with " + strconv.Itoa(len(f.Args)) + " arguments, expected one")
+	}
+
+	res, dref, err := e.resolveValue(f.Args[0], ref)
+	if err != nil || dref != 0 || res == nil {
+		return res, dref, err
+	}
+	if res.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+
+	s, ok := res.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("Called `" + typ.Label() + "` with " + res.Type.Label() + ", expected a string")
+	}
+	value, err := parse(s)
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+	return &RawData{Type: typ, Value: value}, 0, nil
+}
+
 func expectV2(e *blockExecutor, f *Function, ref uint64) (*RawData, uint64, error) {
 	if len(f.Args) != 1 {
 		return nil, 0, errors.New("Called expect with " + strconv.Itoa(len(f.Args)) + " arguments, expected 1")
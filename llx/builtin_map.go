@@ -28,12 +28,11 @@ func mapGetIndexV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*
 		return nil, 0, errors.New("Called [] with " + strconv.Itoa(len(args)) + " arguments, only 1 supported.")
 	}
 	t := types.Type(args[0].Type)
-	if t != types.String {
+	if t != bind.Type.Key() || (t != types.String && t != types.Int) {
 		return nil, 0, errors.New("Called [] with wrong type " + t.Label())
 	}
 	// ^^ TODO
 
-	key := string(args[0].Value)
 	childType := bind.Type.Child()
 
 	if bind.Value == nil {
@@ -43,6 +42,18 @@ func mapGetIndexV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*
 		}, 0, nil
 	}
 
+	if t == types.Int {
+		m, ok := bind.Value.(map[int]interface{})
+		if !ok {
+			return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into map")
+		}
+		return &RawData{
+			Type:  childType,
+			Value: m[int(bytes2int(args[0].Value))],
+		}, 0, nil
+	}
+
+	key := string(args[0].Value)
 	m, ok := bind.Value.(map[string]interface{})
 	if !ok {
 		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into map")
@@ -58,11 +69,14 @@ func mapLengthV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Ra
 		return &RawData{Type: types.Int}, 0, nil
 	}
 
-	arr, ok := bind.Value.(map[string]interface{})
-	if !ok {
+	switch m := bind.Value.(type) {
+	case map[string]interface{}:
+		return IntData(int64(len(m))), 0, nil
+	case map[int]interface{}:
+		return IntData(int64(len(m))), 0, nil
+	default:
 		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into map")
 	}
-	return IntData(int64(len(arr))), 0, nil
 }
 
 func _mapWhereV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, invert bool) (*RawData, uint64, error) {
@@ -77,8 +91,23 @@ func _mapWhereV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, inve
 		return &RawData{Type: items.Type}, 0, nil
 	}
 
-	list := items.Value.(map[string]interface{})
-	if len(list) == 0 {
+	var keys []interface{}
+	var values []interface{}
+	switch m := items.Value.(type) {
+	case map[string]interface{}:
+		for key, value := range m {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+	case map[int]interface{}:
+		for key, value := range m {
+			keys = append(keys, int64(key))
+			values = append(values, value)
+		}
+	default:
+		return nil, 0, errors.New("failed to typecast " + items.Type.Label() + " into map")
+	}
+	if len(keys) == 0 {
 		return items, 0, nil
 	}
 
@@ -97,31 +126,41 @@ func _mapWhereV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, inve
 		return nil, dref, err
 	}
 
+	keyType := items.Type.Key()
 	valueType := items.Type.Child()
 
-	argsList := make([][]*RawData, len(list))
-	i := 0
-	for key, value := range list {
+	argsList := make([][]*RawData, len(keys))
+	for i := range keys {
 		argsList[i] = []*RawData{
 			{
-				Type:  types.String,
-				Value: key,
+				Type:  keyType,
+				Value: keys[i],
 			},
 			{
 				Type:  valueType,
-				Value: value,
+				Value: values[i],
 			},
 		}
-		i++
 	}
 
 	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
-		resMap := map[string]interface{}{}
-		for i, res := range results {
-			if res.isTruthy() == !invert {
-				key := argsList[i][0].Value.(string)
-				resMap[key] = list[key]
+		var resMap interface{}
+		if keyType == types.Int {
+			m := map[int]interface{}{}
+			for i, res := range results {
+				if res.isTruthy() == !invert {
+					m[int(keys[i].(int64))] = values[i]
+				}
+			}
+			resMap = m
+		} else {
+			m := map[string]interface{}{}
+			for i, res := range results {
+				if res.isTruthy() == !invert {
+					m[keys[i].(string)] = values[i]
+				}
 			}
+			resMap = m
 		}
 		data := &RawData{
 			Type:  bind.Type,
@@ -161,6 +200,14 @@ func mapKeysV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawD
 		}, 0, nil
 	}
 
+	if m, ok := bind.Value.(map[int]interface{}); ok {
+		res := make([]interface{}, 0, len(m))
+		for _, key := range intKeys(m) {
+			res = append(res, int64(key))
+		}
+		return ArrayData(res, types.Int), 0, nil
+	}
+
 	m, ok := bind.Value.(map[string]interface{})
 	if !ok {
 		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into map")
@@ -186,6 +233,14 @@ func mapValuesV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Ra
 		}, 0, nil
 	}
 
+	if m, ok := bind.Value.(map[int]interface{}); ok {
+		res := make([]interface{}, 0, len(m))
+		for _, value := range m {
+			res = append(res, value)
+		}
+		return ArrayData(res, typ), 0, nil
+	}
+
 	m, ok := bind.Value.(map[string]interface{})
 	if !ok {
 		return nil, 0, errors.New("failed to typecast " + bind.Type.Label() + " into map")
@@ -434,6 +489,78 @@ func dictTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 	return stringTrimV2(e, bind, chunk, ref)
 }
 
+func dictReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `replace`")
+	}
+
+	return stringReplaceV2(e, bind, chunk, ref)
+}
+
+func dictReplaceAllV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `replaceAll`")
+	}
+
+	return stringReplaceAllV2(e, bind, chunk, ref)
+}
+
+func dictSubstrV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `substr`")
+	}
+
+	return stringSubstrV2(e, bind, chunk, ref)
+}
+
+func dictStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `startsWith`")
+	}
+
+	return stringStartsWithV2(e, bind, chunk, ref)
+}
+
+func dictEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `endsWith`")
+	}
+
+	return stringEndsWithV2(e, bind, chunk, ref)
+}
+
+func dictPadLeftV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `padLeft`")
+	}
+
+	return stringPadLeftV2(e, bind, chunk, ref)
+}
+
+func dictPadRightV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `padRight`")
+	}
+
+	return stringPadRightV2(e, bind, chunk, ref)
+}
+
+func dictFormatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, ok := bind.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("dict value does not support field `format`")
+	}
+
+	return stringFormatV2(e, bind, chunk, ref)
+}
+
 func dictKeysV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	if bind.Value == nil {
 		return &RawData{
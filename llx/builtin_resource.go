@@ -2,6 +2,7 @@ package llx
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"go.mondoo.com/cnquery/types"
@@ -249,38 +250,110 @@ var defaultTimeFormatsOrder = []string{
 	time.Stamp,
 }
 
-func resourceDateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
-	panic("NO DATE PARSING YET")
-	// args, rref, err := args2resourceargsV2(e, ref, chunk.Function.Args)
-	// if err != nil || rref != 0 {
-	// 	return nil, rref, err
-	// }
+// timeLocation loads a timezone by its name, e.g. UTC or Europe/Berlin, or
+// by its offset to UTC, e.g. +02:00
+func timeLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if name[0] == '+' || name[0] == '-' {
+		offset, err := time.Parse("-07:00", name)
+		if err != nil {
+			return nil, errors.New("invalid timezone offset '" + name + "', expected e.g. +02:00")
+		}
+		_, secs := offset.Zone()
+		return time.FixedZone(name, secs), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.New("unknown timezone '" + name + "'")
+	}
+	return loc, nil
+}
 
-	// var format string
-	// if len(args) >= 2 {
-	// 	format = args[1].(string)
-	// 	if f, ok := timeFormats[format]; ok {
-	// 		format = f
-	// 	}
-	// }
+// parseTime parses a time with an optional format, which is either one of
+// the named timeFormats or a Go layout. Times that don't contain a timezone
+// are parsed in the given one.
+func parseTime(value string, format string, timezone string) (time.Time, error) {
+	loc, err := timeLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
 
-	// if format != "" {
-	// 	parsed, err := time.Parse(format, args[0].(string))
-	// 	if err != nil {
-	// 		return nil, 0, errors.New("failed to parse time: " + err.Error())
-	// 	}
-	// 	return TimeData(parsed), 0, nil
-	// }
+	if format != "" {
+		if f, ok := timeFormats[format]; ok {
+			format = f
+		}
+		parsed, err := time.ParseInLocation(format, value, loc)
+		if err != nil {
+			return parsed, errors.New("failed to parse time: " + err.Error())
+		}
+		return parsed, nil
+	}
 
-	// // Note: Yes, this approach is much slower than giving us a hint
-	// // about which time format is used.
-	// for _, format := range defaultTimeFormatsOrder {
-	// 	parsed, err := time.Parse(format, args[0].(string))
-	// 	if err != nil {
-	// 		continue
-	// 	}
-	// 	return TimeData(parsed), 0, nil
-	// }
+	// Note: Yes, this approach is much slower than giving us a hint
+	// about which time format is used.
+	for _, format := range defaultTimeFormatsOrder {
+		parsed, err := time.ParseInLocation(format, value, loc)
+		if err != nil {
+			continue
+		}
+		return parsed, nil
+	}
 
-	return nil, 0, errors.New("failed to parse time")
+	return time.Time{}, errors.New("failed to parse time '" + value + "'")
+}
+
+// resourceDateV2 parses a time from its value, with an optional format
+// and timezone, e.g. parse.date or time.parse
+func resourceDateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	args := make([]string, 3)
+	for i := range chunk.Function.Args {
+		if i >= len(args) {
+			return nil, 0, errors.New("too many arguments to parse time")
+		}
+		arg, rref, err := e.resolveValue(chunk.Function.Args[i], ref)
+		if err != nil || rref != 0 {
+			return nil, rref, err
+		}
+		if arg == nil || arg.Value == nil {
+			if i == 0 {
+				return &RawData{Type: types.Time}, 0, nil
+			}
+			continue
+		}
+		s, ok := arg.Value.(string)
+		if !ok {
+			return nil, 0, errors.New("failed to parse time: expected a string, got " + arg.Type.Label())
+		}
+		args[i] = s
+	}
+
+	parsed, err := parseTime(args[0], args[1], args[2])
+	if err != nil {
+		return &RawData{Type: types.Time, Error: err}, 0, nil
+	}
+	return TimeData(parsed), 0, nil
+}
+
+// timeUnits are durations that are builtin to the time resource. Months
+// and years are calendar-aware, all other units are a fixed number of
+// seconds.
+var timeUnits = map[string]Duration{
+	"second": {Seconds: 1},
+	"minute": {Seconds: secondsPerMinute},
+	"hour":   {Seconds: secondsPerHour},
+	"day":    {Seconds: secondsPerDay},
+	"week":   {Seconds: secondsPerWeek},
+	"month":  {Months: 1},
+	"year":   {Months: 12},
+}
+
+func resourceTimeUnitV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	_, unit, _ := strings.Cut(chunk.Id, ".")
+	d, ok := timeUnits[unit]
+	if !ok {
+		return nil, 0, errors.New("unknown unit of time '" + unit + "'")
+	}
+	return DurationData(d), 0, nil
 }
@@ -2,12 +2,16 @@ package llx
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"math"
+	"net/netip"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"go.mondoo.com/cnquery/types"
 )
@@ -884,49 +888,51 @@ func timeMinusTimeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64)
 	})
 }
 
-func timePlusTimeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
-	return dataOpV2(e, bind, chunk, ref, types.Time, func(left interface{}, right interface{}) *RawData {
-		l := left.(*time.Time)
-		r := right.(*time.Time)
-		if l == nil || r == nil {
-			return &RawData{Type: types.Time}
-		}
+func opTimePlusTime(left interface{}, right interface{}) *RawData {
+	l := left.(*time.Time)
+	r := right.(*time.Time)
+	if l == nil || r == nil {
+		return &RawData{Type: types.Time}
+	}
 
-		if *r == NeverPastTime {
-			return NeverFuturePrimitive.RawData()
-		}
-		if *r == NeverFutureTime {
-			return NeverPastPrimitive.RawData()
-		}
-		if *l == NeverPastTime {
-			return NeverPastPrimitive.RawData()
-		}
-		if *l == NeverFutureTime {
-			return NeverFuturePrimitive.RawData()
-		}
+	if *r == NeverPastTime {
+		return NeverFuturePrimitive.RawData()
+	}
+	if *r == NeverFutureTime {
+		return NeverPastPrimitive.RawData()
+	}
+	if *l == NeverPastTime {
+		return NeverPastPrimitive.RawData()
+	}
+	if *l == NeverFutureTime {
+		return NeverFuturePrimitive.RawData()
+	}
 
-		lt := l.Unix()
-		rt := r.Unix()
+	lt := l.Unix()
+	rt := r.Unix()
 
-		// the breakpoint for time and duration is the unix time of zero
-		bothDuration := false
-		if lt < 0 {
-			lt = TimeToDuration(l)
-			if rt < 0 {
-				bothDuration = true
-			}
-		}
+	// the breakpoint for time and duration is the unix time of zero
+	bothDuration := false
+	if lt < 0 {
+		lt = TimeToDuration(l)
 		if rt < 0 {
-			rt = TimeToDuration(r)
+			bothDuration = true
 		}
+	}
+	if rt < 0 {
+		rt = TimeToDuration(r)
+	}
 
-		sum := lt + rt
-		if bothDuration {
-			return TimeData(DurationToTime(sum))
-		}
+	sum := lt + rt
+	if bothDuration {
+		return TimeData(DurationToTime(sum))
+	}
 
-		return TimeData(time.Unix(sum, 0))
-	})
+	return TimeData(time.Unix(sum, 0))
+}
+
+func timePlusTimeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Time, opTimePlusTime)
 }
 
 func opTimeTimesInt(left interface{}, right interface{}) *RawData {
@@ -2116,9 +2122,10 @@ func stringContainsArrayRegex(e *blockExecutor, bind *RawData, chunk *Chunk, ref
 	return BoolData(false), 0, nil
 }
 
+// stringFindV2 returns all matches of a regex, each with its capture groups
 func stringFindV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
 	if bind.Value == nil {
-		return ArrayData([]interface{}{}, types.String), 0, nil
+		return ArrayData([]interface{}{}, types.Array(types.String)), 0, nil
 	}
 
 	argRef := chunk.Function.Args[0]
@@ -2128,7 +2135,7 @@ func stringFindV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	}
 
 	if arg.Value == nil {
-		return ArrayData([]interface{}{}, types.String), 0, nil
+		return ArrayData([]interface{}{}, types.Array(types.String)), 0, nil
 	}
 
 	reContent := arg.Value.(string)
@@ -2137,13 +2144,17 @@ func stringFindV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 		return nil, 0, errors.New("Failed to compile regular expression: " + reContent)
 	}
 
-	list := re.FindAllString(bind.Value.(string), -1)
-	res := make([]interface{}, len(list))
-	for i := range list {
-		res[i] = list[i]
+	matches := re.FindAllStringSubmatch(bind.Value.(string), -1)
+	res := make([]interface{}, len(matches))
+	for i := range matches {
+		groups := make([]interface{}, len(matches[i]))
+		for j := range matches[i] {
+			groups[j] = matches[i][j]
+		}
+		res[i] = groups
 	}
 
-	return ArrayData(res, types.String), 0, nil
+	return ArrayData(res, types.Array(types.String)), 0, nil
 }
 
 func stringDowncaseV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
@@ -2273,6 +2284,260 @@ func stringTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	return StringData(res), 0, nil
 }
 
+func stringReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	from, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	to, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if from.Value == nil || to.Value == nil {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to replace in string, arguments cannot be null"),
+		}, 0, nil
+	}
+
+	res := strings.ReplaceAll(bind.Value.(string), from.Value.(string), to.Value.(string))
+	return StringData(res), 0, nil
+}
+
+func stringReplaceAllV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	reArg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	repl, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if reArg.Value == nil || repl.Value == nil {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to replace in string, arguments cannot be null"),
+		}, 0, nil
+	}
+
+	reContent := reArg.Value.(string)
+	re, err := regexp.Compile(reContent)
+	if err != nil {
+		return nil, 0, errors.New("Failed to compile regular expression: " + reContent)
+	}
+
+	res := re.ReplaceAllString(bind.Value.(string), repl.Value.(string))
+	return StringData(res), 0, nil
+}
+
+func stringSubstrV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	startArg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if startArg.Value == nil {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to get substring, start was null"),
+		}, 0, nil
+	}
+
+	runes := []rune(bind.Value.(string))
+	start := startArg.Value.(int64)
+	if start < 0 {
+		return &RawData{
+			Type:  types.String,
+			Error: errors.New("failed to get substring, start cannot be negative"),
+		}, 0, nil
+	}
+	if start > int64(len(runes)) {
+		start = int64(len(runes))
+	}
+
+	end := int64(len(runes))
+	if len(chunk.Function.Args) > 1 {
+		lengthArg, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+		if lengthArg.Value == nil {
+			return &RawData{
+				Type:  types.String,
+				Error: errors.New("failed to get substring, length was null"),
+			}, 0, nil
+		}
+
+		length := lengthArg.Value.(int64)
+		if length < 0 {
+			return &RawData{
+				Type:  types.String,
+				Error: errors.New("failed to get substring, length cannot be negative"),
+			}, 0, nil
+		}
+		if start+length < end {
+			end = start + length
+		}
+	}
+
+	return StringData(string(runes[start:end])), 0, nil
+}
+
+func stringStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if arg.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	return BoolData(strings.HasPrefix(bind.Value.(string), arg.Value.(string))), 0, nil
+}
+
+func stringEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if arg.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	return BoolData(strings.HasSuffix(bind.Value.(string), arg.Value.(string))), 0, nil
+}
+
+// maxPaddedWidth limits the width of padded strings, so that a large width
+// doesn't allocate without bound
+const maxPaddedWidth = 1 << 16
+
+// stringPadding computes the padding that is needed to bring a string
+// to the width requested by padLeft and padRight
+func stringPadding(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (string, uint64, error) {
+	widthArg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return "", rref, err
+	}
+	if widthArg.Value == nil {
+		return "", 0, errors.New("failed to pad string, width was null")
+	}
+	width := widthArg.Value.(int64)
+	if width > maxPaddedWidth {
+		return "", 0, errors.New("failed to pad string, width " + strconv.FormatInt(width, 10) +
+			" exceeds the maximum of " + strconv.Itoa(maxPaddedWidth))
+	}
+
+	pad := " "
+	if len(chunk.Function.Args) > 1 {
+		padArg, rref, err := e.resolveValue(chunk.Function.Args[1], ref)
+		if err != nil || rref > 0 {
+			return "", rref, err
+		}
+		if padArg.Value == nil || padArg.Value.(string) == "" {
+			return "", 0, errors.New("failed to pad string, padding cannot be empty")
+		}
+		pad = padArg.Value.(string)
+	}
+
+	missing := int(width) - utf8.RuneCountInString(bind.Value.(string))
+	if missing <= 0 {
+		return "", 0, nil
+	}
+
+	padRunes := []rune(pad)
+	res := make([]rune, missing)
+	for i := range res {
+		res[i] = padRunes[i%len(padRunes)]
+	}
+	return string(res), 0, nil
+}
+
+func stringPadLeftV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	padding, rref, err := stringPadding(e, bind, chunk, ref)
+	if rref > 0 {
+		return nil, rref, nil
+	}
+	if err != nil {
+		return &RawData{Type: types.String, Error: err}, 0, nil
+	}
+
+	return StringData(padding + bind.Value.(string)), 0, nil
+}
+
+func stringPadRightV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	padding, rref, err := stringPadding(e, bind, chunk, ref)
+	if rref > 0 {
+		return nil, rref, nil
+	}
+	if err != nil {
+		return &RawData{Type: types.String, Error: err}, 0, nil
+	}
+
+	return StringData(bind.Value.(string) + padding), 0, nil
+}
+
+// stringFormatV2 uses the string as a format for all arguments it is called
+// with, e.g. `'%s: %d'.format(name, uid)`
+func stringFormatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	args := make([]interface{}, len(chunk.Function.Args))
+	for i := range chunk.Function.Args {
+		arg, rref, err := e.resolveValue(chunk.Function.Args[i], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+
+		switch v := arg.Value.(type) {
+		case *time.Time:
+			if v == nil {
+				args[i] = nil
+			} else {
+				args[i] = *v
+			}
+		case Resource:
+			args[i] = v.MqlName() + " id = " + v.MqlID()
+		default:
+			args[i] = v
+		}
+	}
+
+	return StringData(fmt.Sprintf(bind.Value.(string), args...)), 0, nil
+}
+
 // time methods
 
 // zeroTimeOffset to help convert unix times into base times that start at the year 0
@@ -2366,6 +2631,504 @@ func timeUnixV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*Raw
 	return IntData(int64(raw)), 0, nil
 }
 
+// timeFormatV2 formats a time with a layout, which is either one of the
+// named timeFormats or a Go layout, and an optional timezone
+func timeFormatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+	t := bind.Value.(*time.Time)
+	if t == nil {
+		return &RawData{Type: types.String}, 0, nil
+	}
+
+	args := make([]string, 2)
+	for i := range chunk.Function.Args {
+		if i >= len(args) {
+			return nil, 0, errors.New("too many arguments to format time")
+		}
+		arg, rref, err := e.resolveValue(chunk.Function.Args[i], ref)
+		if err != nil || rref != 0 {
+			return nil, rref, err
+		}
+		if arg == nil || arg.Value == nil {
+			continue
+		}
+		s, ok := arg.Value.(string)
+		if !ok {
+			return nil, 0, errors.New("failed to format time: expected a string, got " + arg.Type.Label())
+		}
+		args[i] = s
+	}
+
+	if *t == NeverPastTime || *t == NeverFutureTime {
+		return StringData("Never"), 0, nil
+	}
+
+	layout := args[0]
+	if f, ok := timeFormats[layout]; ok {
+		layout = f
+	}
+	loc, err := timeLocation(args[1])
+	if err != nil {
+		return &RawData{Type: types.String, Error: err}, 0, nil
+	}
+	return StringData(t.In(loc).Format(layout)), 0, nil
+}
+
+// duration methods
+
+func opDurationCmpNil(left *RawData, right *RawData) bool {
+	return left.Value == nil
+}
+
+func durationCmpNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, opDurationCmpNil)
+}
+
+func durationNotNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolNotOpV2(e, bind, chunk, ref, opDurationCmpNil)
+}
+
+// durationOf a value that is used as a duration, which is either a duration
+// or a time that represents one, e.g. the difference of two times
+func durationOf(value interface{}) Duration {
+	switch x := value.(type) {
+	case Duration:
+		return x
+	case *time.Time:
+		if x == nil {
+			return Duration{}
+		}
+		if *x == NeverPastTime {
+			return Duration{Seconds: math.MinInt64}
+		}
+		if *x == NeverFutureTime {
+			return Duration{Seconds: math.MaxInt64}
+		}
+		return DurationFromTime(x)
+	default:
+		return Duration{}
+	}
+}
+
+func opDurationCmpDuration(left interface{}, right interface{}) bool {
+	return durationOf(left).Compare(durationOf(right)) == 0
+}
+
+func durationCmpDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolOpV2(e, bind, chunk, ref, opDurationCmpDuration)
+}
+
+func durationNotDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolNotOpV2(e, bind, chunk, ref, opDurationCmpDuration)
+}
+
+func durationOrderV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(int) bool) (*RawData, uint64, error) {
+	return nonNilDataOpV2(e, bind, chunk, ref, types.Bool, func(left interface{}, right interface{}) *RawData {
+		return BoolData(f(durationOf(left).Compare(durationOf(right))))
+	})
+}
+
+func durationLTDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp < 0 })
+}
+
+func durationLTEDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp <= 0 })
+}
+
+func durationGTDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp > 0 })
+}
+
+func durationGTEDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp >= 0 })
+}
+
+func durationPlusDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		return DurationData(left.(Duration).Add(right.(Duration)))
+	})
+}
+
+func durationMinusDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		return DurationData(left.(Duration).Add(right.(Duration).Neg()))
+	})
+}
+
+func durationTimesIntV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		d := left.(Duration)
+		i := right.(int64)
+		return DurationData(Duration{Months: d.Months * i, Seconds: d.Seconds * i})
+	})
+}
+
+func intTimesDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		d := right.(Duration)
+		i := left.(int64)
+		return DurationData(Duration{Months: d.Months * i, Seconds: d.Seconds * i})
+	})
+}
+
+func durationTimesFloatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		return DurationData(left.(Duration).Scale(right.(float64)))
+	})
+}
+
+func floatTimesDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		return DurationData(right.(Duration).Scale(left.(float64)))
+	})
+}
+
+// durationDivided splits a duration into equal parts, e.g. 1mo / 2
+func durationDivided(d Duration, by float64) *RawData {
+	if by == 0 {
+		return &RawData{Type: types.Duration, Error: errors.New("cannot divide duration by zero")}
+	}
+	return DurationData(d.Scale(1 / by))
+}
+
+func durationDividedIntV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		return durationDivided(left.(Duration), float64(right.(int64)))
+	})
+}
+
+func durationDividedFloatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Duration, func(left interface{}, right interface{}) *RawData {
+		return durationDivided(left.(Duration), right.(float64))
+	})
+}
+
+// opTimePlusDuration adds a duration to a time, using the calendar for
+// months and years
+func opTimePlusDuration(t *time.Time, d Duration) *RawData {
+	if t == nil {
+		return &RawData{Type: types.Time}
+	}
+	if *t == NeverPastTime {
+		return NeverPastPrimitive.RawData()
+	}
+	if *t == NeverFutureTime {
+		return NeverFuturePrimitive.RawData()
+	}
+	return TimeData(d.AddTo(*t))
+}
+
+func timePlusDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Time, func(left interface{}, right interface{}) *RawData {
+		return opTimePlusDuration(left.(*time.Time), right.(Duration))
+	})
+}
+
+func timeMinusDurationV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Time, func(left interface{}, right interface{}) *RawData {
+		return opTimePlusDuration(left.(*time.Time), right.(Duration).Neg())
+	})
+}
+
+func durationPlusTimeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return dataOpV2(e, bind, chunk, ref, types.Time, func(left interface{}, right interface{}) *RawData {
+		return opTimePlusDuration(right.(*time.Time), left.(Duration))
+	})
+}
+
+// durationMethodV2 runs a function on the duration that is bound
+func durationMethodV2(bind *RawData, f func(Duration) int64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Int}, 0, nil
+	}
+	return IntData(f(bind.Value.(Duration))), 0, nil
+}
+
+func durationSecondsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationMethodV2(bind, func(d Duration) int64 { return d.Approx() })
+}
+
+func durationMinutesV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationMethodV2(bind, func(d Duration) int64 { return d.Approx() / secondsPerMinute })
+}
+
+func durationHoursV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationMethodV2(bind, func(d Duration) int64 { return d.Approx() / secondsPerHour })
+}
+
+func durationDaysV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationMethodV2(bind, func(d Duration) int64 { return d.Approx() / secondsPerDay })
+}
+
+func durationMonthsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationMethodV2(bind, func(d Duration) int64 { return d.Months + d.Seconds/secondsPerMonth })
+}
+
+func durationYearsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return durationMethodV2(bind, func(d Duration) int64 { return (d.Months + d.Seconds/secondsPerMonth) / 12 })
+}
+
+// version methods
+
+func opVersionCmpNil(left *RawData, right *RawData) bool {
+	return left.Value == nil
+}
+
+func versionCmpNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, opVersionCmpNil)
+}
+
+func versionNotNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolNotOpV2(e, bind, chunk, ref, opVersionCmpNil)
+}
+
+// versionEqualityV2 checks if two versions are equal. Unlike other types,
+// versions that can't be compared, e.g. because they belong to different
+// ecosystems, result in an error instead of being unequal.
+func versionEqualityV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, equal bool) (*RawData, uint64, error) {
+	v, dref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil {
+		return nil, 0, err
+	}
+	if dref != 0 {
+		return nil, dref, nil
+	}
+
+	if bind.Value == nil || v == nil || v.Value == nil {
+		bothNil := bind.Value == nil && (v == nil || v.Value == nil)
+		return BoolData(bothNil == equal), 0, nil
+	}
+
+	cmp, err := compareVersions(bind.Value, v.Value)
+	if err != nil {
+		return &RawData{Type: types.Bool, Error: err}, 0, nil
+	}
+	return BoolData((cmp == 0) == equal), 0, nil
+}
+
+func versionCmpVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionEqualityV2(e, bind, chunk, ref, true)
+}
+
+func versionNotVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionEqualityV2(e, bind, chunk, ref, false)
+}
+
+func versionOrderV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(int) bool) (*RawData, uint64, error) {
+	return nonNilDataOpV2(e, bind, chunk, ref, types.Bool, func(left interface{}, right interface{}) *RawData {
+		cmp, err := compareVersions(left, right)
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: err}
+		}
+		return BoolData(f(cmp))
+	})
+}
+
+func versionLTVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp < 0 })
+}
+
+func versionLTEVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp <= 0 })
+}
+
+func versionGTVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp > 0 })
+}
+
+func versionGTEVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return versionOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp >= 0 })
+}
+
+func versionInRangeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool}, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if arg.Value == nil {
+		return &RawData{Type: types.Bool, Error: errors.New("failed to check version range, range was null")}, 0, nil
+	}
+
+	ok, err := bind.Value.(Version).InRange(arg.Value.(string))
+	if err != nil {
+		return &RawData{Type: types.Bool, Error: err}, 0, nil
+	}
+	return BoolData(ok), 0, nil
+}
+
+// ip methods
+
+func opIPCmpNil(left *RawData, right *RawData) bool {
+	return left.Value == nil
+}
+
+func ipCmpNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolOpV2(e, bind, chunk, ref, opIPCmpNil)
+}
+
+func ipNotNilV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return rawboolNotOpV2(e, bind, chunk, ref, opIPCmpNil)
+}
+
+func ipCmpIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolOpV2(e, bind, chunk, ref, ipEqual)
+}
+
+func ipNotIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolNotOpV2(e, bind, chunk, ref, ipEqual)
+}
+
+func ipOrderV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(int) bool) (*RawData, uint64, error) {
+	return nonNilDataOpV2(e, bind, chunk, ref, types.Bool, func(left interface{}, right interface{}) *RawData {
+		cmp, err := compareIPs(left, right)
+		if err != nil {
+			return &RawData{Type: types.Bool, Error: err}
+		}
+		return BoolData(f(cmp))
+	})
+}
+
+func ipLTIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp < 0 })
+}
+
+func ipLTEIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp <= 0 })
+}
+
+func ipGTIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp > 0 })
+}
+
+func ipGTEIPV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipOrderV2(e, bind, chunk, ref, func(cmp int) bool { return cmp >= 0 })
+}
+
+// ipMethodV2 runs a function on the IP address that is bound
+func ipMethodV2(bind *RawData, typ types.Type, f func(netip.Addr) *RawData) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+	ip, err := ParseIP(bind.Value.(string))
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+	return f(ip.Unmap()), 0, nil
+}
+
+func ipIsPrivateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipMethodV2(bind, types.Bool, func(ip netip.Addr) *RawData {
+		return BoolData(ip.IsPrivate())
+	})
+}
+
+func ipIsLoopbackV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipMethodV2(bind, types.Bool, func(ip netip.Addr) *RawData {
+		return BoolData(ip.IsLoopback())
+	})
+}
+
+func ipIsUnspecifiedV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipMethodV2(bind, types.Bool, func(ip netip.Addr) *RawData {
+		return BoolData(ip.IsUnspecified())
+	})
+}
+
+func ipVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return ipMethodV2(bind, types.Int, func(ip netip.Addr) *RawData {
+		return IntData(ipVersion(ip))
+	})
+}
+
+// cidr methods
+
+func cidrCmpCIDRV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolOpV2(e, bind, chunk, ref, cidrEqual)
+}
+
+func cidrNotCIDRV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return boolNotOpV2(e, bind, chunk, ref, cidrEqual)
+}
+
+// cidrMethodV2 runs a function on the network that is bound
+func cidrMethodV2(bind *RawData, typ types.Type, f func(netip.Prefix) *RawData) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: typ}, 0, nil
+	}
+	network, err := ParseCIDR(bind.Value.(string))
+	if err != nil {
+		return &RawData{Type: typ, Error: err}, 0, nil
+	}
+	return f(network), 0, nil
+}
+
+// cidrArgMethodV2 runs a function on the network that is bound and the
+// IP address or network that is passed as its argument
+func cidrArgMethodV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, f func(netip.Prefix, netip.Prefix) bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Bool}, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if arg.Value == nil {
+		return &RawData{Type: types.Bool, Error: errors.New("failed to compare network, argument was null")}, 0, nil
+	}
+
+	network, err := ParseCIDR(bind.Value.(string))
+	if err != nil {
+		return &RawData{Type: types.Bool, Error: err}, 0, nil
+	}
+	other, err := cidrOf(arg.Value)
+	if err != nil {
+		return &RawData{Type: types.Bool, Error: err}, 0, nil
+	}
+	return BoolData(f(network, other)), 0, nil
+}
+
+func cidrContainsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return cidrArgMethodV2(e, bind, chunk, ref, cidrContains)
+}
+
+func cidrOverlapsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return cidrArgMethodV2(e, bind, chunk, ref, func(network netip.Prefix, other netip.Prefix) bool {
+		return network.Overlaps(other)
+	})
+}
+
+func cidrPrefixLengthV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return cidrMethodV2(bind, types.Int, func(network netip.Prefix) *RawData {
+		return IntData(int64(network.Bits()))
+	})
+}
+
+func cidrVersionV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return cidrMethodV2(bind, types.Int, func(network netip.Prefix) *RawData {
+		return IntData(ipVersion(network.Addr()))
+	})
+}
+
+func cidrIsPrivateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return cidrMethodV2(bind, types.Bool, func(network netip.Prefix) *RawData {
+		return BoolData(cidrWithin(network, privateNetworks))
+	})
+}
+
+func cidrIsLoopbackV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return cidrMethodV2(bind, types.Bool, func(network netip.Prefix) *RawData {
+		return BoolData(cidrWithin(network, loopbackNetworks))
+	})
+}
+
 // stringslice methods
 
 func stringsliceEqString(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
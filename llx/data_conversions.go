@@ -32,6 +32,10 @@ func init() {
 		types.String:       string2result,
 		types.Regex:        regex2result,
 		types.Time:         time2result,
+		types.Version:      version2result,
+		types.IP:           ip2result,
+		types.CIDR:         cidr2result,
+		types.Duration:     duration2result,
 		types.Dict:         dict2result,
 		types.Score:        score2result,
 		types.Block:        block2result,
@@ -50,6 +54,10 @@ func init() {
 		types.String:       pstring2raw,
 		types.Regex:        pregex2raw,
 		types.Time:         ptime2raw,
+		types.Version:      pversion2raw,
+		types.IP:           pip2raw,
+		types.CIDR:         pcidr2raw,
+		types.Duration:     pduration2raw,
 		types.Dict:         pdict2raw,
 		types.Score:        pscore2raw,
 		types.Block:        pblock2rawV2,
@@ -198,6 +206,38 @@ func time2result(value interface{}, typ types.Type) (*Primitive, error) {
 	return TimePrimitive(v), nil
 }
 
+func version2result(value interface{}, typ types.Type) (*Primitive, error) {
+	v, ok := value.(Version)
+	if !ok {
+		return nil, errInvalidConversion(value, typ)
+	}
+	return VersionPrimitive(v), nil
+}
+
+func ip2result(value interface{}, typ types.Type) (*Primitive, error) {
+	v, ok := value.(string)
+	if !ok {
+		return nil, errInvalidConversion(value, typ)
+	}
+	return IPPrimitive(v), nil
+}
+
+func cidr2result(value interface{}, typ types.Type) (*Primitive, error) {
+	v, ok := value.(string)
+	if !ok {
+		return nil, errInvalidConversion(value, typ)
+	}
+	return CIDRPrimitive(v), nil
+}
+
+func duration2result(value interface{}, typ types.Type) (*Primitive, error) {
+	v, ok := value.(Duration)
+	if !ok {
+		return nil, errInvalidConversion(value, typ)
+	}
+	return DurationPrimitive(v), nil
+}
+
 func dict2result(value interface{}, typ types.Type) (*Primitive, error) {
 	prim, err := dict2primitive(value)
 	if err != nil {
@@ -275,18 +315,26 @@ func stringmap2result(value interface{}, typ types.Type) (*Primitive, error) {
 }
 
 func intmap2result(value interface{}, typ types.Type) (*Primitive, error) {
-	m, ok := value.(map[int32]interface{})
-	if !ok {
-		return nil, errInvalidConversion(value, typ)
-	}
 	res := make(map[string]*Primitive)
 	ct := typ.Child()
 	var err error
-	for k, v := range m {
-		res[strconv.FormatInt(int64(k), 10)], err = raw2primitive(v, ct)
-		if err != nil {
-			return nil, err
+	switch m := value.(type) {
+	case map[int]interface{}:
+		for k, v := range m {
+			res[strconv.Itoa(k)], err = raw2primitive(v, ct)
+			if err != nil {
+				return nil, err
+			}
 		}
+	case map[int32]interface{}:
+		for k, v := range m {
+			res[strconv.FormatInt(int64(k), 10)], err = raw2primitive(v, ct)
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, errInvalidConversion(value, typ)
 	}
 	return &Primitive{Type: string(typ), Map: res}, nil
 }
@@ -356,10 +404,14 @@ func (r *RawData) Result() *Result {
 		// so we return it early before raw2primitive has a chance to change the
 		// type to nil
 		if r.Value == nil {
-			return &Result{
+			res := &Result{
 				Data:  &Primitive{Type: string(r.Type)},
 				Error: errorMsg,
 			}
+			if timeout, ok := r.IsTimeout(); ok {
+				res.Timeout = timeout.proto()
+			}
+			return res
 		}
 	}
 
@@ -448,7 +500,9 @@ func (r *Result) RawResultV2() *RawResult {
 			data = r.Data.RawData()
 		}
 	}
-	if len(r.Error) > 0 {
+	if r.Timeout != nil {
+		data.Error = r.Timeout.timeoutError()
+	} else if len(r.Error) > 0 {
 		data.Error = errors.New(r.Error)
 	}
 	return &RawResult{
@@ -514,6 +568,28 @@ func ptime2raw(p *Primitive) *RawData {
 	return TimeData(bytes2time(p.Value))
 }
 
+func pversion2raw(p *Primitive) *RawData {
+	if len(p.Value) == 0 {
+		return &RawData{Type: types.Version}
+	}
+	return VersionData(bytes2version(p.Value))
+}
+
+func pip2raw(p *Primitive) *RawData {
+	return IPData(string(p.Value))
+}
+
+func pcidr2raw(p *Primitive) *RawData {
+	return CIDRData(string(p.Value))
+}
+
+func pduration2raw(p *Primitive) *RawData {
+	if len(p.Value) == 0 {
+		return &RawData{Type: types.Duration}
+	}
+	return DurationData(bytes2duration(p.Value))
+}
+
 func pdict2raw(p *Primitive) *RawData {
 	if p.Value == nil {
 		return &RawData{
@@ -560,14 +636,28 @@ func parray2raw(p *Primitive) *RawData {
 }
 
 func pmap2raw(p *Primitive) *RawData {
+	typ := types.Type(p.Type)
 	d, err := primitive2mapV2(p.Map)
-	return &RawData{Value: d, Error: err, Type: types.Type(p.Type)}
+	if err != nil || typ.Key() != types.Int {
+		return &RawData{Value: d, Error: err, Type: typ}
+	}
+
+	// int maps travel with string keys, see intmap2result
+	res := make(map[int]interface{}, len(d))
+	for k, v := range d {
+		i, err := strconv.Atoi(k)
+		if err != nil {
+			return &RawData{Error: errors.New("invalid key in int map: " + k), Type: typ}
+		}
+		res[i] = v
+	}
+	return &RawData{Value: res, Type: typ}
 }
 
 func presource2raw(p *Primitive) *RawData {
 	id := string(p.Value)
 	typ := types.Type(p.Type)
-	return &RawData{Value: MockResource{
+	return &RawData{Value: &MockResource{
 		Name: typ.ResourceName(),
 		ID:   id,
 	}, Type: typ}
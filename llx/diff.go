@@ -0,0 +1,306 @@
+package llx
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// DeltaKind is how a value changed between two results
+type DeltaKind byte
+
+const (
+	// DeltaAdded is a value that only exists in the new result
+	DeltaAdded DeltaKind = iota + 1
+	// DeltaRemoved is a value that only exists in the old result
+	DeltaRemoved
+	// DeltaChanged is a value that exists in both results, but differs
+	DeltaChanged
+)
+
+func (k DeltaKind) String() string {
+	switch k {
+	case DeltaAdded:
+		return "added"
+	case DeltaRemoved:
+		return "removed"
+	case DeltaChanged:
+		return "changed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText renders the kind as its name, e.g. in JSON
+func (k DeltaKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Delta is one change between two values at a path inside of them. The path
+// is empty for the values themselves, `["key"]` for the entry of a map or
+// dict, and `[2]` for the item of a list. Old is nil for added values and
+// New is nil for removed values.
+type Delta struct {
+	Kind DeltaKind
+	Path string
+	Old  *Primitive
+	New  *Primitive
+}
+
+// DiffPrimitives compares two values and returns all changes between them.
+// Maps, dicts and blocks are compared by their keys. Lists are compared as
+// sets: items that exist in both lists don't change, even if they moved.
+// Of all other items, blocks and maps with the same identity (see itemID)
+// are compared deeply, and the rest are added or removed. Labels are used
+// to name the fields of blocks, which are keyed by their checksum; they may
+// be nil.
+func DiffPrimitives(old *Primitive, new *Primitive, labels map[string]string) []*Delta {
+	res := []*Delta{}
+	diffPrimitives("", unwrapDict(old), unwrapDict(new), labels, &res)
+	return res
+}
+
+func diffPrimitives(path string, old *Primitive, new *Primitive, labels map[string]string, res *[]*Delta) {
+	switch {
+	case old.IsNil() && new.IsNil():
+		return
+	case old.IsNil():
+		*res = append(*res, &Delta{Kind: DeltaAdded, Path: path, New: new})
+		return
+	case new.IsNil():
+		*res = append(*res, &Delta{Kind: DeltaRemoved, Path: path, Old: old})
+		return
+	case proto.Equal(old, new):
+		return
+	}
+
+	oldType := types.Type(old.Type)
+	newType := types.Type(new.Type)
+	switch {
+	case isListPrimitive(oldType) && isListPrimitive(newType):
+		diffLists(path, old.Array, new.Array, labels, res)
+	case isMapPrimitive(oldType) && isMapPrimitive(newType):
+		diffMaps(path, old.Map, new.Map, oldType == types.Block, labels, res)
+	default:
+		*res = append(*res, &Delta{Kind: DeltaChanged, Path: path, Old: old, New: new})
+	}
+}
+
+func isListPrimitive(typ types.Type) bool {
+	return typ.IsArray()
+}
+
+func isMapPrimitive(typ types.Type) bool {
+	return typ.IsMap() || typ == types.Block
+}
+
+// unwrapDict turns a dict into the primitives of its values, which can be
+// compared like any other lists and maps
+func unwrapDict(p *Primitive) *Primitive {
+	if p == nil || types.Type(p.Type) != types.Dict || p.Value == nil {
+		return p
+	}
+
+	res := &Primitive{}
+	if err := proto.Unmarshal(p.Value, res); err != nil {
+		return p
+	}
+	return res
+}
+
+// blockKey is the name of a field in a block, which is its label if known
+func blockKey(key string, labels map[string]string) string {
+	if label, ok := labels[key]; ok && label != "" {
+		return label
+	}
+	return key
+}
+
+// isBlockMeta is true for the fields of blocks that aren't queried, like
+// the truthiness of the block or the resource it ran on
+func isBlockMeta(key string) bool {
+	return key == "__t" || key == "__s" || key == "_"
+}
+
+func diffMaps(path string, old map[string]*Primitive, new map[string]*Primitive, isBlock bool, labels map[string]string, res *[]*Delta) {
+	keys := make([]string, 0, len(old)+len(new))
+	for k := range old {
+		keys = append(keys, k)
+	}
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		name := k
+		if isBlock {
+			if isBlockMeta(k) {
+				continue
+			}
+			name = blockKey(k, labels)
+		}
+		diffPrimitives(path+"["+strconv.Quote(name)+"]", unwrapDict(old[k]), unwrapDict(new[k]), labels, res)
+	}
+}
+
+// primitiveKey identifies equal values in lists
+func primitiveKey(p *Primitive) string {
+	raw, _ := proto.MarshalOptions{Deterministic: true}.Marshal(unwrapDict(p))
+	return string(raw)
+}
+
+// idFields are the fields of blocks and maps that identify them, in the
+// order they are looked for
+var idFields = []string{"id", "name", "path"}
+
+// itemID identifies a block or map in a list, so that it can be compared to
+// the same item in another list, even if other fields changed. Blocks are
+// identified by the resource they ran on. Blocks without a resource and
+// maps are identified by their id, name or path field. Items without an
+// identity return false.
+func itemID(p *Primitive, labels map[string]string) (string, bool) {
+	typ := types.Type(p.Type)
+	if !isMapPrimitive(typ) {
+		return "", false
+	}
+
+	isBlock := typ == types.Block
+	if isBlock {
+		if self, ok := p.Map["_"]; ok && types.Type(self.Type).IsResource() {
+			return "\x00" + self.Type + "\x00" + string(self.Value), true
+		}
+	}
+
+	fields := make(map[string]*Primitive, len(p.Map))
+	for k, v := range p.Map {
+		if isBlock {
+			k = blockKey(k, labels)
+		}
+		fields[k] = v
+	}
+	for _, name := range idFields {
+		field := unwrapDict(fields[name])
+		if field.IsNil() {
+			continue
+		}
+		typ := types.Type(field.Type)
+		if typ.IsArray() || isMapPrimitive(typ) {
+			continue
+		}
+		return name + "\x00" + primitiveKey(field), true
+	}
+	return "", false
+}
+
+func diffLists(path string, old []*Primitive, new []*Primitive, labels map[string]string, res *[]*Delta) {
+	itemPath := func(i int) string {
+		return path + "[" + strconv.Itoa(i) + "]"
+	}
+
+	// items that exist in both lists are equal
+	oldEqual := make([]bool, len(old))
+	newEqual := make([]bool, len(new))
+	oldByKey := make(map[string][]int, len(old))
+	for i := range old {
+		key := primitiveKey(old[i])
+		oldByKey[key] = append(oldByKey[key], i)
+	}
+	for i := range new {
+		key := primitiveKey(new[i])
+		if idx := oldByKey[key]; len(idx) > 0 {
+			oldEqual[idx[0]] = true
+			newEqual[i] = true
+			oldByKey[key] = idx[1:]
+		}
+	}
+
+	// all other items with the same identity are compared deeply
+	oldByID := map[string][]int{}
+	for i := range old {
+		if oldEqual[i] {
+			continue
+		}
+		if id, ok := itemID(unwrapDict(old[i]), labels); ok {
+			oldByID[id] = append(oldByID[id], i)
+		}
+	}
+	matches := make(map[int]int, len(oldByID))
+	oldMatched := make([]bool, len(old))
+	for i := range new {
+		if newEqual[i] {
+			continue
+		}
+		id, ok := itemID(unwrapDict(new[i]), labels)
+		if !ok {
+			continue
+		}
+		if idx := oldByID[id]; len(idx) > 0 {
+			matches[i] = idx[0]
+			oldMatched[idx[0]] = true
+			oldByID[id] = idx[1:]
+		}
+	}
+
+	for i := range old {
+		if !oldEqual[i] && !oldMatched[i] {
+			*res = append(*res, &Delta{Kind: DeltaRemoved, Path: itemPath(i), Old: old[i]})
+		}
+	}
+
+	for i := range new {
+		if newEqual[i] {
+			continue
+		}
+		if oldIdx, ok := matches[i]; ok {
+			diffPrimitives(itemPath(i), unwrapDict(old[oldIdx]), unwrapDict(new[i]), labels, res)
+			continue
+		}
+		*res = append(*res, &Delta{Kind: DeltaAdded, Path: itemPath(i), New: new[i]})
+	}
+}
+
+// FormatPrimitive renders a value for people, e.g. to show how it changed.
+// Labels are used to name the fields of blocks; they may be nil.
+func FormatPrimitive(p *Primitive, labels map[string]string) string {
+	p = unwrapDict(p)
+	if p.IsNil() {
+		return "null"
+	}
+
+	typ := types.Type(p.Type)
+	switch {
+	case typ.IsResource():
+		return typ.ResourceName() + " id = " + string(p.Value)
+
+	case isListPrimitive(typ):
+		items := make([]string, len(p.Array))
+		for i := range p.Array {
+			items[i] = FormatPrimitive(p.Array[i], labels)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+
+	case isMapPrimitive(typ):
+		isBlock := typ == types.Block
+		entries := make([]string, 0, len(p.Map))
+		for k, v := range p.Map {
+			if isBlock {
+				if isBlockMeta(k) {
+					continue
+				}
+				k = blockKey(k, labels)
+			}
+			entries = append(entries, strconv.Quote(k)+": "+FormatPrimitive(v, labels))
+		}
+		sort.Strings(entries)
+		return "{" + strings.Join(entries, ", ") + "}"
+
+	default:
+		return p.RawData().String()
+	}
+}
@@ -0,0 +1,136 @@
+package llx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/types"
+)
+
+func dictPrimitive(t *testing.T, v interface{}) *Primitive {
+	res, err := dict2result(v, types.Dict)
+	require.NoError(t, err)
+	return res
+}
+
+func TestDiffPrimitives(t *testing.T) {
+	t.Run("equal values", func(t *testing.T) {
+		assert.Empty(t, DiffPrimitives(StringPrimitive("a"), StringPrimitive("a"), nil))
+		assert.Empty(t, DiffPrimitives(nil, NilPrimitive, nil))
+	})
+
+	t.Run("simple values", func(t *testing.T) {
+		res := DiffPrimitives(IntPrimitive(1), IntPrimitive(2), nil)
+		require.Len(t, res, 1)
+		assert.Equal(t, DeltaChanged, res[0].Kind)
+		assert.Equal(t, "", res[0].Path)
+		assert.Equal(t, "1", FormatPrimitive(res[0].Old, nil))
+		assert.Equal(t, "2", FormatPrimitive(res[0].New, nil))
+
+		res = DiffPrimitives(nil, StringPrimitive("a"), nil)
+		require.Len(t, res, 1)
+		assert.Equal(t, DeltaAdded, res[0].Kind)
+
+		res = DiffPrimitives(StringPrimitive("a"), NilPrimitive, nil)
+		require.Len(t, res, 1)
+		assert.Equal(t, DeltaRemoved, res[0].Kind)
+	})
+
+	t.Run("lists are compared as sets", func(t *testing.T) {
+		old := ArrayPrimitive([]*Primitive{StringPrimitive("bash"), StringPrimitive("curl"), StringPrimitive("vim")}, types.String)
+		new := ArrayPrimitive([]*Primitive{StringPrimitive("vim"), StringPrimitive("bash"), StringPrimitive("nginx")}, types.String)
+		res := DiffPrimitives(old, new, nil)
+		require.Len(t, res, 2)
+		assert.Equal(t, &Delta{Kind: DeltaRemoved, Path: "[1]", Old: old.Array[1]}, res[0])
+		assert.Equal(t, &Delta{Kind: DeltaAdded, Path: "[2]", New: new.Array[2]}, res[1])
+	})
+
+	t.Run("dicts are compared deeply", func(t *testing.T) {
+		old := dictPrimitive(t, map[string]interface{}{
+			"PermitRootLogin": "no",
+			"Port":            int64(22),
+			"Ciphers":         []interface{}{"aes256-ctr"},
+		})
+		new := dictPrimitive(t, map[string]interface{}{
+			"PermitRootLogin": "yes",
+			"Ciphers":         []interface{}{"aes256-ctr", "aes128-ctr"},
+			"X11Forwarding":   true,
+		})
+
+		res := DiffPrimitives(old, new, nil)
+		require.Len(t, res, 4)
+		assert.Equal(t, DeltaAdded, res[0].Kind)
+		assert.Equal(t, `["Ciphers"][1]`, res[0].Path)
+		assert.Equal(t, `"aes128-ctr"`, FormatPrimitive(res[0].New, nil))
+		assert.Equal(t, DeltaChanged, res[1].Kind)
+		assert.Equal(t, `["PermitRootLogin"]`, res[1].Path)
+		assert.Equal(t, DeltaRemoved, res[2].Kind)
+		assert.Equal(t, `["Port"]`, res[2].Path)
+		assert.Equal(t, DeltaAdded, res[3].Kind)
+		assert.Equal(t, `["X11Forwarding"]`, res[3].Path)
+	})
+
+	t.Run("blocks use labels", func(t *testing.T) {
+		block := func(version string) *Primitive {
+			return &Primitive{Type: string(types.Block), Map: map[string]*Primitive{
+				"abc": StringPrimitive(version),
+				"__t": BoolPrimitive(version == "1"),
+			}}
+		}
+		labels := map[string]string{"abc": "version"}
+
+		res := DiffPrimitives(block("1"), block("2"), labels)
+		require.Len(t, res, 1)
+		assert.Equal(t, `["version"]`, res[0].Path)
+		assert.Equal(t, `{"version": "1"}`, FormatPrimitive(block("1"), labels))
+	})
+
+	t.Run("blocks of the same resource are compared deeply", func(t *testing.T) {
+		block := func(name string, version string) *Primitive {
+			return &Primitive{Type: string(types.Block), Map: map[string]*Primitive{
+				"_":   {Type: string(types.Resource("package")), Value: []byte(name)},
+				"abc": StringPrimitive(name),
+				"def": StringPrimitive(version),
+				"__t": BoolPrimitive(true),
+			}}
+		}
+		labels := map[string]string{"abc": "name", "def": "version"}
+		old := ArrayPrimitive([]*Primitive{block("bash", "5.1"), block("curl", "7.8"), block("vim", "9.0")}, types.Block)
+		new := ArrayPrimitive([]*Primitive{block("curl", "7.8"), block("bash", "5.2"), block("nginx", "1.2")}, types.Block)
+
+		res := DiffPrimitives(old, new, labels)
+		require.Len(t, res, 3)
+		assert.Equal(t, &Delta{Kind: DeltaRemoved, Path: "[2]", Old: old.Array[2]}, res[0])
+		assert.Equal(t, DeltaChanged, res[1].Kind)
+		assert.Equal(t, `[1]["version"]`, res[1].Path)
+		assert.Equal(t, `"5.1"`, FormatPrimitive(res[1].Old, labels))
+		assert.Equal(t, `"5.2"`, FormatPrimitive(res[1].New, labels))
+		assert.Equal(t, &Delta{Kind: DeltaAdded, Path: "[2]", New: new.Array[2]}, res[2])
+		assert.Equal(t, `{"name": "bash", "version": "5.1"}`, FormatPrimitive(old.Array[0], labels))
+	})
+
+	t.Run("maps with the same identity are compared deeply", func(t *testing.T) {
+		old := dictPrimitive(t, []interface{}{
+			map[string]interface{}{"name": "ssh", "port": int64(22)},
+			map[string]interface{}{"name": "http", "port": int64(80)},
+			map[string]interface{}{"port": int64(443)},
+		})
+		new := dictPrimitive(t, []interface{}{
+			map[string]interface{}{"name": "http", "port": int64(80)},
+			map[string]interface{}{"name": "ssh", "port": int64(2222)},
+			map[string]interface{}{"port": int64(8443)},
+		})
+
+		res := DiffPrimitives(old, new, nil)
+		require.Len(t, res, 3)
+		assert.Equal(t, DeltaRemoved, res[0].Kind)
+		assert.Equal(t, "[2]", res[0].Path)
+		assert.Equal(t, DeltaChanged, res[1].Kind)
+		assert.Equal(t, `[1]["port"]`, res[1].Path)
+		assert.Equal(t, "22", FormatPrimitive(res[1].Old, nil))
+		assert.Equal(t, "2222", FormatPrimitive(res[1].New, nil))
+		assert.Equal(t, DeltaAdded, res[2].Kind)
+		assert.Equal(t, "[2]", res[2].Path)
+	})
+}
@@ -0,0 +1,336 @@
+package llx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mondoo.com/cnquery/types"
+)
+
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	secondsPerDay    = 24 * secondsPerHour
+	secondsPerWeek   = 7 * secondsPerDay
+	// secondsPerMonth is the average length of a month in the gregorian
+	// calendar, which is used to compare durations with months to others
+	secondsPerMonth = 2629746
+)
+
+// Duration is the runtime value of the duration type. Months and years
+// don't have a fixed length, so they are kept apart from all other units
+// and are added to times using the calendar.
+type Duration struct {
+	Months  int64
+	Seconds int64
+}
+
+// ParseDuration parses durations like 90d, 1h30m, 2 weeks, 3mo, and 1y, as
+// well as ISO 8601 durations like PT24H or P1Y2M10D. A sign applies to all
+// units that follow it, e.g. -1h30m is -90 minutes and 1mo-1d is one month
+// minus one day.
+func ParseDuration(s string) (Duration, error) {
+	s = strings.TrimSpace(s)
+	rest := strings.TrimLeft(s, "+-")
+	if len(rest) != 0 && (rest[0] == 'P' || rest[0] == 'p') {
+		res, err := parseISODuration(rest[1:])
+		if err != nil {
+			return res, errors.New("invalid duration '" + s + "': " + err.Error())
+		}
+		if strings.HasPrefix(s, "-") {
+			res = res.Neg()
+		}
+		return res, nil
+	}
+
+	res, err := parseShortDuration(s)
+	if err != nil {
+		return res, errors.New("invalid duration '" + s + "': " + err.Error())
+	}
+	return res, nil
+}
+
+// durationUnits maps units to their length in seconds. Months and years
+// are counted in months instead.
+var durationUnits = map[string]int64{
+	"s": 1, "sec": 1, "secs": 1, "second": 1, "seconds": 1,
+	"m": secondsPerMinute, "min": secondsPerMinute, "mins": secondsPerMinute, "minute": secondsPerMinute, "minutes": secondsPerMinute,
+	"h": secondsPerHour, "hr": secondsPerHour, "hrs": secondsPerHour, "hour": secondsPerHour, "hours": secondsPerHour,
+	"d": secondsPerDay, "day": secondsPerDay, "days": secondsPerDay,
+	"w": secondsPerWeek, "week": secondsPerWeek, "weeks": secondsPerWeek,
+}
+
+var monthUnits = map[string]int64{
+	"mo": 1, "month": 1, "months": 1,
+	"y": 12, "yr": 12, "yrs": 12, "year": 12, "years": 12,
+}
+
+func parseShortDuration(s string) (Duration, error) {
+	res := Duration{}
+	sign := int64(1)
+	found := false
+
+	s = strings.ToLower(s)
+
+	for {
+		s = strings.TrimLeft(s, " ")
+		if s == "" {
+			break
+		}
+		switch s[0] {
+		case '-':
+			sign = -1
+			s = s[1:]
+			continue
+		case '+':
+			sign = 1
+			s = s[1:]
+			continue
+		}
+
+		n := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if n == 0 {
+			return res, errors.New("expected a number before '" + s + "'")
+		}
+		if n == -1 {
+			return res, errors.New("missing unit after '" + s + "'")
+		}
+		num, err := strconv.ParseFloat(s[:n], 64)
+		if err != nil {
+			return res, errors.New("invalid number '" + s[:n] + "'")
+		}
+		s = strings.TrimLeft(s[n:], " ")
+
+		n = strings.IndexFunc(s, func(r rune) bool { return r < 'a' || r > 'z' })
+		if n == -1 {
+			n = len(s)
+		}
+		unit := s[:n]
+		s = s[n:]
+
+		if months, ok := monthUnits[unit]; ok {
+			if num != math.Trunc(num) {
+				return res, errors.New("months and years must be whole numbers")
+			}
+			res.Months += sign * int64(num) * months
+		} else if secs, ok := durationUnits[unit]; ok {
+			res.Seconds += sign * int64(num*float64(secs))
+		} else {
+			return res, errors.New("unknown unit '" + unit + "'")
+		}
+		found = true
+	}
+
+	if !found {
+		return res, errors.New("it is empty")
+	}
+	return res, nil
+}
+
+// parseISODuration parses ISO 8601 durations without the leading P,
+// i.e. [nY][nM][nW][nD][T[nH][nM][nS]]
+func parseISODuration(s string) (Duration, error) {
+	res := Duration{}
+	if s == "" {
+		return res, errors.New("it has no units")
+	}
+
+	s = strings.ToUpper(s)
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return res, errors.New("unexpected 'T'")
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		n := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if n <= 0 {
+			return res, errors.New("expected a number before '" + s + "'")
+		}
+		num, err := strconv.ParseFloat(strings.Replace(s[:n], ",", ".", 1), 64)
+		if err != nil {
+			return res, errors.New("invalid number '" + s[:n] + "'")
+		}
+		unit := s[n]
+		s = s[n+1:]
+
+		if !inTime && (unit == 'Y' || unit == 'M') {
+			if num != math.Trunc(num) {
+				return res, errors.New("months and years must be whole numbers")
+			}
+			if unit == 'Y' {
+				res.Months += int64(num) * 12
+			} else {
+				res.Months += int64(num)
+			}
+			continue
+		}
+
+		var secs int64
+		switch {
+		case !inTime && unit == 'W':
+			secs = secondsPerWeek
+		case !inTime && unit == 'D':
+			secs = secondsPerDay
+		case inTime && unit == 'H':
+			secs = secondsPerHour
+		case inTime && unit == 'M':
+			secs = secondsPerMinute
+		case inTime && unit == 'S':
+			secs = 1
+		default:
+			return res, errors.New("unknown unit '" + string(unit) + "'")
+		}
+		res.Seconds += int64(num * float64(secs))
+	}
+
+	return res, nil
+}
+
+// DurationFromTime converts time values that are used as durations, e.g.
+// the difference of two times, into a duration
+func DurationFromTime(t *time.Time) Duration {
+	return Duration{Seconds: TimeToDuration(t)}
+}
+
+// String renders the duration in the same format that is parsed,
+// e.g. 1y2mo3d4h5m6s
+func (d Duration) String() string {
+	if d.Months == 0 && d.Seconds == 0 {
+		return "0s"
+	}
+
+	var res strings.Builder
+	negative := false
+	write := func(value int64, unit string) {
+		if value == 0 {
+			return
+		}
+		if value < 0 && !negative {
+			res.WriteByte('-')
+			negative = true
+		} else if value > 0 && negative {
+			res.WriteByte('+')
+			negative = false
+		}
+		if value < 0 {
+			value = -value
+		}
+		res.WriteString(strconv.FormatInt(value, 10))
+		res.WriteString(unit)
+	}
+
+	write(d.Months/12, "y")
+	write(d.Months%12, "mo")
+	write(d.Seconds/secondsPerDay, "d")
+	write(d.Seconds%secondsPerDay/secondsPerHour, "h")
+	write(d.Seconds%secondsPerHour/secondsPerMinute, "m")
+	write(d.Seconds%secondsPerMinute, "s")
+	return res.String()
+}
+
+// Approx is the length of the duration in seconds, with months counted
+// as the average length of a month
+func (d Duration) Approx() int64 {
+	return d.Months*secondsPerMonth + d.Seconds
+}
+
+// Compare this duration to another one. It returns -1 if it is shorter,
+// 0 if they are equally long, and 1 if it is longer.
+func (d Duration) Compare(other Duration) int {
+	l, r := d.Approx(), other.Approx()
+	switch {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// EqualValue checks if this duration is as long as another one
+func (d Duration) EqualValue(other interface{}) bool {
+	o, ok := other.(Duration)
+	return ok && d.Compare(o) == 0
+}
+
+// Add two durations
+func (d Duration) Add(other Duration) Duration {
+	return Duration{Months: d.Months + other.Months, Seconds: d.Seconds + other.Seconds}
+}
+
+// Neg turns the duration around, e.g. 1d into -1d
+func (d Duration) Neg() Duration {
+	return Duration{Months: -d.Months, Seconds: -d.Seconds}
+}
+
+// Scale the duration by a factor. Fractions of months are converted into
+// seconds, using the average length of a month.
+func (d Duration) Scale(f float64) Duration {
+	months := float64(d.Months) * f
+	whole := math.Trunc(months)
+	return Duration{
+		Months:  int64(whole),
+		Seconds: int64(math.Round(float64(d.Seconds)*f + (months-whole)*secondsPerMonth)),
+	}
+}
+
+// AddTo adds the duration to a time. Months are added using the calendar
+// and the day is clamped to the end of the month, e.g. one month after
+// January 31st is the last day of February.
+func (d Duration) AddTo(t time.Time) time.Time {
+	if d.Months != 0 {
+		y, m, day := t.Date()
+		hour, min, sec := t.Clock()
+		first := time.Date(y, m+time.Month(d.Months), 1, hour, min, sec, t.Nanosecond(), t.Location())
+		if last := first.AddDate(0, 1, -1).Day(); day > last {
+			day = last
+		}
+		t = first.AddDate(0, 0, day-1)
+	}
+	return t.Add(time.Duration(d.Seconds) * time.Second)
+}
+
+// DurationPrimitive creates a primitive from a duration
+func DurationPrimitive(d Duration) *Primitive {
+	return &Primitive{
+		Type:  string(types.Duration),
+		Value: durationBytes(d),
+	}
+}
+
+// DurationData creates a rawdata struct from a duration
+func DurationData(d Duration) *RawData {
+	return &RawData{
+		Type:  types.Duration,
+		Value: d,
+	}
+}
+
+// durations are stored as the varints of months and seconds
+func durationBytes(d Duration) []byte {
+	return append(int2bytes(d.Months), int2bytes(d.Seconds)...)
+}
+
+func bytes2duration(b []byte) Duration {
+	r := bytes.NewReader(b)
+	months, err := binary.ReadVarint(r)
+	if err != nil {
+		panic("Failed to read bytes into duration: " + err.Error())
+	}
+	seconds, err := binary.ReadVarint(r)
+	if err != nil {
+		panic("Failed to read bytes into duration: " + err.Error())
+	}
+	return Duration{Months: months, Seconds: seconds}
+}
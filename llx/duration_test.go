@@ -0,0 +1,78 @@
+package llx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		duration string
+		res      Duration
+	}{
+		{"90d", Duration{Seconds: 90 * 24 * 60 * 60}},
+		{"1h30m", Duration{Seconds: 90 * 60}},
+		{"-1h30m", Duration{Seconds: -90 * 60}},
+		{"1.5h", Duration{Seconds: 90 * 60}},
+		{"2 weeks", Duration{Seconds: 14 * 24 * 60 * 60}},
+		{"3mo", Duration{Months: 3}},
+		{"1y2mo", Duration{Months: 14}},
+		{"1mo-1d", Duration{Months: 1, Seconds: -24 * 60 * 60}},
+		{"PT24H", Duration{Seconds: 24 * 60 * 60}},
+		{"P1Y2M10DT2H30M", Duration{Months: 14, Seconds: 10*24*60*60 + 2*60*60 + 30*60}},
+		{"P2W", Duration{Seconds: 14 * 24 * 60 * 60}},
+		{"-P1D", Duration{Seconds: -24 * 60 * 60}},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.duration, func(t *testing.T) {
+			res, err := ParseDuration(cur.duration)
+			require.NoError(t, err)
+			assert.Equal(t, cur.res, res)
+		})
+	}
+
+	_, err := ParseDuration("90")
+	assert.EqualError(t, err, "invalid duration '90': missing unit after '90'")
+	_, err = ParseDuration("1.5mo")
+	assert.EqualError(t, err, "invalid duration '1.5mo': months and years must be whole numbers")
+	_, err = ParseDuration("3 fortnights")
+	assert.EqualError(t, err, "invalid duration '3 fortnights': unknown unit 'fortnights'")
+	_, err = ParseDuration("P1H")
+	assert.EqualError(t, err, "invalid duration 'P1H': unknown unit 'H'")
+}
+
+func TestDuration_String(t *testing.T) {
+	for _, s := range []string{"0s", "90d", "1y2mo10d2h30m", "-1h30m", "1mo-1d", "-1mo+1d"} {
+		d, err := ParseDuration(s)
+		require.NoError(t, err)
+		assert.Equal(t, s, d.String())
+	}
+}
+
+func TestDuration_AddTo(t *testing.T) {
+	jan31 := time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, time.February, 29, 10, 0, 0, 0, time.UTC), Duration{Months: 1}.AddTo(jan31))
+	assert.Equal(t, time.Date(2023, time.December, 31, 10, 0, 0, 0, time.UTC), Duration{Months: -1}.AddTo(jan31))
+	assert.Equal(t, time.Date(2025, time.January, 31, 10, 0, 0, 0, time.UTC), Duration{Months: 12}.AddTo(jan31))
+	assert.Equal(t, time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC), Duration{Months: 1, Seconds: 24 * 60 * 60}.AddTo(jan31))
+}
+
+func TestDuration_Compare(t *testing.T) {
+	assert.Equal(t, 1, Duration{Seconds: 90 * 24 * 60 * 60}.Compare(Duration{Months: 2}))
+	assert.Equal(t, -1, Duration{Seconds: 90 * 24 * 60 * 60}.Compare(Duration{Months: 12}))
+	assert.Equal(t, 0, Duration{Months: 12}.Compare(Duration{Months: 12}))
+	assert.Equal(t, Duration{Months: 1, Seconds: 5 * 24 * 60 * 60}, Duration{Months: 2, Seconds: 10 * 24 * 60 * 60}.Scale(0.5))
+	assert.Equal(t, Duration{Months: 1, Seconds: secondsPerMonth / 2}, Duration{Months: 3}.Scale(0.5))
+}
+
+func TestDurationPrimitive(t *testing.T) {
+	d := Duration{Months: 1, Seconds: -24 * 60 * 60}
+	p := DurationPrimitive(d)
+	assert.Equal(t, "1mo-1d", p.LabelV2(nil))
+	assert.Equal(t, DurationData(d), p.RawData())
+}
@@ -0,0 +1,161 @@
+package llx
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"go.mondoo.com/cnquery/types"
+)
+
+// IPPrimitive creates a primitive from an IP address
+func IPPrimitive(ip string) *Primitive {
+	return &Primitive{
+		Type:  string(types.IP),
+		Value: []byte(ip),
+	}
+}
+
+// CIDRPrimitive creates a primitive from a network in CIDR notation
+func CIDRPrimitive(cidr string) *Primitive {
+	return &Primitive{
+		Type:  string(types.CIDR),
+		Value: []byte(cidr),
+	}
+}
+
+// IPData creates a rawdata struct from an IP address
+func IPData(ip string) *RawData {
+	return &RawData{
+		Type:  types.IP,
+		Value: ip,
+	}
+}
+
+// CIDRData creates a rawdata struct from a network in CIDR notation
+func CIDRData(cidr string) *RawData {
+	return &RawData{
+		Type:  types.CIDR,
+		Value: cidr,
+	}
+}
+
+// ParseIP parses an IP address. Addresses may be written in brackets,
+// which is common for IPv6, e.g. [::1].
+func ParseIP(s string) (netip.Addr, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		s = s[1 : len(s)-1]
+	}
+	res, err := netip.ParseAddr(s)
+	if err != nil {
+		return res, errors.New("invalid IP address '" + s + "'")
+	}
+	return res, nil
+}
+
+// ParseCIDR parses a network in CIDR notation, e.g. 10.0.0.0/8. A single
+// address is a network that only contains this address.
+func ParseCIDR(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		ip, err := ParseIP(s)
+		if err != nil {
+			return netip.Prefix{}, errors.New("invalid CIDR '" + s + "'")
+		}
+		ip = ip.Unmap()
+		return netip.PrefixFrom(ip, ip.BitLen()), nil
+	}
+
+	res, err := netip.ParsePrefix(s)
+	if err != nil {
+		return res, errors.New("invalid CIDR '" + s + "'")
+	}
+	// IPv4 networks that are mapped to IPv6 are treated like IPv4
+	if res.Addr().Is4In6() && res.Bits() >= 96 {
+		res = netip.PrefixFrom(res.Addr().Unmap(), res.Bits()-96)
+	}
+	return res.Masked(), nil
+}
+
+var (
+	privateNetworks = []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+		netip.MustParsePrefix("fc00::/7"),
+	}
+	loopbackNetworks = []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	}
+)
+
+// cidrContains checks if a network contains another network or address
+func cidrContains(network netip.Prefix, other netip.Prefix) bool {
+	return network.Bits() <= other.Bits() && network.Contains(other.Addr())
+}
+
+// cidrWithin checks if a network is entirely inside any of the given ones
+func cidrWithin(network netip.Prefix, networks []netip.Prefix) bool {
+	for i := range networks {
+		if cidrContains(networks[i], network) {
+			return true
+		}
+	}
+	return false
+}
+
+// ipVersion is 4 or 6, with IPv4 addresses that are mapped to IPv6
+// counting as IPv4
+func ipVersion(ip netip.Addr) int64 {
+	if ip.Unmap().Is4() {
+		return 4
+	}
+	return 6
+}
+
+// compareIPs of the left and right side of an operation, which are both
+// stored as strings
+func compareIPs(left interface{}, right interface{}) (int, error) {
+	l, err := ParseIP(left.(string))
+	if err != nil {
+		return 0, err
+	}
+	r, err := ParseIP(right.(string))
+	if err != nil {
+		return 0, err
+	}
+	return l.Unmap().Compare(r.Unmap()), nil
+}
+
+// ipEqual compares two IP addresses, e.g. ::1 and 0:0:0:0:0:0:0:1 are equal.
+// Values that aren't IP addresses are compared as strings.
+func ipEqual(left interface{}, right interface{}) bool {
+	cmp, err := compareIPs(left, right)
+	if err != nil {
+		return left.(string) == right.(string)
+	}
+	return cmp == 0
+}
+
+// cidrEqual compares two networks, e.g. 10.0.0.1/8 and 10.0.0.0/8 are equal.
+// Values that aren't networks are compared as strings.
+func cidrEqual(left interface{}, right interface{}) bool {
+	l, lerr := ParseCIDR(left.(string))
+	r, rerr := ParseCIDR(right.(string))
+	if lerr != nil || rerr != nil {
+		return left.(string) == right.(string)
+	}
+	return l == r
+}
+
+// cidrOf an IP address or network, which are both stored as strings
+func cidrOf(value interface{}) (netip.Prefix, error) {
+	s, ok := value.(string)
+	if !ok {
+		return netip.Prefix{}, fmt.Errorf("expected an IP address or CIDR, got %T", value)
+	}
+	return ParseCIDR(s)
+}
@@ -0,0 +1,51 @@
+package llx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIP(t *testing.T) {
+	ip, err := ParseIP("[::1]")
+	require.NoError(t, err)
+	assert.Equal(t, "::1", ip.String())
+
+	_, err = ParseIP("*")
+	assert.EqualError(t, err, "invalid IP address '*'")
+}
+
+func TestParseCIDR(t *testing.T) {
+	tests := []struct {
+		cidr string
+		res  string
+	}{
+		{"10.0.0.0/8", "10.0.0.0/8"},
+		{"10.1.2.3/8", "10.0.0.0/8"},
+		{"10.1.2.3", "10.1.2.3/32"},
+		{"::1", "::1/128"},
+		{"::ffff:10.0.0.0/104", "10.0.0.0/8"},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.cidr, func(t *testing.T) {
+			res, err := ParseCIDR(cur.cidr)
+			require.NoError(t, err)
+			assert.Equal(t, cur.res, res.String())
+		})
+	}
+
+	_, err := ParseCIDR("10.0.0.0/33")
+	assert.Error(t, err)
+}
+
+func TestIPPrimitive(t *testing.T) {
+	p := IPPrimitive("10.0.0.1")
+	assert.Equal(t, "10.0.0.1", p.LabelV2(nil))
+	assert.Equal(t, IPData("10.0.0.1"), p.RawData())
+
+	p = CIDRPrimitive("10.0.0.0/8")
+	assert.Equal(t, CIDRData("10.0.0.0/8"), p.RawData())
+}
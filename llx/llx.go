@@ -111,6 +111,8 @@ type blockExecutor struct {
 	parent         *blockExecutor
 	ctx            *MQLExecutorV2
 	watcherIds     *types.StringSet
+	// spans of fields that are resolved by a scheduler, by their ref
+	spans sync.Map
 }
 
 // MQLExecutorV2 is the runtime of MQL codestructure
@@ -302,6 +304,45 @@ func (e *blockExecutor) unregister() []error {
 	return errs
 }
 
+// dependencySpan finds the span of the last field that the chunk at ref
+// depends on via its binding or arguments, if fields are resolved by a
+// tracing scheduler
+func (b *blockExecutor) dependencySpan(ref uint64) *Span {
+	var res *Span
+	visited := map[uint64]struct{}{}
+	queue := []uint64{ref}
+	for len(queue) != 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur == 0 || !b.isInMyBlock(cur) {
+			continue
+		}
+		if _, ok := visited[cur]; ok {
+			continue
+		}
+		visited[cur] = struct{}{}
+
+		if span, ok := b.spans.Load(cur); ok && cur != ref {
+			if x := span.(*Span); res == nil || x.Finished.After(res.Finished) {
+				res = x
+			}
+			continue
+		}
+
+		chunk := b.ctx.code.Chunk(cur)
+		if chunk == nil || chunk.Function == nil {
+			continue
+		}
+		queue = append(queue, chunk.Function.Binding)
+		for _, arg := range chunk.Function.Args {
+			if argRef, ok := arg.RefV2(); ok {
+				queue = append(queue, argRef)
+			}
+		}
+	}
+	return res
+}
+
 func (b *blockExecutor) isInMyBlock(ref uint64) bool {
 	return (ref >> 32) == (b.blockRef >> 32)
 }
@@ -561,11 +602,23 @@ func (c *blockExecutor) runFunctionBlocks(argList [][]*RawData, blockRef uint64,
 }
 
 func (b *blockExecutor) runFunctionBlock(args []*RawData, blockRef uint64, cb ResultCallback) error {
-	executor, err := b.newBlockExecutor(blockRef, reportSync(cb))
+	executor, err := b.newFunctionBlock(args, blockRef, cb)
 	if err != nil {
 		return err
 	}
 
+	executor.run()
+	return nil
+}
+
+// newFunctionBlock creates and registers the executor of a function block
+// with its arguments, without running it
+func (b *blockExecutor) newFunctionBlock(args []*RawData, blockRef uint64, cb ResultCallback) (*blockExecutor, error) {
+	executor, err := b.newBlockExecutor(blockRef, reportSync(cb))
+	if err != nil {
+		return nil, err
+	}
+
 	b.ctx.addBlockExecutor(executor)
 
 	if len(args) < int(executor.block.Parameters) {
@@ -579,8 +632,7 @@ func (b *blockExecutor) runFunctionBlock(args []*RawData, blockRef uint64, cb Re
 		})
 	}
 
-	executor.run()
-	return nil
+	return executor, nil
 }
 
 func (b *blockExecutor) runBlock(bind *RawData, functionRef *Primitive, args []*Primitive, ref uint64) (*RawData, uint64, error) {
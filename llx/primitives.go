@@ -461,6 +461,21 @@ func (p *Primitive) LabelV1(code *CodeV1) string {
 		return fmt.Sprintf("/%s/", string(p.Value))
 	case types.Time:
 		return "<...>"
+	case types.IP, types.CIDR:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return string(p.Value)
+	case types.Version:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return "version(" + PrettyPrintString(bytes2version(p.Value).Value) + ")"
+	case types.Duration:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return bytes2duration(p.Value).String()
 	case types.Dict:
 		return "<...>"
 	case types.Score:
@@ -538,6 +553,21 @@ func (p *Primitive) LabelV2(code *CodeV2) string {
 		return fmt.Sprintf("/%s/", string(p.Value))
 	case types.Time:
 		return "<...>"
+	case types.IP, types.CIDR:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return string(p.Value)
+	case types.Version:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return "version(" + PrettyPrintString(bytes2version(p.Value).Value) + ")"
+	case types.Duration:
+		if len(p.Value) == 0 {
+			return "null"
+		}
+		return bytes2duration(p.Value).String()
 	case types.Dict:
 		return "<...>"
 	case types.Score:
@@ -82,8 +82,14 @@ func rawDataString(typ types.Type, value interface{}) string {
 		return "\"" + value.(string) + "\""
 	case types.Regex:
 		return "/" + value.(string) + "/"
+	case types.IP, types.CIDR:
+		return value.(string)
 	case types.Time:
 		return value.(*time.Time).String()
+	case types.Version:
+		return value.(Version).String()
+	case types.Duration:
+		return value.(Duration).String()
 	case types.Dict:
 		return dictRawDataString(value)
 	case types.Score:
@@ -196,6 +202,15 @@ func isTruthy(data interface{}, typ types.Type) (bool, bool) {
 	case types.Regex:
 		return data.(string) != "", true
 
+	case types.IP, types.CIDR:
+		return data.(string) != "", true
+
+	case types.Version:
+		return data.(Version).Value != "", true
+
+	case types.Duration:
+		return data.(Duration) != Duration{}, true
+
 	case types.Time:
 		dt := data.(*time.Time)
 
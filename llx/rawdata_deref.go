@@ -94,6 +94,22 @@ func dereferenceStringMap(typ types.Type, data map[string]interface{}, codeID st
 	return MapData(res, childType), nil
 }
 
+func dereferenceIntMap(typ types.Type, data map[int]interface{}, codeID string, bundle *CodeBundle) (*RawData, error) {
+	res := make(map[int]interface{}, len(data))
+	childType := typ.Child()
+
+	for key := range data {
+		entry := &RawData{Value: data[key], Type: childType}
+		v, err := dereference(entry, codeID, bundle)
+		if err != nil {
+			return nil, err
+		}
+		res[key] = v.Value
+	}
+
+	return &RawData{Value: res, Type: typ}, nil
+}
+
 func dereference(raw *RawData, codeID string, bundle *CodeBundle) (*RawData, error) {
 	if raw.Type.IsEmpty() || raw.Value == nil {
 		return raw, nil
@@ -119,9 +135,9 @@ func dereference(raw *RawData, codeID string, bundle *CodeBundle) (*RawData, err
 		if typ.Key() == types.String {
 			return dereferenceStringMap(typ, data.(map[string]interface{}), codeID, bundle)
 		}
-		// if typ.Key() == types.Int {
-		// 	return dereferenceIntMap(typ, data.(map[int]interface{}), codeID, bundle)
-		// }
+		if typ.Key() == types.Int {
+			return dereferenceIntMap(typ, data.(map[int]interface{}), codeID, bundle)
+		}
 		return nil, errors.New("unable to dereference map, its type is not supported: " + typ.Label() + ", raw: " + fmt.Sprintf("%#v", data))
 
 	default:
@@ -349,6 +349,18 @@ func rawDataJSON(typ types.Type, data interface{}, codeID string, bundle *CodeBu
 		buf.Write(b)
 		return err
 
+	case types.Version:
+		buf.WriteString(string2json(data.(Version).Value))
+		return nil
+
+	case types.Duration:
+		buf.WriteString(string2json(data.(Duration).String()))
+		return nil
+
+	case types.IP, types.CIDR:
+		buf.WriteString(string2json(data.(string)))
+		return nil
+
 	case types.Dict:
 		return rawDictJSON(typ, data, buf)
 
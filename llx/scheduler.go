@@ -0,0 +1,519 @@
+package llx
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/resources"
+)
+
+// DefaultSchedulerWorkers is the number of fields a scheduler resolves at
+// the same time, if no other number is configured
+const DefaultSchedulerWorkers = 8
+
+// SchedulerOptions configure how a scheduler resolves fields
+type SchedulerOptions struct {
+	// Workers is the max number of fields that are resolved at the same time.
+	// Defaults to DefaultSchedulerWorkers.
+	Workers int
+	// Connection is the type of connection the runtime uses, e.g. "ssh" or
+	// "winrm". It selects the resources' concurrency limits.
+	Connection string
+	// Trace records a span for every resource that is created and every
+	// field that is resolved
+	Trace bool
+	// FieldTimeout is the max time to wait for a field's value. Fields that
+	// take longer receive a TimeoutError right away, so they don't hold up
+	// the query. Their worker is only free once the runtime returns.
+	// No timeout if it is 0.
+	FieldTimeout time.Duration
+	// ListWindow is the max number of list items whose blocks run at the
+	// same time. Defaults to DefaultListWindow.
+	ListWindow int
+}
+
+// Span is the resolution of one field of a resource, or the creation of a
+// resource if its field is empty
+type Span struct {
+	Resource string
+	ID       string
+	Field    string
+	// Parent is the span of the field that this one depends on, if any
+	Parent *Span
+	// Queued is when the field was requested, Started when the scheduler
+	// started to resolve it, and Finished when its value was received
+	Queued   time.Time
+	Started  time.Time
+	Finished time.Time
+	// RoundTrips is the number of calls the runtime made to its provider
+	RoundTrips int
+	// Cache is how the runtime's cache was used for this span
+	Cache CacheStatus
+}
+
+// CacheStatus is how a span used the runtime's cache
+type CacheStatus byte
+
+const (
+	// CacheUnused means the span didn't use a cache
+	CacheUnused CacheStatus = iota
+	// CacheHit means the value was found in the cache
+	CacheHit
+	// CacheMiss means the value was cacheable, but not found in the cache
+	CacheMiss
+)
+
+func (c CacheStatus) String() string {
+	switch c {
+	case CacheHit:
+		return "hit"
+	case CacheMiss:
+		return "miss"
+	default:
+		return ""
+	}
+}
+
+// AddRoundTrip records a call to the provider. Does nothing if the span is
+// nil, so runtimes can call it whether tracing is on or not.
+func (s *Span) AddRoundTrip() {
+	if s != nil {
+		s.RoundTrips++
+	}
+}
+
+// SetCache records how the cache was used. Does nothing if the span is nil.
+func (s *Span) SetCache(status CacheStatus) {
+	if s != nil {
+		s.Cache = status
+	}
+}
+
+// IsResource is true if the span created a resource
+func (s *Span) IsResource() bool {
+	return s.Field == ""
+}
+
+// Wait is the time a field spent waiting for a worker
+func (s *Span) Wait() time.Duration {
+	return s.Started.Sub(s.Queued)
+}
+
+// Duration is the time it took to resolve a field
+func (s *Span) Duration() time.Duration {
+	return s.Finished.Sub(s.Started)
+}
+
+// TracedRuntime is a runtime that reports its provider round trips and cache
+// use into the span of the resource or field. The span is nil if tracing is
+// off, and is only written to until the call returns.
+type TracedRuntime interface {
+	Runtime
+	CreateResourceTraced(span *Span, name string, args map[string]*Primitive) (Resource, error)
+	WatchAndUpdateTraced(span *Span, resource Resource, field string, watcherUID string, callback func(res interface{}, err error)) error
+}
+
+// Scheduler is a runtime that resolves resource fields concurrently. All
+// fields are resolved by a limited number of workers. Resources can limit
+// how many of their fields are resolved at the same time via their
+// concurrency in the schema, e.g. to run only one command on WinRM
+// connections at a time. Serial resources share their state across fields,
+// so the fields of one of their instances are resolved one after the other.
+type Scheduler struct {
+	Runtime
+	opts    SchedulerOptions
+	workers chan struct{}
+
+	lock      sync.Mutex
+	resources map[string]*schedulerResource
+	instances map[string]*sync.Mutex
+	watchers  map[string][]*schedulerTask
+	spans     []*Span
+}
+
+type schedulerTask struct {
+	cancelled bool
+}
+
+// schedulerResource is how the fields of a resource can be resolved
+type schedulerResource struct {
+	// limit is the semaphore that limits concurrent calls, nil if unlimited
+	limit  chan struct{}
+	serial bool
+}
+
+// NewScheduler creates a scheduler for all fields resolved by the runtime
+func NewScheduler(runtime Runtime, opts SchedulerOptions) *Scheduler {
+	if opts.Workers < 1 {
+		opts.Workers = DefaultSchedulerWorkers
+	}
+
+	return &Scheduler{
+		Runtime:   runtime,
+		opts:      opts,
+		workers:   make(chan struct{}, opts.Workers),
+		resources: map[string]*schedulerResource{},
+		instances: map[string]*sync.Mutex{},
+		watchers:  map[string][]*schedulerTask{},
+	}
+}
+
+// Workers is the max number of fields that are resolved at the same time
+func (s *Scheduler) Workers() int {
+	return s.opts.Workers
+}
+
+// WatchAndUpdate resolves the field in the background and calls the callback
+// once its value is received
+func (s *Scheduler) WatchAndUpdate(resource Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	s.WatchAndUpdateAfter(nil, resource, field, watcherUID, callback)
+	return nil
+}
+
+// WatchAndUpdateAfter works like WatchAndUpdate, where the field depends on
+// the value of the parent span's field. It returns the span of this field,
+// which is nil if tracing is off.
+func (s *Scheduler) WatchAndUpdateAfter(parent *Span, resource Resource, field string, watcherUID string, callback func(res interface{}, err error)) *Span {
+	name := resource.MqlName()
+	id := resource.MqlID()
+
+	var span *Span
+	if s.opts.Trace {
+		span = &Span{
+			Resource: name,
+			ID:       id,
+			Field:    field,
+			Parent:   parent,
+			Queued:   time.Now(),
+		}
+	}
+
+	task := &schedulerTask{}
+	s.lock.Lock()
+	s.watchers[watcherUID] = append(s.watchers[watcherUID], task)
+	info := s.resource(name)
+	limit := info.limit
+	var instance *sync.Mutex
+	if info.serial {
+		instance = s.instance(name + "\x00" + id)
+	}
+	s.lock.Unlock()
+
+	go func() {
+		if instance != nil {
+			instance.Lock()
+		}
+		if limit != nil {
+			limit <- struct{}{}
+		}
+		s.workers <- struct{}{}
+
+		state := &fieldState{resolving: true}
+		release := func() {
+			<-s.workers
+			if limit != nil {
+				<-limit
+			}
+			if instance != nil {
+				instance.Unlock()
+			}
+		}
+
+		if s.isCancelled(task) {
+			release()
+			return
+		}
+
+		if span != nil {
+			span.Started = time.Now()
+		}
+
+		if s.opts.FieldTimeout > 0 {
+			timer := time.AfterFunc(s.opts.FieldTimeout, func() {
+				if !state.timeout() || s.isCancelled(task) {
+					return
+				}
+				// the field's result is abandoned, but its worker, its resource's
+				// limit and lock stay taken until the runtime call returns, so
+				// that a hanging runtime can't pile up more calls
+				callback(nil, TimeoutError{Field: name + "." + field, After: s.opts.FieldTimeout})
+			})
+			defer timer.Stop()
+		}
+
+		// callbacks that are called while the field is resolved are deferred,
+		// so that the workers are free before we continue with its results
+		receive := func(res interface{}, err error) {
+			if f := state.receive(func() { callback(res, err) }); f != nil {
+				f()
+			}
+		}
+		var err error
+		if traced, ok := s.Runtime.(TracedRuntime); ok && span != nil {
+			err = traced.WatchAndUpdateTraced(span, resource, field, watcherUID, receive)
+		} else {
+			err = s.Runtime.WatchAndUpdate(resource, field, watcherUID, receive)
+		}
+		release()
+
+		if _, ok := err.(resources.NotReadyError); ok {
+			err = nil
+		}
+		deferred, timedOut := state.resolved()
+
+		if span != nil {
+			span.Finished = time.Now()
+		}
+		if s.done(watcherUID, task, span) || timedOut {
+			return
+		}
+
+		if err != nil {
+			callback(nil, err)
+		}
+		for i := range deferred {
+			deferred[i]()
+		}
+	}()
+
+	return span
+}
+
+// CreateResource creates a resource with the runtime and records its span,
+// if tracing is on
+func (s *Scheduler) CreateResource(name string, args map[string]*Primitive) (Resource, error) {
+	if !s.opts.Trace {
+		return s.Runtime.CreateResource(name, args)
+	}
+
+	now := time.Now()
+	span := &Span{Resource: name, Queued: now, Started: now}
+	var res Resource
+	var err error
+	if traced, ok := s.Runtime.(TracedRuntime); ok {
+		res, err = traced.CreateResourceTraced(span, name, args)
+	} else {
+		res, err = s.Runtime.CreateResource(name, args)
+	}
+	s.addResourceSpan(span, res)
+	return res, err
+}
+
+// CreateResourceWithID creates a resource with the given ID and records its
+// span, if tracing is on
+func (s *Scheduler) CreateResourceWithID(name string, id string, args map[string]*Primitive) (Resource, error) {
+	if !s.opts.Trace {
+		return s.Runtime.CreateResourceWithID(name, id, args)
+	}
+
+	now := time.Now()
+	span := &Span{Resource: name, ID: id, Queued: now, Started: now}
+	res, err := s.Runtime.CreateResourceWithID(name, id, args)
+	s.addResourceSpan(span, res)
+	return res, err
+}
+
+func (s *Scheduler) addResourceSpan(span *Span, res Resource) {
+	span.Finished = time.Now()
+	if res != nil {
+		span.ID = res.MqlID()
+	}
+
+	s.lock.Lock()
+	s.spans = append(s.spans, span)
+	s.lock.Unlock()
+}
+
+// fieldState tracks if a field's value arrived before it timed out
+type fieldState struct {
+	lock      sync.Mutex
+	resolving bool
+	finished  bool
+	timedOut  bool
+	deferred  []func()
+}
+
+// receive a callback from the runtime. It returns the callback if it can be
+// called right away, or nil if it was deferred or the field timed out.
+func (f *fieldState) receive(callback func()) func() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.timedOut {
+		return nil
+	}
+	f.finished = true
+	if f.resolving {
+		f.deferred = append(f.deferred, callback)
+		return nil
+	}
+	return callback
+}
+
+// resolved is called once the runtime returns, after which the field can't
+// time out anymore. It returns the deferred callbacks and if the field timed
+// out in the meantime.
+func (f *fieldState) resolved() ([]func(), bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.resolving = false
+	if !f.timedOut {
+		f.finished = true
+	}
+	return f.deferred, f.timedOut
+}
+
+// timeout marks the field as timed out, unless it finished already
+func (f *fieldState) timeout() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.finished {
+		return false
+	}
+	f.timedOut = true
+	return true
+}
+
+// resource returns how the fields of a resource are resolved, which is
+// configured in its schema. Callers must hold the lock.
+func (s *Scheduler) resource(name string) *schedulerResource {
+	if res, ok := s.resources[name]; ok {
+		return res
+	}
+
+	res := &schedulerResource{}
+	if info := s.Runtime.Schema().Lookup(name); info != nil {
+		n, ok := info.Concurrency[s.opts.Connection]
+		if !ok {
+			n = info.Concurrency[""]
+		}
+		if n > 0 {
+			res.limit = make(chan struct{}, n)
+		}
+		res.serial = info.Serial
+	}
+
+	s.resources[name] = res
+	return res
+}
+
+// instance returns the lock of a serial resource's instance. Callers must
+// hold the lock.
+func (s *Scheduler) instance(key string) *sync.Mutex {
+	res, ok := s.instances[key]
+	if !ok {
+		res = &sync.Mutex{}
+		s.instances[key] = res
+	}
+	return res
+}
+
+func (s *Scheduler) isCancelled(task *schedulerTask) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return task.cancelled
+}
+
+// done removes a task from its watcher and records its span. Any updates
+// after this are handled by the underlying runtime. Returns true if the
+// task was cancelled in the meantime.
+func (s *Scheduler) done(watcherUID string, task *schedulerTask, span *Span) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if span != nil {
+		s.spans = append(s.spans, span)
+	}
+
+	tasks := s.watchers[watcherUID]
+	for i := range tasks {
+		if tasks[i] == task {
+			tasks = append(tasks[:i], tasks[i+1:]...)
+			break
+		}
+	}
+	if len(tasks) == 0 {
+		delete(s.watchers, watcherUID)
+	} else {
+		s.watchers[watcherUID] = tasks
+	}
+
+	return task.cancelled
+}
+
+// Unregister a watcher, which cancels all of its fields that are not
+// resolved yet
+func (s *Scheduler) Unregister(watcherUID string) error {
+	s.lock.Lock()
+	for _, task := range s.watchers[watcherUID] {
+		task.cancelled = true
+	}
+	delete(s.watchers, watcherUID)
+	s.lock.Unlock()
+
+	return s.Runtime.Unregister(watcherUID)
+}
+
+// Spans of all resources that were created and fields that were resolved,
+// sorted by when they started. Only available if tracing is on.
+func (s *Scheduler) Spans() []*Span {
+	s.lock.Lock()
+	res := make([]*Span, len(s.spans))
+	copy(res, s.spans)
+	s.lock.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Started.Before(res[j].Started)
+	})
+	return res
+}
+
+// CriticalPath is the chain of dependent fields that finished last. It
+// starts with the first field that had to be resolved and ends with the
+// last one. Only available if tracing is on.
+func (s *Scheduler) CriticalPath() []*Span {
+	s.lock.Lock()
+	var last *Span
+	for _, span := range s.spans {
+		if span.IsResource() {
+			continue
+		}
+		if last == nil || span.Finished.After(last.Finished) {
+			last = span
+		}
+	}
+	s.lock.Unlock()
+
+	var res []*Span
+	for cur := last; cur != nil; cur = cur.Parent {
+		res = append(res, cur)
+	}
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return res
+}
+
+// Close the scheduler and its runtime. If tracing is on, the critical path
+// is logged.
+func (s *Scheduler) Close() {
+	if s.opts.Trace {
+		path := s.CriticalPath()
+		for i := range path {
+			span := path[i]
+			log.Debug().
+				Str("resource", span.Resource).
+				Str("id", span.ID).
+				Str("field", span.Field).
+				Dur("wait", span.Wait()).
+				Dur("duration", span.Duration()).
+				Msg("scheduler> critical path")
+		}
+	}
+
+	s.Runtime.Close()
+}
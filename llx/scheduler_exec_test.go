@@ -0,0 +1,113 @@
+package llx_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// fileRuntime has file resources, whose size takes longer to compute the
+// longer their path is
+type fileRuntime struct {
+	schema *resources.Schema
+}
+
+func newFileRuntime() *fileRuntime {
+	return &fileRuntime{
+		schema: &resources.Schema{Resources: map[string]*resources.ResourceInfo{
+			"file": {
+				Id:   "file",
+				Name: "file",
+				Init: &resources.Init{Args: []*resources.TypedArg{{Name: "path", Type: string(types.String)}}},
+				Fields: map[string]*resources.Field{
+					"path": {Name: "path", Type: string(types.String), IsMandatory: true},
+					"size": {Name: "size", Type: string(types.Int)},
+				},
+			},
+		}},
+	}
+}
+
+func (r *fileRuntime) Unregister(watcherUID string) error { return nil }
+
+func (r *fileRuntime) CreateResource(name string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return &llx.MockResource{Name: name, ID: string(args["path"].Value)}, nil
+}
+
+func (r *fileRuntime) CreateResourceWithID(name string, id string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return &llx.MockResource{Name: name, ID: id}, nil
+}
+
+func (r *fileRuntime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	switch field {
+	case "path":
+		callback(resource.MqlID(), nil)
+	case "size":
+		time.Sleep(time.Duration(len(resource.MqlID())) * 20 * time.Millisecond)
+		callback(int64(len(resource.MqlID())), nil)
+	}
+	return nil
+}
+
+func (r *fileRuntime) Schema() llx.Schema { return r.schema }
+
+func (r *fileRuntime) Close() {}
+
+func TestSchedulerExecutor(t *testing.T) {
+	scheduler := llx.NewScheduler(newFileRuntime(), llx.SchedulerOptions{Workers: 4, Trace: true})
+
+	bundle, err := mqlc.Compile(
+		"file('a').size\nfile('bb').size\nfile('ccc').size\nfile(file('dddd').path).size",
+		nil, mqlc.NewConfig(scheduler.Schema(), cnquery.DefaultFeatures))
+	require.NoError(t, err)
+
+	lock := sync.Mutex{}
+	done := make(chan struct{})
+	results := map[string]*llx.RawData{}
+	entrypoints := bundle.CodeV2.Entrypoints()
+	executor, err := llx.NewExecutorV2(bundle.CodeV2, scheduler, nil, func(res *llx.RawResult) {
+		lock.Lock()
+		defer lock.Unlock()
+		if _, ok := results[res.CodeID]; ok {
+			return
+		}
+		results[res.CodeID] = res.Data
+		if len(results) == len(entrypoints) {
+			close(done)
+		}
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	require.NoError(t, executor.Run())
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for results")
+	}
+
+	// sizes are resolved at the same time, which is faster than the 200ms
+	// it takes to resolve them one after the other
+	assert.Less(t, time.Since(start), 150*time.Millisecond)
+	for i, size := range []int64{1, 2, 3, 4} {
+		res := results[bundle.CodeV2.Checksums[entrypoints[i]]]
+		require.NotNil(t, res)
+		assert.Equal(t, size, res.Value)
+	}
+
+	// the last file's size takes longest and depends on another file's path
+	path := scheduler.CriticalPath()
+	require.Len(t, path, 2)
+	assert.Equal(t, "path", path[0].Field)
+	assert.Equal(t, "dddd", path[0].ID)
+	assert.Equal(t, "size", path[1].Field)
+	assert.Equal(t, "dddd", path[1].ID)
+}
@@ -0,0 +1,286 @@
+package llx
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/resources"
+)
+
+type slowRuntime struct {
+	Runtime
+	schema *resources.Schema
+	delay  time.Duration
+
+	lock        sync.Mutex
+	running     map[string]int
+	maxRunning  map[string]int
+	unregisters []string
+}
+
+func newSlowRuntime(delay time.Duration) *slowRuntime {
+	return &slowRuntime{
+		schema: &resources.Schema{Resources: map[string]*resources.ResourceInfo{
+			"file": {Id: "file", Fields: map[string]*resources.Field{
+				"content": {Name: "content", Type: "\x07"},
+				"size":    {Name: "size", Type: "\x05"},
+			}, Serial: true},
+			"command": {Id: "command", Concurrency: map[string]int32{"winrm": 1}},
+		}},
+		delay:      delay,
+		running:    map[string]int{},
+		maxRunning: map[string]int{},
+	}
+}
+
+func (r *slowRuntime) enter(keys ...string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, key := range keys {
+		r.running[key]++
+		if r.running[key] > r.maxRunning[key] {
+			r.maxRunning[key] = r.running[key]
+		}
+	}
+}
+
+func (r *slowRuntime) leave(keys ...string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, key := range keys {
+		r.running[key]--
+	}
+}
+
+func (r *slowRuntime) max(key string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.maxRunning[key]
+}
+
+func (r *slowRuntime) WatchAndUpdate(resource Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	keys := []string{"", resource.MqlName(), resource.MqlName() + "\x00" + resource.MqlID()}
+	r.enter(keys...)
+	time.Sleep(r.delay)
+	r.leave(keys...)
+
+	if field == "missing" {
+		return errors.New("cannot find field")
+	}
+	callback(resource.MqlID()+"."+field, nil)
+	return nil
+}
+
+func (r *slowRuntime) Unregister(watcherUID string) error {
+	r.lock.Lock()
+	r.unregisters = append(r.unregisters, watcherUID)
+	r.lock.Unlock()
+	return nil
+}
+
+func (r *slowRuntime) Schema() Schema {
+	return r.schema
+}
+
+func (r *slowRuntime) Close() {}
+
+// tracedRuntime counts one round trip per call and reports cache hits for
+// the size of files
+type tracedRuntime struct {
+	*slowRuntime
+}
+
+func (r tracedRuntime) CreateResourceTraced(span *Span, name string, args map[string]*Primitive) (Resource, error) {
+	span.AddRoundTrip()
+	return &MockResource{Name: name, ID: string(args["path"].Value)}, nil
+}
+
+func (r tracedRuntime) WatchAndUpdateTraced(span *Span, resource Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	if field == "size" {
+		span.SetCache(CacheHit)
+	} else {
+		span.AddRoundTrip()
+	}
+	return r.slowRuntime.WatchAndUpdate(resource, field, watcherUID, callback)
+}
+
+type schedulerResult struct {
+	value interface{}
+	err   error
+}
+
+func resolve(s *Scheduler, resources []Resource, field string) []schedulerResult {
+	res := make([]schedulerResult, len(resources))
+	wg := sync.WaitGroup{}
+	wg.Add(len(resources))
+	for i := range resources {
+		i := i
+		s.WatchAndUpdate(resources[i], field, "w"+resources[i].MqlID(), func(value interface{}, err error) {
+			res[i] = schedulerResult{value, err}
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	return res
+}
+
+func TestScheduler(t *testing.T) {
+	t.Run("resolve fields concurrently", func(t *testing.T) {
+		runtime := newSlowRuntime(20 * time.Millisecond)
+		s := NewScheduler(runtime, SchedulerOptions{Workers: 4})
+
+		files := []Resource{}
+		for _, id := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+			files = append(files, &MockResource{Name: "file", ID: id})
+		}
+
+		res := resolve(s, files, "content")
+		assert.Equal(t, schedulerResult{value: "a.content"}, res[0])
+		assert.Equal(t, schedulerResult{value: "h.content"}, res[7])
+		assert.Equal(t, 4, runtime.max(""))
+	})
+
+	t.Run("fields of one serial resource are resolved one at a time", func(t *testing.T) {
+		runtime := newSlowRuntime(5 * time.Millisecond)
+		s := NewScheduler(runtime, SchedulerOptions{Workers: 4})
+
+		file := &MockResource{Name: "file", ID: "a"}
+		resolve(s, []Resource{file, file, file}, "size")
+		assert.Equal(t, 1, runtime.max("file\x00a"))
+
+		command := &MockResource{Name: "command", ID: "a"}
+		resolve(s, []Resource{command, command, command}, "stdout")
+		assert.Equal(t, 3, runtime.max("command\x00a"))
+	})
+
+	t.Run("limit resources per connection", func(t *testing.T) {
+		commands := []Resource{
+			&MockResource{Name: "command", ID: "a"},
+			&MockResource{Name: "command", ID: "b"},
+			&MockResource{Name: "command", ID: "c"},
+		}
+
+		runtime := newSlowRuntime(10 * time.Millisecond)
+		resolve(NewScheduler(runtime, SchedulerOptions{Workers: 4, Connection: "winrm"}), commands, "stdout")
+		assert.Equal(t, 1, runtime.max("command"))
+
+		runtime = newSlowRuntime(10 * time.Millisecond)
+		resolve(NewScheduler(runtime, SchedulerOptions{Workers: 4, Connection: "ssh"}), commands, "stdout")
+		assert.Equal(t, 3, runtime.max("command"))
+	})
+
+	t.Run("errors are sent to the callback", func(t *testing.T) {
+		s := NewScheduler(newSlowRuntime(0), SchedulerOptions{})
+		res := resolve(s, []Resource{&MockResource{Name: "file", ID: "a"}}, "missing")
+		assert.EqualError(t, res[0].err, "cannot find field")
+	})
+
+	t.Run("fields that take too long time out", func(t *testing.T) {
+		runtime := newSlowRuntime(40 * time.Millisecond)
+		s := NewScheduler(runtime, SchedulerOptions{Workers: 1, FieldTimeout: 10 * time.Millisecond})
+
+		res := resolve(s, []Resource{
+			&MockResource{Name: "file", ID: "a"},
+			&MockResource{Name: "file", ID: "b"},
+		}, "content")
+		assert.Equal(t, TimeoutError{Field: "file.content", After: 10 * time.Millisecond}, res[0].err)
+		assert.Nil(t, res[0].value)
+		assert.Equal(t, TimeoutError{Field: "file.content", After: 10 * time.Millisecond}, res[1].err)
+		// the worker stays taken until the runtime call returns
+		assert.Equal(t, 1, runtime.max(""))
+
+		// the lock of a serial resource stays taken as well
+		runtime = newSlowRuntime(40 * time.Millisecond)
+		file := &MockResource{Name: "file", ID: "a"}
+		resolve(NewScheduler(runtime, SchedulerOptions{Workers: 4, FieldTimeout: 10 * time.Millisecond}),
+			[]Resource{file, file}, "content")
+		assert.Equal(t, 1, runtime.max("file\x00a"))
+
+		// the timeout is delivered before the runtime call returns
+		s = NewScheduler(newSlowRuntime(time.Second), SchedulerOptions{FieldTimeout: 10 * time.Millisecond})
+		timedOut := make(chan error, 1)
+		s.WatchAndUpdate(&MockResource{Name: "file", ID: "a"}, "content", "w", func(value interface{}, err error) {
+			timedOut <- err
+		})
+		select {
+		case err := <-timedOut:
+			assert.Equal(t, TimeoutError{Field: "file.content", After: 10 * time.Millisecond}, err)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatal("the timeout was not delivered before the runtime call returned")
+		}
+
+		res = resolve(NewScheduler(newSlowRuntime(0), SchedulerOptions{FieldTimeout: time.Second}),
+			[]Resource{&MockResource{Name: "file", ID: "a"}}, "content")
+		assert.Equal(t, schedulerResult{value: "a.content"}, res[0])
+	})
+
+	t.Run("unregister cancels queued fields", func(t *testing.T) {
+		runtime := newSlowRuntime(20 * time.Millisecond)
+		s := NewScheduler(runtime, SchedulerOptions{Workers: 1})
+
+		called := make(chan string, 2)
+		s.WatchAndUpdate(&MockResource{Name: "file", ID: "a"}, "content", "first", func(res interface{}, err error) {
+			called <- "first"
+		})
+		s.WatchAndUpdate(&MockResource{Name: "file", ID: "b"}, "content", "second", func(res interface{}, err error) {
+			called <- "second"
+		})
+		require.NoError(t, s.Unregister("second"))
+
+		assert.Equal(t, "first", <-called)
+		time.Sleep(50 * time.Millisecond)
+		assert.Empty(t, called)
+		assert.Equal(t, []string{"second"}, runtime.unregisters)
+	})
+
+	t.Run("trace the critical path", func(t *testing.T) {
+		s := NewScheduler(newSlowRuntime(5*time.Millisecond), SchedulerOptions{Trace: true})
+
+		done := make(chan struct{})
+		root := make(chan *Span, 1)
+		root <- s.WatchAndUpdateAfter(nil, &MockResource{Name: "file", ID: "a"}, "content", "w1", func(res interface{}, err error) {
+			s.WatchAndUpdateAfter(<-root, &MockResource{Name: "file", ID: "b"}, "size", "w2", func(res interface{}, err error) {
+				close(done)
+			})
+		})
+		s.WatchAndUpdate(&MockResource{Name: "file", ID: "c"}, "size", "w3", func(res interface{}, err error) {})
+		<-done
+
+		path := s.CriticalPath()
+		require.Len(t, path, 2)
+		assert.Equal(t, "a", path[0].ID)
+		assert.Equal(t, "b", path[1].ID)
+		assert.Equal(t, "size", path[1].Field)
+		assert.False(t, path[1].Started.Before(path[0].Finished))
+		assert.Len(t, s.Spans(), 3)
+	})
+
+	t.Run("trace resources, round trips and cache use", func(t *testing.T) {
+		s := NewScheduler(tracedRuntime{newSlowRuntime(time.Millisecond)}, SchedulerOptions{Trace: true})
+
+		file, err := s.CreateResource("file", map[string]*Primitive{"path": StringPrimitive("/etc/hosts")})
+		require.NoError(t, err)
+		resolve(s, []Resource{file}, "content")
+		resolve(s, []Resource{file}, "size")
+
+		spans := s.Spans()
+		require.Len(t, spans, 3)
+		assert.True(t, spans[0].IsResource())
+		assert.Equal(t, "/etc/hosts", spans[0].ID)
+		assert.Equal(t, 1, spans[0].RoundTrips)
+		assert.Equal(t, "content", spans[1].Field)
+		assert.Equal(t, 1, spans[1].RoundTrips)
+		assert.Equal(t, CacheUnused, spans[1].Cache)
+		assert.Equal(t, "size", spans[2].Field)
+		assert.Equal(t, 0, spans[2].RoundTrips)
+		assert.Equal(t, CacheHit, spans[2].Cache)
+
+		path := s.CriticalPath()
+		require.Len(t, path, 1)
+		assert.Equal(t, "size", path[0].Field)
+	})
+}
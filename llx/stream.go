@@ -0,0 +1,319 @@
+package llx
+
+import (
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/types"
+)
+
+// DefaultListWindow is the number of list items whose blocks run at the same
+// time, if no other number is configured
+const DefaultListWindow = 1000
+
+// listWindow is the max number of list items whose blocks run at the same
+// time. Lists that are longer than this are streamed.
+func (c *MQLExecutorV2) listWindow() int {
+	if scheduler, ok := c.runtime.(*Scheduler); ok && scheduler.opts.ListWindow > 0 {
+		return scheduler.opts.ListWindow
+	}
+	return DefaultListWindow
+}
+
+// ListSource is a list that is fetched one page after the other. Runtimes
+// may return it as the value of a list field, so that where, map, length,
+// first and notEmpty only keep one page of it in memory. Every other use of
+// the field gets all of its items. A source can be read more than once.
+type ListSource interface {
+	// Page returns up to max items, starting with the item at offset. A page
+	// with fewer than max items is the last one.
+	Page(offset int, max int) ([]interface{}, error)
+}
+
+// sliceSource is a list that is already in memory
+type sliceSource []interface{}
+
+func (s sliceSource) Page(offset int, max int) ([]interface{}, error) {
+	if offset >= len(s) {
+		return nil, nil
+	}
+	end := offset + max
+	if end > len(s) {
+		end = len(s)
+	}
+	return s[offset:end], nil
+}
+
+// collectList fetches all pages of the source
+func collectList(source ListSource, pageSize int) ([]interface{}, error) {
+	res := []interface{}{}
+	for {
+		page, err := source.Page(len(res), pageSize)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page...)
+		if len(page) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+// countList counts the items of the source, one page at a time
+func countList(source ListSource, pageSize int) (int, error) {
+	res := 0
+	for {
+		page, err := source.Page(res, pageSize)
+		if err != nil {
+			return 0, err
+		}
+		res += len(page)
+		if len(page) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+// listStreamFunctions are the functions that read a list source page by
+// page, if it is their binding
+var listStreamFunctions = map[string]struct{}{
+	"where":     {},
+	"$whereNot": {},
+	"map":       {},
+	"length":    {},
+	"first":     {},
+	"notEmpty":  {},
+}
+
+// streamsList is true if the list source at ref is only used by functions
+// that read it page by page. Otherwise all its items are fetched when the
+// field is received.
+func (b *blockExecutor) streamsList(ref uint64) bool {
+	if b.ctx.isReported(ref, true) {
+		return false
+	}
+	consumers := b.ctx.consumers(ref)
+	if len(consumers) == 0 {
+		return false
+	}
+	for _, chunk := range consumers {
+		if chunk.Primitive != nil || chunk.Function.Binding != ref {
+			return false
+		}
+		if _, ok := listStreamFunctions[chunk.Id]; !ok {
+			return false
+		}
+		// where and map get the list as their first argument, all other
+		// arguments are passed on to their block
+		for i, arg := range chunk.Function.Args {
+			if r, ok := arg.RefV2(); ok && r == ref && i != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// consumers are all chunks in all blocks that use the value at ref
+func (c *MQLExecutorV2) consumers(ref uint64) []*Chunk {
+	var res []*Chunk
+	for _, block := range c.code.Blocks {
+		for i := range block.Chunks {
+			if chunkUses(block.Chunks[i], ref) {
+				res = append(res, block.Chunks[i])
+			}
+		}
+	}
+	return res
+}
+
+// isReported is true if the value at ref is an entrypoint of any block, or
+// a datapoint if these are included
+func (c *MQLExecutorV2) isReported(ref uint64, datapoints bool) bool {
+	for _, block := range c.code.Blocks {
+		for _, r := range block.Entrypoints {
+			if r == ref {
+				return true
+			}
+		}
+		if !datapoints {
+			continue
+		}
+		for _, r := range block.Datapoints {
+			if r == ref {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// streamLimits are the number of matching items that are enough for a
+// function that is called on a filtered list. The filtered list of all, none
+// and one is reported with their results, so all matching items are needed.
+var streamLimits = map[string]int{
+	"$any":  1,
+	"first": 1,
+}
+
+// streamLimit is the number of matching items after which the filter at ref
+// can stop, because the only chunk that uses its result doesn't need more of
+// them. It is 0 if all matching items are needed. The filtered list of any
+// is a datapoint, but it is only shown if any fails, in which case no item
+// matched.
+func (b *blockExecutor) streamLimit(ref uint64) int {
+	if b.ctx.isReported(ref, false) {
+		return 0
+	}
+	consumers := b.ctx.consumers(ref)
+	if len(consumers) != 1 {
+		return 0
+	}
+
+	consumer := consumers[0]
+	if consumer.Function == nil || consumer.Function.Binding != ref {
+		return 0
+	}
+	return streamLimits[consumer.Id]
+}
+
+// chunkUses is true if the chunk is bound to ref or gets it as an argument
+func chunkUses(chunk *Chunk, ref uint64) bool {
+	if chunk.Primitive != nil {
+		if r, ok := chunk.Primitive.RefV2(); ok && r == ref {
+			return true
+		}
+	}
+	if chunk.Function == nil {
+		return false
+	}
+	if chunk.Function.Binding == ref {
+		return true
+	}
+	for _, arg := range chunk.Function.Args {
+		if r, ok := arg.RefV2(); ok && r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// streamFunctionBlocks runs the block for every item of a list source, one
+// page of items after the other. Only one page and the executors for its
+// items are kept, so the memory it takes doesn't grow with the list. onItem
+// receives the results in the order of the list and returns false to stop
+// the stream, in which case no more pages are fetched. onComplete is called
+// once the stream ended, with the error of the page that failed to load, if
+// any. Unlike runFunctionBlocks, results don't change once their page
+// finished.
+func (b *blockExecutor) streamFunctionBlocks(source ListSource, typ types.Type, blockRef uint64,
+	onItem func(item interface{}, res arrayBlockCallResult) bool,
+	onComplete func(err error),
+) error {
+	window := b.ctx.listWindow()
+
+	var runPage func(offset int) error
+	runPage = func(offset int) error {
+		page, err := source.Page(offset, window)
+		if err != nil {
+			onComplete(err)
+			return nil
+		}
+		if len(page) == 0 {
+			onComplete(nil)
+			return nil
+		}
+
+		finished := false
+		var executors []*blockExecutor
+		callResults, shouldRun := newArrayBlockCallResultsV2(len(page), b.ctx.code, blockRef, func(results []arrayBlockCallResult, errs []error) {
+			// results are updated again if any of their fields change
+			if finished {
+				return
+			}
+			finished = true
+			b.ctx.retireBlockExecutors(executors)
+
+			for i := range results {
+				if !onItem(page[i], results[i]) {
+					onComplete(nil)
+					return
+				}
+			}
+			if len(page) < window {
+				onComplete(nil)
+				return
+			}
+			if err := runPage(offset + len(page)); err != nil {
+				onComplete(err)
+			}
+		})
+		if !shouldRun {
+			return nil
+		}
+
+		// all executors of the page are created before any of them runs,
+		// since the page may finish while its last executor runs
+		executors = make([]*blockExecutor, len(page))
+		for i := range executors {
+			idx := i
+			executor, err := b.newFunctionBlock([]*RawData{{Type: typ, Value: page[i]}}, blockRef, func(rr *RawResult) {
+				callResults.update(idx, rr)
+			})
+			if err != nil {
+				return err
+			}
+			executors[i] = executor
+		}
+		for i := range executors {
+			executors[i].run()
+		}
+		return nil
+	}
+
+	return runPage(0)
+}
+
+// retireBlockExecutors drops executors and all of their children once their
+// results were received and unregisters their watchers
+func (c *MQLExecutorV2) retireBlockExecutors(executors []*blockExecutor) {
+	retired := make(map[*blockExecutor]struct{}, len(executors))
+	for i := range executors {
+		retired[executors[i]] = struct{}{}
+	}
+
+	c.lock.Lock()
+	var watchers []string
+	kept := c.blockExecutors[:0]
+	for _, be := range c.blockExecutors {
+		if !isRetired(be, retired) {
+			kept = append(kept, be)
+			continue
+		}
+		be.watcherIds.Range(func(key string) bool {
+			watchers = append(watchers, key)
+			return true
+		})
+	}
+	for i := len(kept); i < len(c.blockExecutors); i++ {
+		c.blockExecutors[i] = nil
+	}
+	c.blockExecutors = kept
+	c.lock.Unlock()
+
+	for i := range watchers {
+		if err := c.runtime.Unregister(watchers[i]); err != nil {
+			log.Error().Err(err).Msg("exec> unregister error")
+		}
+	}
+
+	log.Trace().Str("id", c.id).Int("executors", len(executors)).Msg("exec> retired block executors")
+}
+
+// isRetired is true if the executor or any of its parents is retired
+func isRetired(be *blockExecutor, retired map[*blockExecutor]struct{}) bool {
+	for cur := be; cur != nil; cur = cur.parent {
+		if _, ok := retired[cur]; ok {
+			return true
+		}
+	}
+	return false
+}
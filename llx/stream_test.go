@@ -0,0 +1,281 @@
+package llx_test
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// listRuntime has a list of files, whose size is their index in the list.
+// It counts how many sizes were fetched. If it is paged, the list is a list
+// source and it also counts the pages and the largest one. It also has the
+// time and parse resources, which only have builtin functions.
+type listRuntime struct {
+	schema   *resources.Schema
+	files    int
+	sizes    int64
+	paged    bool
+	pages    int64
+	maxPage  int64
+	lastPage int64
+}
+
+// fileSource pages through the files of a listRuntime
+type fileSource struct {
+	runtime *listRuntime
+}
+
+func (f fileSource) Page(offset int, max int) ([]interface{}, error) {
+	atomic.AddInt64(&f.runtime.pages, 1)
+	atomic.StoreInt64(&f.runtime.lastPage, int64(offset))
+
+	var res []interface{}
+	for i := offset; i < f.runtime.files && len(res) < max; i++ {
+		res = append(res, &llx.MockResource{Name: "file", ID: strconv.Itoa(i)})
+	}
+	for {
+		cur := atomic.LoadInt64(&f.runtime.maxPage)
+		if int64(len(res)) <= cur || atomic.CompareAndSwapInt64(&f.runtime.maxPage, cur, int64(len(res))) {
+			break
+		}
+	}
+	return res, nil
+}
+
+func newListRuntime(files int) *listRuntime {
+	return &listRuntime{
+		files: files,
+		schema: &resources.Schema{Resources: map[string]*resources.ResourceInfo{
+			"files": {
+				Id:   "files",
+				Name: "files",
+				Fields: map[string]*resources.Field{
+					"list": {Name: "list", Type: string(types.Array(types.Resource("file")))},
+				},
+			},
+			"file": {
+				Id:   "file",
+				Name: "file",
+				Fields: map[string]*resources.Field{
+					"size": {Name: "size", Type: string(types.Int)},
+				},
+			},
+			"time":  {Id: "time", Name: "time"},
+			"parse": {Id: "parse", Name: "parse"},
+		}},
+	}
+}
+
+func (r *listRuntime) Unregister(watcherUID string) error { return nil }
+
+func (r *listRuntime) CreateResource(name string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return &llx.MockResource{Name: name}, nil
+}
+
+func (r *listRuntime) CreateResourceWithID(name string, id string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return &llx.MockResource{Name: name, ID: id}, nil
+}
+
+func (r *listRuntime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	switch field {
+	case "list":
+		if r.paged {
+			callback(fileSource{runtime: r}, nil)
+			return nil
+		}
+		list := make([]interface{}, r.files)
+		for i := range list {
+			list[i] = &llx.MockResource{Name: "file", ID: strconv.Itoa(i)}
+		}
+		callback(list, nil)
+	case "size":
+		atomic.AddInt64(&r.sizes, 1)
+		size, _ := strconv.Atoi(resource.MqlID())
+		callback(int64(size), nil)
+	}
+	return nil
+}
+
+func (r *listRuntime) Schema() llx.Schema { return r.schema }
+
+func (r *listRuntime) Close() {}
+
+// runList runs a query with one entrypoint on 100 files, 10 at a time
+func runList(t *testing.T, query string) (*llx.RawData, int64) {
+	res, runtime := runListOn(t, newListRuntime(100), query)
+	return res, runtime.sizes
+}
+
+func runListOn(t *testing.T, runtime *listRuntime, query string) (*llx.RawData, *listRuntime) {
+	scheduler := llx.NewScheduler(runtime, llx.SchedulerOptions{Workers: 4, ListWindow: 10})
+
+	bundle, err := mqlc.Compile(query, nil, mqlc.NewConfig(scheduler.Schema(), cnquery.DefaultFeatures))
+	require.NoError(t, err)
+	checksum := bundle.CodeV2.Checksums[bundle.CodeV2.Entrypoints()[0]]
+
+	var once sync.Once
+	done := make(chan *llx.RawData, 1)
+	executor, err := llx.NewExecutorV2(bundle.CodeV2, scheduler, nil, func(res *llx.RawResult) {
+		if res.CodeID == checksum {
+			once.Do(func() { done <- res.Data })
+		}
+	})
+	require.NoError(t, err)
+	require.NoError(t, executor.Run())
+
+	select {
+	case res := <-done:
+		require.NoError(t, executor.Unregister())
+		return res, runtime
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for results")
+		return nil, nil
+	}
+}
+
+func TestStreamLists(t *testing.T) {
+	t.Run("where", func(t *testing.T) {
+		res, sizes := runList(t, "files.list.where(size > 94).length")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(5), res.Value)
+		assert.Equal(t, int64(100), sizes)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		res, sizes := runList(t, "files.list.map(size)")
+		require.NoError(t, res.Error)
+		list := res.Value.([]interface{})
+		require.Len(t, list, 100)
+		for i := range list {
+			assert.Equal(t, int64(i), list[i])
+		}
+		assert.Equal(t, int64(100), sizes)
+	})
+
+	t.Run("any stops at the first match", func(t *testing.T) {
+		res, sizes := runList(t, "files.list.any(size == 13)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, true, res.Value)
+		assert.Equal(t, int64(20), sizes)
+	})
+
+	// their filtered lists are reported, so they need all items
+	t.Run("all, none and one", func(t *testing.T) {
+		res, sizes := runList(t, "files.list.all(size < 25)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, false, res.Value)
+		assert.Equal(t, int64(100), sizes)
+
+		res, _ = runList(t, "files.list.none(size == 5)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, false, res.Value)
+
+		res, _ = runList(t, "files.list.one(size == 41)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, true, res.Value)
+	})
+
+	t.Run("first stops at the first match", func(t *testing.T) {
+		res, sizes := runList(t, "files.list.where(size > 31).first.size")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(32), res.Value)
+		// the first match's size is fetched again for the result
+		assert.Equal(t, int64(41), sizes)
+	})
+
+	t.Run("any runs to the end without matches", func(t *testing.T) {
+		res, sizes := runList(t, "files.list.any(size > 100)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, false, res.Value)
+		assert.Equal(t, int64(100), sizes)
+	})
+
+	t.Run("filtered lists that are results have all items", func(t *testing.T) {
+		res, sizes := runList(t, "files.list.where(size < 3)")
+		require.NoError(t, res.Error)
+		assert.Len(t, res.Value, 3)
+		assert.Equal(t, int64(100), sizes)
+	})
+}
+
+func TestStreamListSources(t *testing.T) {
+	// runPaged runs the query on 100 files, which are fetched 10 at a time
+	runPaged := func(t *testing.T, query string) (*llx.RawData, *listRuntime) {
+		runtime := newListRuntime(100)
+		runtime.paged = true
+		return runListOn(t, runtime, query)
+	}
+
+	t.Run("where", func(t *testing.T) {
+		res, runtime := runPaged(t, "files.list.where(size > 94).length")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(5), res.Value)
+		assert.Equal(t, int64(100), runtime.sizes)
+		assert.Equal(t, int64(10), runtime.maxPage)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		res, runtime := runPaged(t, "files.list.map(size)")
+		require.NoError(t, res.Error)
+		list := res.Value.([]interface{})
+		require.Len(t, list, 100)
+		for i := range list {
+			assert.Equal(t, int64(i), list[i])
+		}
+		assert.Equal(t, int64(10), runtime.maxPage)
+	})
+
+	t.Run("any stops fetching at the first match", func(t *testing.T) {
+		res, runtime := runPaged(t, "files.list.any(size == 13)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, true, res.Value)
+		assert.Equal(t, int64(20), runtime.sizes)
+		assert.Equal(t, int64(2), runtime.pages)
+		assert.Equal(t, int64(10), runtime.lastPage)
+	})
+
+	t.Run("all and none", func(t *testing.T) {
+		res, runtime := runPaged(t, "files.list.all(size < 25)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, false, res.Value)
+		assert.Equal(t, int64(10), runtime.maxPage)
+
+		res, runtime = runPaged(t, "files.list.none(size == 5)")
+		require.NoError(t, res.Error)
+		assert.Equal(t, false, res.Value)
+		assert.Equal(t, int64(10), runtime.maxPage)
+	})
+
+	t.Run("length", func(t *testing.T) {
+		res, runtime := runPaged(t, "files.list.length")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(100), res.Value)
+		assert.Equal(t, int64(0), runtime.sizes)
+		assert.Equal(t, int64(10), runtime.maxPage)
+	})
+
+	t.Run("first", func(t *testing.T) {
+		res, runtime := runPaged(t, "files.list.first.size")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(0), res.Value)
+		assert.Equal(t, int64(1), runtime.pages)
+		assert.Equal(t, int64(1), runtime.maxPage)
+	})
+
+	t.Run("lists that are results have all items", func(t *testing.T) {
+		res, runtime := runPaged(t, "files.list")
+		require.NoError(t, res.Error)
+		assert.Len(t, res.Value, 100)
+		assert.Equal(t, int64(10), runtime.maxPage)
+	})
+}
@@ -0,0 +1,67 @@
+package llx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+)
+
+func TestTime_Run(t *testing.T) {
+	jan31 := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		query    string
+		expected interface{}
+	}{
+		{"time.parse('2024-01-31', 'date')", &jan31},
+		{"parse.date('2024-01-31')", &jan31},
+		{"parse.date('2024-01-31T10:00:00Z', 'rfc3339').format('date')", "2024-01-31"},
+		{"time.parse('2024-01-31 10:00', '2006-01-02 15:04', 'Europe/Berlin').format('rfc3339', 'UTC')", "2024-01-31T09:00:00Z"},
+		{"time.parse('2024-01-31 10:00', '2006-01-02 15:04', 'Europe/Berlin') == parse.date('2024-01-31T09:00:00Z')", true},
+		{"time.parse('2024-01-31', 'date') + time.month", ptr(time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC))},
+		{"time.parse('2024-01-31', 'date', 'Europe/Berlin') - 2 * time.day", ptr(time.Date(2024, time.January, 29, 0, 0, 0, 0, berlin))},
+		{"time.day", llx.Duration{Seconds: 24 * 60 * 60}},
+		{"3 * time.hour + 30 * time.minute + time.second", llx.Duration{Seconds: 3*60*60 + 30*60 + 1}},
+		{"time.day > 23 * time.hour", true},
+		{"duration('2d') / 2", llx.Duration{Seconds: 24 * 60 * 60}},
+		{"time.month / 2.0", llx.Duration{Seconds: 15*24*60*60 + 5*60*60 + 14*60 + 33}},
+		{"-duration('1d')", llx.Duration{Seconds: -24 * 60 * 60}},
+		{"x = time.week; -x + time.day", llx.Duration{Seconds: -6 * 24 * 60 * 60}},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.query, func(t *testing.T) {
+			res, _ := runList(t, cur.query)
+			require.NoError(t, res.Error)
+			assert.Equal(t, cur.expected, res.Value)
+		})
+	}
+
+	errs := []struct {
+		query    string
+		expected string
+	}{
+		{"time.parse('31.01.2024', 'date')", `failed to parse time: parsing time "31.01.2024" as "2006-01-02": cannot parse "31.01.2024" as "2006"`},
+		{"parse.date('garbage')", "failed to parse time 'garbage'"},
+		{"duration('1d') / 0", "cannot divide duration by zero"},
+	}
+
+	for i := range errs {
+		cur := errs[i]
+		t.Run(cur.query, func(t *testing.T) {
+			res, _ := runList(t, cur.query)
+			require.Error(t, res.Error)
+			assert.Equal(t, cur.expected, res.Error.Error())
+		})
+	}
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
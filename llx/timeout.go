@@ -0,0 +1,46 @@
+package llx
+
+import (
+	"time"
+)
+
+// TimeoutError is the error of a result that did not arrive in time
+type TimeoutError struct {
+	// Field that timed out, e.g. "command.stdout". It is empty if the query
+	// as a whole timed out.
+	Field string
+	// After is the timeout that was exceeded
+	After time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	if e.Field == "" {
+		return "query timed out after " + e.After.String()
+	}
+	return e.Field + " timed out after " + e.After.String()
+}
+
+// TimeoutResult creates the result of a datapoint that timed out
+func TimeoutResult(codeID string, field string, after time.Duration) *RawResult {
+	return &RawResult{
+		Data:   &RawData{Error: TimeoutError{Field: field, After: after}},
+		CodeID: codeID,
+	}
+}
+
+// IsTimeout returns the timeout error of the data if it timed out
+func (r *RawData) IsTimeout() (TimeoutError, bool) {
+	if r == nil || r.Error == nil {
+		return TimeoutError{}, false
+	}
+	res, ok := r.Error.(TimeoutError)
+	return res, ok
+}
+
+func (e TimeoutError) proto() *Timeout {
+	return &Timeout{Field: e.Field, After: int64(e.After)}
+}
+
+func (t *Timeout) timeoutError() TimeoutError {
+	return TimeoutError{Field: t.Field, After: time.Duration(t.After)}
+}
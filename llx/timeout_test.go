@@ -0,0 +1,44 @@
+package llx
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/types"
+)
+
+func TestTimeoutResult(t *testing.T) {
+	t.Run("field timeout", func(t *testing.T) {
+		res := TimeoutResult("checksum", "command.stdout", 5*time.Second)
+		assert.EqualError(t, res.Data.Error, "command.stdout timed out after 5s")
+
+		timeout, ok := res.Data.IsTimeout()
+		require.True(t, ok)
+		assert.Equal(t, "command.stdout", timeout.Field)
+	})
+
+	t.Run("query timeout", func(t *testing.T) {
+		res := TimeoutResult("checksum", "", time.Minute)
+		assert.EqualError(t, res.Data.Error, "query timed out after 1m0s")
+	})
+
+	t.Run("other errors are no timeouts", func(t *testing.T) {
+		_, ok := (&RawData{Error: errors.New("fail")}).IsTimeout()
+		assert.False(t, ok)
+		_, ok = (&RawData{Type: types.Int, Value: int64(1)}).IsTimeout()
+		assert.False(t, ok)
+	})
+
+	t.Run("convert to and from proto", func(t *testing.T) {
+		res := TimeoutResult("checksum", "command.stdout", 5*time.Second).Result()
+		assert.Equal(t, "command.stdout timed out after 5s", res.Error)
+		assert.Equal(t, "command.stdout", res.Timeout.Field)
+
+		raw := res.RawResultV2()
+		assert.Equal(t, "checksum", raw.CodeID)
+		assert.Equal(t, TimeoutError{Field: "command.stdout", After: 5 * time.Second}, raw.Data.Error)
+	})
+}
@@ -0,0 +1,190 @@
+package llx
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// chromeTrace is the JSON object format of Chrome's trace events, which can
+// be opened in chrome://tracing or https://ui.perfetto.dev
+type chromeTrace struct {
+	TraceEvents     []chromeTraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string             `json:"displayTimeUnit"`
+}
+
+// chromeTraceEvent is one complete event, its timestamp and duration are in
+// microseconds
+type chromeTraceEvent struct {
+	Name      string            `json:"name"`
+	Category  string            `json:"cat"`
+	Phase     string            `json:"ph"`
+	Timestamp int64             `json:"ts"`
+	Duration  int64             `json:"dur"`
+	Pid       int               `json:"pid"`
+	Tid       int               `json:"tid"`
+	Args      map[string]string `json:"args"`
+}
+
+// spanName is the resource and field of a span, e.g. "packages.list", or
+// just the resource if the span created it
+func spanName(span *Span) string {
+	if span.IsResource() {
+		return span.Resource
+	}
+	return span.Resource + "." + span.Field
+}
+
+// WriteChromeTrace writes the spans in Chrome's trace event format. Spans
+// that overlap in time are put on separate threads, so that every thread
+// shows the fields that one worker resolved.
+func WriteChromeTrace(w io.Writer, spans []*Span) error {
+	res := chromeTrace{
+		TraceEvents:     make([]chromeTraceEvent, 0, len(spans)),
+		DisplayTimeUnit: "ms",
+	}
+
+	sorted := make([]*Span, len(spans))
+	copy(sorted, spans)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Started.Before(sorted[j].Started)
+	})
+
+	var start time.Time
+	if len(sorted) != 0 {
+		start = sorted[0].Started
+	}
+
+	// threads holds when the last span on each thread finished
+	var threads []time.Time
+	for _, span := range sorted {
+		tid := -1
+		for i := range threads {
+			if !threads[i].After(span.Started) {
+				tid = i
+				break
+			}
+		}
+		if tid == -1 {
+			tid = len(threads)
+			threads = append(threads, time.Time{})
+		}
+		threads[tid] = span.Finished
+
+		category := "field"
+		if span.IsResource() {
+			category = "resource"
+		}
+
+		args := map[string]string{
+			"resource":   span.Resource,
+			"id":         span.ID,
+			"wait":       span.Wait().String(),
+			"roundTrips": strconv.Itoa(span.RoundTrips),
+		}
+		if !span.IsResource() {
+			args["field"] = span.Field
+		}
+		if span.Cache != CacheUnused {
+			args["cache"] = span.Cache.String()
+		}
+
+		res.TraceEvents = append(res.TraceEvents, chromeTraceEvent{
+			Name:      spanName(span),
+			Category:  category,
+			Phase:     "X",
+			Timestamp: span.Started.Sub(start).Microseconds(),
+			Duration:  span.Duration().Microseconds(),
+			Pid:       1,
+			Tid:       tid + 1,
+			Args:      args,
+		})
+	}
+
+	return json.NewEncoder(w).Encode(res)
+}
+
+// FieldProfile sums up all spans of one resource field, or of creating one
+// resource if the field is empty
+type FieldProfile struct {
+	Resource    string
+	Field       string
+	Calls       int
+	Total       time.Duration
+	Max         time.Duration
+	RoundTrips  int
+	CacheHits   int
+	CacheMisses int
+}
+
+// Name of the profiled resource field, e.g. "packages.list"
+func (f *FieldProfile) Name() string {
+	if f.Field == "" {
+		return f.Resource
+	}
+	return f.Resource + "." + f.Field
+}
+
+// Profile sums up the spans per resource field, with the fields that took
+// the longest in total first
+func Profile(spans []*Span) []*FieldProfile {
+	idx := map[string]*FieldProfile{}
+	res := []*FieldProfile{}
+	for _, span := range spans {
+		name := spanName(span)
+		cur, ok := idx[name]
+		if !ok {
+			cur = &FieldProfile{Resource: span.Resource, Field: span.Field}
+			idx[name] = cur
+			res = append(res, cur)
+		}
+
+		d := span.Duration()
+		cur.Calls++
+		cur.Total += d
+		if d > cur.Max {
+			cur.Max = d
+		}
+		cur.RoundTrips += span.RoundTrips
+		switch span.Cache {
+		case CacheHit:
+			cur.CacheHits++
+		case CacheMiss:
+			cur.CacheMisses++
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Total != res[j].Total {
+			return res[i].Total > res[j].Total
+		}
+		return res[i].Name() < res[j].Name()
+	})
+	return res
+}
+
+// FormatProfile renders the first n profiles as a table for people. All
+// profiles are rendered if n is 0 or less.
+func FormatProfile(profiles []*FieldProfile, n int) string {
+	if n > 0 && len(profiles) > n {
+		profiles = profiles[:n]
+	}
+
+	var res strings.Builder
+	w := tabwriter.NewWriter(&res, 0, 0, 2, ' ', 0)
+	w.Write([]byte("FIELD\tCALLS\tTOTAL\tMAX\tROUND TRIPS\tCACHE HIT/MISS\n"))
+	for _, p := range profiles {
+		w.Write([]byte(p.Name() + "\t" +
+			strconv.Itoa(p.Calls) + "\t" +
+			p.Total.Round(time.Microsecond).String() + "\t" +
+			p.Max.Round(time.Microsecond).String() + "\t" +
+			strconv.Itoa(p.RoundTrips) + "\t" +
+			strconv.Itoa(p.CacheHits) + "/" + strconv.Itoa(p.CacheMisses) + "\n"))
+	}
+	w.Flush()
+	return res.String()
+}
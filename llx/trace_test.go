@@ -0,0 +1,90 @@
+package llx
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSpans() []*Span {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	span := func(resource string, field string, from time.Duration, to time.Duration) *Span {
+		return &Span{
+			Resource: resource,
+			ID:       "id",
+			Field:    field,
+			Queued:   start,
+			Started:  start.Add(from),
+			Finished: start.Add(to),
+		}
+	}
+
+	res := []*Span{
+		span("packages", "", 0, time.Millisecond),
+		span("packages", "list", time.Millisecond, 50*time.Millisecond),
+		span("users", "list", 2*time.Millisecond, 10*time.Millisecond),
+		span("users", "list", 50*time.Millisecond, 60*time.Millisecond),
+	}
+	res[1].RoundTrips = 1
+	res[1].Cache = CacheMiss
+	res[3].Cache = CacheHit
+	return res
+}
+
+func TestWriteChromeTrace(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteChromeTrace(&buf, testSpans()))
+
+	var res chromeTrace
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &res))
+	require.Len(t, res.TraceEvents, 4)
+
+	resource := res.TraceEvents[0]
+	assert.Equal(t, "packages", resource.Name)
+	assert.Equal(t, "resource", resource.Category)
+	assert.Equal(t, "X", resource.Phase)
+	assert.Equal(t, int64(0), resource.Timestamp)
+	assert.Equal(t, int64(1000), resource.Duration)
+
+	list := res.TraceEvents[1]
+	assert.Equal(t, "packages.list", list.Name)
+	assert.Equal(t, "field", list.Category)
+	assert.Equal(t, int64(1000), list.Timestamp)
+	assert.Equal(t, "miss", list.Args["cache"])
+	assert.Equal(t, "1", list.Args["roundTrips"])
+	assert.Equal(t, "1ms", list.Args["wait"])
+
+	// overlapping spans are on separate threads, others reuse free threads
+	assert.Equal(t, 1, resource.Tid)
+	assert.Equal(t, 1, list.Tid)
+	assert.Equal(t, 2, res.TraceEvents[2].Tid)
+	assert.Equal(t, 1, res.TraceEvents[3].Tid)
+}
+
+func TestProfile(t *testing.T) {
+	profiles := Profile(testSpans())
+	require.Len(t, profiles, 3)
+
+	assert.Equal(t, "packages.list", profiles[0].Name())
+	assert.Equal(t, 49*time.Millisecond, profiles[0].Total)
+	assert.Equal(t, 1, profiles[0].CacheMisses)
+
+	assert.Equal(t, "users.list", profiles[1].Name())
+	assert.Equal(t, 2, profiles[1].Calls)
+	assert.Equal(t, 18*time.Millisecond, profiles[1].Total)
+	assert.Equal(t, 10*time.Millisecond, profiles[1].Max)
+	assert.Equal(t, 1, profiles[1].CacheHits)
+
+	assert.Equal(t, "packages", profiles[2].Name())
+
+	table := FormatProfile(profiles, 2)
+	lines := strings.Split(strings.TrimSpace(table), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "FIELD"))
+	assert.Equal(t, []string{"packages.list", "1", "49ms", "49ms", "1", "0/1"}, strings.Fields(lines[1]))
+}
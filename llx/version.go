@@ -0,0 +1,111 @@
+package llx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mondoo.com/cnquery/resources/packs/core/versions/generic"
+	"go.mondoo.com/cnquery/types"
+)
+
+// Version is the runtime value of the version type, e.g. the version of a
+// package. Its ecosystem decides how it is compared to other versions.
+type Version struct {
+	Value string
+	// Ecosystem of the version, e.g. deb, rpm, apk, or npm. Versions without
+	// an ecosystem are compared as semantic versions if possible.
+	Ecosystem string
+}
+
+// NewVersion creates a version and makes sure it can be parsed in its
+// ecosystem
+func NewVersion(value string, ecosystem string) (Version, error) {
+	res := Version{Value: value, Ecosystem: ecosystem}
+	if _, err := generic.Compare(ecosystem, value, value); err != nil {
+		if ecosystem == "" {
+			return res, errors.New("invalid version '" + value + "': " + err.Error())
+		}
+		return res, errors.New("invalid " + ecosystem + " version '" + value + "': " + err.Error())
+	}
+	return res, nil
+}
+
+func (v Version) String() string {
+	return v.Value
+}
+
+// Compare this version to another one. It returns -1 if this version is
+// lower, 0 if they are equal, and 1 if it is higher. If only one of them
+// has an ecosystem, it is used for both.
+func (v Version) Compare(other Version) (int, error) {
+	ecosystem := v.Ecosystem
+	if ecosystem == "" {
+		ecosystem = other.Ecosystem
+	} else if other.Ecosystem != "" && other.Ecosystem != ecosystem {
+		return 0, errors.New("cannot compare " + ecosystem + " version '" + v.Value + "' to " + other.Ecosystem + " version '" + other.Value + "'")
+	}
+	return generic.Compare(ecosystem, v.Value, other.Value)
+}
+
+// InRange checks if this version matches a range like "<2.0, >=1.4"
+func (v Version) InRange(constraints string) (bool, error) {
+	return generic.InRange(v.Ecosystem, v.Value, constraints)
+}
+
+// VersionPrimitive creates a primitive from a version
+func VersionPrimitive(v Version) *Primitive {
+	return &Primitive{
+		Type:  string(types.Version),
+		Value: versionBytes(v),
+	}
+}
+
+// VersionData creates a rawdata struct from a version
+func VersionData(v Version) *RawData {
+	return &RawData{
+		Type:  types.Version,
+		Value: v,
+	}
+}
+
+// versions are stored as <ecosystem>:<value>. Ecosystems never contain
+// a colon, while versions may, e.g. for deb epochs.
+func versionBytes(v Version) []byte {
+	return []byte(v.Ecosystem + ":" + v.Value)
+}
+
+func bytes2version(b []byte) Version {
+	ecosystem, value, _ := strings.Cut(string(b), ":")
+	return Version{Value: value, Ecosystem: ecosystem}
+}
+
+// compareVersions of the left and right side of an operation, where one of
+// them may be a string or dict. Strings use the ecosystem of the version
+// they are compared to.
+func compareVersions(left interface{}, right interface{}) (int, error) {
+	l, lok := left.(Version)
+	r, rok := right.(Version)
+	if !lok {
+		s, ok := left.(string)
+		if !ok {
+			return 0, fmt.Errorf("cannot compare %T to a version", left)
+		}
+		l = Version{Value: s, Ecosystem: r.Ecosystem}
+	}
+	if !rok {
+		s, ok := right.(string)
+		if !ok {
+			return 0, fmt.Errorf("cannot compare %T to a version", right)
+		}
+		r = Version{Value: s, Ecosystem: l.Ecosystem}
+	}
+	return l.Compare(r)
+}
+
+// EqualValue checks if this version is equal to another version or
+// string. Versions that can't be compared are not equal.
+func (v Version) EqualValue(other interface{}) bool {
+	cmp, err := compareVersions(v, other)
+	return err == nil && cmp == 0
+}
@@ -0,0 +1,61 @@
+package llx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+)
+
+func TestVersion_Run(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected interface{}
+	}{
+		{"version('1:1.0', 'deb') > version('2.0', 'deb')", true},
+		{"version('1.2') == '1.2.0'", true},
+		{"[version('1.10'), version('1.9'), version('1.2')].sort", []interface{}{
+			llx.Version{Value: "1.2"}, llx.Version{Value: "1.9"}, llx.Version{Value: "1.10"},
+		}},
+		{"[version('1.2'), version('1.10'), version('1.9')].max", llx.Version{Value: "1.10"}},
+		{"[version('1.2'), version('1.2.0'), version('1.3')].unique.length", int64(2)},
+		{"[duration('2d'), duration('1h'), duration('1d')].sort.first", llx.Duration{Seconds: 60 * 60}},
+		{"[duration('1d'), duration('PT24H'), duration('1h')].unique.length", int64(2)},
+		{"[duration('2d'), duration('1mo')].min", llx.Duration{Seconds: 2 * 24 * 60 * 60}},
+		{"[version('1.2'), version('1.10'), version('1.2')].countBy(_)", map[string]interface{}{
+			"1.2": int64(2), "1.10": int64(1),
+		}},
+		{"[version('1.2'), version('1.10')].groupBy(_)['1.10']", []interface{}{llx.Version{Value: "1.10"}}},
+		{"[duration('1d'), duration('PT24H'), duration('1h')].countBy(_)", map[string]interface{}{
+			"1d": int64(2), "1h": int64(1),
+		}},
+		{"[duration('1d'), duration('1h')].groupBy(_)['1h']", []interface{}{llx.Duration{Seconds: 60 * 60}}},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.query, func(t *testing.T) {
+			res, _ := runList(t, cur.query)
+			require.NoError(t, res.Error)
+			assert.Equal(t, cur.expected, res.Value)
+		})
+	}
+
+	errs := []struct {
+		query    string
+		expected string
+	}{
+		{"version('1.0') >= 'garbage'", "invalid version 'garbage', it must start with a number"},
+		{"version('1.0', 'deb') == version('1.0', 'rpm')", "cannot compare deb version '1.0' to rpm version '1.0'"},
+	}
+
+	for i := range errs {
+		cur := errs[i]
+		t.Run(cur.query, func(t *testing.T) {
+			res, _ := runList(t, cur.query)
+			require.Error(t, res.Error)
+			assert.Equal(t, cur.expected, res.Error.Error())
+		})
+	}
+}
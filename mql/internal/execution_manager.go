@@ -49,56 +49,67 @@ func newExecutionManager(schema llx.Schema, runtime llx.Runtime, runQueue chan r
 }
 
 func (em *executionManager) Start() {
-	em.wg.Add(1)
-	go func() {
-		defer em.wg.Done()
-		for {
-			// Prioritize stopChan
-			select {
-			case <-em.stopChan:
+	// code bundles only wait on each other if their fields are resolved one
+	// at a time, otherwise run as many as there are workers to resolve them
+	workers := 1
+	if scheduler, ok := em.runtime.(*llx.Scheduler); ok {
+		workers = scheduler.Workers()
+	}
+
+	em.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go em.run()
+	}
+}
+
+func (em *executionManager) run() {
+	defer em.wg.Done()
+	for {
+		// Prioritize stopChan
+		select {
+		case <-em.stopChan:
+			return
+		default:
+		}
+
+		select {
+		case item, ok := <-em.runQueue:
+			if !ok {
 				return
-			default:
 			}
-
-			select {
-			case item, ok := <-em.runQueue:
-				if !ok {
-					return
-				}
-				props := make(map[string]*llx.Primitive)
-				errMsg := ""
-				for k, r := range item.props {
-					if r.Error != "" {
-						// This case is tricky to handle. If we cannot run the query at
-						// all, its unclear what to report for the datapoint. If we
-						// report them in, then another query cant report them, at least
-						// with the way things are right now. If we don't report them,
-						// things will wait around for datapoint results that will never
-						// arrive.
-						errMsg = "property " + k + " errored: " + r.Error
-						break
-					}
-					props[k] = r.Data
+			props := make(map[string]*llx.Primitive)
+			errMsg := ""
+			for k, r := range item.props {
+				if r.Error != "" {
+					// This case is tricky to handle. If we cannot run the query at
+					// all, its unclear what to report for the datapoint. If we
+					// report them in, then another query cant report them, at least
+					// with the way things are right now. If we don't report them,
+					// things will wait around for datapoint results that will never
+					// arrive.
+					errMsg = "property " + k + " errored: " + r.Error
+					break
 				}
+				props[k] = r.Data
+			}
 
-				if err := em.executeCodeBundle(item.codeBundle, props, errMsg); err != nil {
-					// an error is returned if we cannot execute a query. This happens
-					// if the lumi runtime doesn't report back expected data, there is
-					// a problem with the lumi runtime, or the query is somehow invalid.
-					// We need to give up here because the underlying runtime is in a bad
-					// state and/or we will not be able to report certain datapoints and
-					// we cannot be confident about which ones
-					select {
-					case em.errChan <- err:
-					default:
-					}
-					return
+			if err := em.executeCodeBundle(item.codeBundle, props, errMsg); err != nil {
+				// an error is returned if we cannot execute a query. This happens
+				// if the lumi runtime doesn't report back expected data, there is
+				// a problem with the lumi runtime, or the query is somehow invalid.
+				// We need to give up here because the underlying runtime is in a bad
+				// state and/or we will not be able to report certain datapoints and
+				// we cannot be confident about which ones
+				select {
+				case em.errChan <- err:
+				default:
 				}
-			case <-em.stopChan:
 				return
 			}
+		case <-em.stopChan:
+			return
 		}
-	}()
+	}
 }
 
 func (em *executionManager) Err() chan error {
@@ -177,31 +188,27 @@ func (em *executionManager) executeCodeBundle(codeBundle *llx.CodeBundle, props
 		close(execDoneChan)
 	}()
 
-	var errOut error
-
 	timer := time.NewTimer(em.timeout)
 	defer timer.Stop()
 	select {
 	case <-timer.C:
 		log.Error().Dur("timeout", em.timeout).Str("qrid", codeID).Msg("execution timed out")
-		errOut = errQueryTimeout
 	case <-execDoneChan:
 	}
 
+	// datapoints that are still missing are reported as timed out, which
+	// keeps all other results of the query
 	unreported := wg.Decommission()
 	if len(unreported) > 0 {
 		log.Warn().Strs("missing", unreported).Str("qrid", codeID).Msg("unreported datapoints")
 	}
-
-	if err := executor.Unregister(); err != nil {
-		return err
+	for i := range unreported {
+		sendResult(llx.TimeoutResult(unreported[i], "", em.timeout))
 	}
 
-	return errOut
+	return executor.Unregister()
 }
 
-var errQueryTimeout = errors.New("query execution timed out")
-
 type iExecutor interface {
 	Unregister() error
 }
@@ -16,15 +16,17 @@ type compileHandler struct {
 }
 
 var (
-	childType       = func(t types.Type) types.Type { return t.Child() }
-	arrayBlockType  = func(t types.Type) types.Type { return types.Array(types.Map(types.Int, types.Block)) }
-	boolType        = func(t types.Type) types.Type { return types.Bool }
-	intType         = func(t types.Type) types.Type { return types.Int }
-	stringType      = func(t types.Type) types.Type { return types.String }
-	stringArrayType = func(t types.Type) types.Type { return types.Array(types.String) }
-	dictType        = func(t types.Type) types.Type { return types.Dict }
-	blockType       = func(t types.Type) types.Type { return types.Block }
-	dictArrayType   = func(t types.Type) types.Type { return types.Array(types.Dict) }
+	sameType         = func(t types.Type) types.Type { return t }
+	childType        = func(t types.Type) types.Type { return t.Child() }
+	arrayBlockType   = func(t types.Type) types.Type { return types.Array(types.Map(types.Int, types.Block)) }
+	boolType         = func(t types.Type) types.Type { return types.Bool }
+	intType          = func(t types.Type) types.Type { return types.Int }
+	stringType       = func(t types.Type) types.Type { return types.String }
+	stringArrayType  = func(t types.Type) types.Type { return types.Array(types.String) }
+	stringArray2Type = func(t types.Type) types.Type { return types.Array(types.Array(types.String)) }
+	dictType         = func(t types.Type) types.Type { return types.Dict }
+	blockType        = func(t types.Type) types.Type { return types.Block }
+	dictArrayType    = func(t types.Type) types.Type { return types.Array(types.Dict) }
 )
 
 var builtinFunctions map[types.Type]map[string]compileHandler
@@ -32,15 +34,23 @@ var builtinFunctions map[types.Type]map[string]compileHandler
 func init() {
 	builtinFunctions = map[types.Type]map[string]compileHandler{
 		types.String: {
-			"contains":  {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"contains":   {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"find":       {typ: stringArray2Type, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":     {typ: intType, signature: FunctionSignature{}},
+			"camelcase":  {typ: stringType, signature: FunctionSignature{}},
+			"downcase":   {typ: stringType, signature: FunctionSignature{}},
+			"upcase":     {typ: stringType, signature: FunctionSignature{}},
+			"lines":      {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":       {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":    {typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.String, types.String}}},
+			"replaceAll": {typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.Regex, types.String}}},
+			"substr":     {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"startsWith": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"padLeft":    {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"padRight":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"format":     {compile: compileStringFormat},
 		},
 		types.Time: {
 			"seconds": {typ: intType, signature: FunctionSignature{}},
@@ -48,19 +58,54 @@ func init() {
 			"hours":   {typ: intType, signature: FunctionSignature{}},
 			"days":    {typ: intType, signature: FunctionSignature{}},
 			"unix":    {typ: intType, signature: FunctionSignature{}},
+			"format":  {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String, types.String}}},
+		},
+		types.Duration: {
+			"seconds": {typ: intType, signature: FunctionSignature{}},
+			"minutes": {typ: intType, signature: FunctionSignature{}},
+			"hours":   {typ: intType, signature: FunctionSignature{}},
+			"days":    {typ: intType, signature: FunctionSignature{}},
+			"months":  {typ: intType, signature: FunctionSignature{}},
+			"years":   {typ: intType, signature: FunctionSignature{}},
+		},
+		types.Version: {
+			"inRange": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+		},
+		types.IP: {
+			"isPrivate":     {typ: boolType, signature: FunctionSignature{}},
+			"isLoopback":    {typ: boolType, signature: FunctionSignature{}},
+			"isUnspecified": {typ: boolType, signature: FunctionSignature{}},
+			"version":       {typ: intType, signature: FunctionSignature{}},
+		},
+		types.CIDR: {
+			// contains and overlaps take IP addresses, networks, and strings
+			"contains":     {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
+			"overlaps":     {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
+			"prefixLength": {typ: intType, signature: FunctionSignature{}},
+			"version":      {typ: intType, signature: FunctionSignature{}},
+			"isPrivate":    {typ: boolType, signature: FunctionSignature{}},
+			"isLoopback":   {typ: boolType, signature: FunctionSignature{}},
 		},
 		types.Dict: {
 			"[]": {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
 			"{}": {typ: blockType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			// string-ish
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"find":       {typ: stringArray2Type, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":     {typ: intType, signature: FunctionSignature{}},
+			"camelcase":  {typ: stringType, signature: FunctionSignature{}},
+			"downcase":   {typ: stringType, signature: FunctionSignature{}},
+			"upcase":     {typ: stringType, signature: FunctionSignature{}},
+			"lines":      {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":       {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":    {typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.String, types.String}}},
+			"replaceAll": {typ: stringType, signature: FunctionSignature{Required: 2, Args: []types.Type{types.Regex, types.String}}},
+			"substr":     {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"startsWith": {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"padLeft":    {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"padRight":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.String}}},
+			"format":     {compile: compileStringFormat},
 			// array- or map-ish
 			"first":        {typ: dictType, signature: FunctionSignature{}},
 			"last":         {typ: dictType, signature: FunctionSignature{}},
@@ -74,6 +119,18 @@ func init() {
 			"none":         {compile: compileDictNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"flat":         {compile: compileDictFlat, signature: FunctionSignature{}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"sortDesc":     {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"reverse":      {typ: dictType, signature: FunctionSignature{}},
+			"take":         {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":         {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"sum":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"min":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"max":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"avg":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"median":       {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"countBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			// map-ish
 			"keys":   {typ: stringArrayType, signature: FunctionSignature{}},
 			"values": {typ: dictArrayType, signature: FunctionSignature{}},
@@ -96,13 +153,25 @@ func init() {
 			"none":         {compile: compileArrayNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"flat":         {compile: compileArrayFlat, signature: FunctionSignature{}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"sortDesc":     {compile: compileArraySort, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"reverse":      {typ: sameType, signature: FunctionSignature{}},
+			"take":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"sum":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"min":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"max":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"avg":          {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"median":       {compile: compileArrayAggregate, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"countBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 		},
 		types.MapLike: {
-			"[]":     {typ: childType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"[]":     {compile: compileMapIndex, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
 			"{}":     {typ: blockType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"length": {typ: intType, signature: FunctionSignature{}},
 			"where":  {compile: compileMapWhere, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
-			"keys":   {typ: stringArrayType, signature: FunctionSignature{}},
+			"keys":   {compile: compileMapKeys, signature: FunctionSignature{}},
 			"values": {compile: compileMapValues, signature: FunctionSignature{}},
 		},
 		types.ResourceLike: {
@@ -115,10 +184,32 @@ func init() {
 			"one":      {compile: compileResourceOne, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"none":     {compile: compileResourceNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":      {compile: compileResourceMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"sort":     {compile: compileResourceListFunction, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"sortDesc": {compile: compileResourceListFunction, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"reverse":  {compile: compileResourceListFunction, signature: FunctionSignature{}},
+			"take":     {compile: compileResourceListFunction, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":     {compile: compileResourceListFunction, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"sum":      {compile: compileResourceListFunction, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"min":      {compile: compileResourceListFunction, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"max":      {compile: compileResourceListFunction, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"avg":      {compile: compileResourceListFunction, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"median":   {compile: compileResourceListFunction, signature: FunctionSignature{Required: 0, Args: []types.Type{types.FunctionLike}}},
+			"groupBy":  {compile: compileResourceListFunction, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"countBy":  {compile: compileResourceListFunction, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 		},
 		// TODO: [#32] unique builtin fields that need a long-term support in LR
 		types.Resource("parse"): {
-			"date": {compile: compileResourceParseDate, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String, types.String}}},
+			"date": {compile: compileResourceParseDate, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String, types.String, types.String}}},
+		},
+		types.Resource("time"): {
+			"parse":  {compile: compileResourceParseDate, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String, types.String, types.String}}},
+			"second": {compile: compileResourceTimeUnit, signature: FunctionSignature{}},
+			"minute": {compile: compileResourceTimeUnit, signature: FunctionSignature{}},
+			"hour":   {compile: compileResourceTimeUnit, signature: FunctionSignature{}},
+			"day":    {compile: compileResourceTimeUnit, signature: FunctionSignature{}},
+			"week":   {compile: compileResourceTimeUnit, signature: FunctionSignature{}},
+			"month":  {compile: compileResourceTimeUnit, signature: FunctionSignature{}},
+			"year":   {compile: compileResourceTimeUnit, signature: FunctionSignature{}},
 		},
 	}
 }
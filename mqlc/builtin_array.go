@@ -180,6 +180,220 @@ func compileArrayUnique(c *compiler, typ types.Type, ref uint64, id string, call
 	return typ, nil
 }
 
+// sortableTypes are all types whose values can be ordered
+var sortableTypes = map[types.Type]struct{}{
+	types.Bool:     {},
+	types.Int:      {},
+	types.Float:    {},
+	types.String:   {},
+	types.Regex:    {},
+	types.Time:     {},
+	types.Dict:     {},
+	types.Version:  {},
+	types.Duration: {},
+}
+
+// compileArrayKeyBlock compiles the function block of calls like sort(..)
+// or sum(..), which compute one value (key) for every entry of the array.
+// It returns the args for the call, the new binding and the type of the
+// computed key.
+func compileArrayKeyBlock(c *compiler, typ types.Type, ref uint64, id string, arg *parser.Arg) ([]*llx.Primitive, uint64, types.Type, error) {
+	if arg.Name != "" {
+		return nil, 0, types.Nil, errors.New("called '" + id + "' with a named parameter, which is not supported")
+	}
+
+	refs, err := c.blockExpressions([]*parser.Expression{arg.Value}, typ, ref)
+	if err != nil {
+		return nil, 0, types.Nil, err
+	}
+	if refs.block == 0 {
+		return nil, 0, types.Nil, errors.New("called '" + id + "' without a function block")
+	}
+	if refs.isStandalone {
+		return nil, 0, types.Nil, errors.New("called '" + id + "' with a value, please provide a field or expression instead")
+	}
+
+	block := c.Result.CodeV2.Block(refs.block)
+	if len(block.Entrypoints) != 1 {
+		return nil, 0, types.Nil, errors.New("called '" + id + "' with a bad function block, you can only return 1 value")
+	}
+	keyType := c.Result.CodeV2.DereferencedBlockType(block)
+
+	args := []*llx.Primitive{
+		llx.RefPrimitiveV2(refs.binding),
+		llx.FunctionPrimitive(refs.block),
+	}
+	for _, v := range refs.deps {
+		if c.isInMyBlock(v) {
+			args = append(args, llx.RefPrimitiveV2(v))
+		}
+	}
+	c.blockDeps = append(c.blockDeps, refs.deps...)
+
+	return args, refs.binding, keyType, nil
+}
+
+func compileArraySort(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 1 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "', only 1 is supported")
+	}
+
+	// Sort is being called with 0 arguments, which means it sorts the values
+	// of the array directly. This only works for types we know how to order.
+	if call == nil || len(call.Function) == 0 {
+		ct := typ.Child()
+		if _, ok := sortableTypes[ct]; !ok {
+			return types.Nil, errors.New("cannot sort array of " + ct.Label() + ", try calling '" + id + "' with a field or expression to sort by")
+		}
+
+		c.addChunk(&llx.Chunk{
+			Call: llx.Chunk_FUNCTION,
+			Id:   id,
+			Function: &llx.Function{
+				Type:    string(typ),
+				Binding: ref,
+			},
+		})
+		return typ, nil
+	}
+
+	args, binding, keyType, err := compileArrayKeyBlock(c, typ, ref, id, call.Function[0])
+	if err != nil {
+		return types.Nil, err
+	}
+	if _, ok := sortableTypes[keyType]; !ok {
+		return types.Nil, errors.New("cannot sort by values of type " + keyType.Label() + " when calling '" + id + "'")
+	}
+
+	fieldId := "fieldSort"
+	if id == "sortDesc" {
+		fieldId = "fieldSortDesc"
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   fieldId,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: binding,
+			Args:    args,
+		},
+	})
+	return typ, nil
+}
+
+// aggregateType returns the type of an aggregation over values of the given
+// type. Ints stay ints when they are summed up or compared, but averages
+// and medians are always floats.
+func aggregateType(id string, typ types.Type) (types.Type, bool) {
+	switch typ {
+	case types.Int:
+		if id == "avg" || id == "median" {
+			return types.Float, true
+		}
+		return types.Int, true
+	case types.Float, types.Time, types.Dict:
+		return typ, true
+	case types.Version, types.Duration:
+		return typ, id == "min" || id == "max"
+	default:
+		return types.Nil, false
+	}
+}
+
+var fieldAggregations = map[string]string{
+	"sum":    "fieldSum",
+	"min":    "fieldMin",
+	"max":    "fieldMax",
+	"avg":    "fieldAvg",
+	"median": "fieldMedian",
+}
+
+func compileArrayAggregate(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 1 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "', only 1 is supported")
+	}
+
+	if call == nil || len(call.Function) == 0 {
+		resType, ok := aggregateType(id, typ.Child())
+		if !ok {
+			return types.Nil, errors.New("cannot compute '" + id + "' of array of " + typ.Child().Label() + ", try calling '" + id + "' with a field or expression")
+		}
+
+		c.addChunk(&llx.Chunk{
+			Call: llx.Chunk_FUNCTION,
+			Id:   id,
+			Function: &llx.Function{
+				Type:    string(resType),
+				Binding: ref,
+			},
+		})
+		return resType, nil
+	}
+
+	args, binding, keyType, err := compileArrayKeyBlock(c, typ, ref, id, call.Function[0])
+	if err != nil {
+		return types.Nil, err
+	}
+	resType, ok := aggregateType(id, keyType)
+	if !ok {
+		return types.Nil, errors.New("cannot compute '" + id + "' of values of type " + keyType.Label())
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   fieldAggregations[id],
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: binding,
+			Args:    args,
+		},
+	})
+	return resType, nil
+}
+
+// groupKeyType is the key type of the maps that groupBy and countBy return.
+// Maps only have string or int keys, so keys of all other types are turned
+// into their string value.
+func groupKeyType(keyType types.Type) types.Type {
+	if keyType == types.Int {
+		return types.Int
+	}
+	return types.String
+}
+
+// compileArrayGroupBy compiles groupBy and countBy calls. Their results are
+// maps keyed by the computed key, see groupKeyType.
+func compileArrayGroupBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 1 {
+		return types.Nil, errors.New("function '" + id + "' needs exactly one argument")
+	}
+
+	args, binding, keyType, err := compileArrayKeyBlock(c, typ, ref, id, call.Function[0])
+	if err != nil {
+		return types.Nil, err
+	}
+	if _, ok := sortableTypes[keyType]; !ok {
+		return types.Nil, errors.New("cannot group by values of type " + keyType.Label() + " when calling '" + id + "'")
+	}
+
+	resType := types.Map(groupKeyType(keyType), typ)
+	if id == "countBy" {
+		resType = types.Map(groupKeyType(keyType), types.Int)
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: binding,
+			Args:    args,
+		},
+	})
+	return resType, nil
+}
+
 func compileArrayContains(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
 	_, err := compileArrayWhere(c, typ, ref, "where", call)
 	if err != nil {
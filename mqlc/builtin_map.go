@@ -478,6 +478,29 @@ func compileMapWhere(c *compiler, typ types.Type, ref uint64, id string, call *p
 	return typ, nil
 }
 
+// compileMapIndex compiles accessors like m['key'], whose key must have the
+// key type of the map
+func compileMapIndex(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	h := &compileHandler{
+		typ:       childType,
+		signature: FunctionSignature{Required: 1, Args: []types.Type{typ.Key()}},
+	}
+	return c.compileBuiltinFunction(h, id, &variable{typ: typ, ref: ref}, call)
+}
+
+func compileMapKeys(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	typ = types.Array(typ.Key())
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: ref,
+		},
+	})
+	return typ, nil
+}
+
 func compileMapValues(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
 	typ = types.Array(typ.Child())
 	c.addChunk(&llx.Chunk{
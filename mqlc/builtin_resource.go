@@ -463,6 +463,32 @@ func compileResourceLength(c *compiler, typ types.Type, ref uint64, id string, c
 	return typ, nil
 }
 
+// compileResourceListFunction compiles array functions on list resources.
+// It retrieves the list of the resource and calls the array function on it.
+func compileResourceListFunction(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	_, err := listResource(c, typ)
+	if err != nil {
+		return types.Nil, errors.New("failed to compile " + id + ": " + err.Error())
+	}
+
+	h, ok := builtinFunctions[types.ArrayLike][id]
+	if !ok {
+		return types.Nil, errors.New("cannot find function '" + id + "' for list resource " + typ.Label())
+	}
+
+	listType, err := compileResourceDefault(c, typ, ref, "list", nil)
+	if err != nil {
+		return listType, err
+	}
+
+	return c.compileBuiltinFunction(&h, id, &variable{
+		typ: listType,
+		ref: c.tailRef(),
+	}, call)
+}
+
+// compileResourceParseDate parses a time from a string with an optional
+// format and timezone, e.g. parse.date or time.parse
 func compileResourceParseDate(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
 	if call == nil {
 		return types.Nil, errors.New("missing arguments to parse date")
@@ -474,9 +500,10 @@ func compileResourceParseDate(c *compiler, typ types.Type, ref uint64, id string
 		Args: []*resources.TypedArg{
 			{Name: "value", Type: string(types.String)},
 			{Name: "format", Type: string(types.String)},
+			{Name: "timezone", Type: string(types.String)},
 		},
 	}
-	args, err := c.unnamedArgs("parse."+id, init, call.Function)
+	args, err := c.unnamedArgs(typ.ResourceName()+"."+id, init, call.Function)
 	if err != nil {
 		return types.Nil, err
 	}
@@ -501,3 +528,21 @@ func compileResourceParseDate(c *compiler, typ types.Type, ref uint64, id string
 	})
 	return types.Time, nil
 }
+
+// compileResourceTimeUnit compiles the units of the time resource, e.g.
+// time.day or time.month, which are durations
+func compileResourceTimeUnit(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("time." + id + " does not take any arguments")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   string(typ) + "." + id,
+		Function: &llx.Function{
+			Type:    string(types.Duration),
+			Binding: ref,
+		},
+	})
+	return types.Duration, nil
+}
@@ -99,3 +99,36 @@ func compileStringContains(c *compiler, typ types.Type, ref uint64, id string, c
 		return types.Nil, errors.New("cannot find #string.contains with this type " + types.Type(val.Type).Label())
 	}
 }
+
+// compileStringFormat compiles calls to format, which take any number of
+// arguments of any type
+func compileStringFormat(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	var args []*llx.Primitive
+	if call != nil {
+		for i := range call.Function {
+			arg := call.Function[i]
+			if arg.Name != "" {
+				return types.Nil, errors.New("called '" + id + "' with a named parameter, which is not supported")
+			}
+
+			x, err := c.compileExpression(arg.Value)
+			if err != nil {
+				return types.Nil, err
+			}
+			if x != nil {
+				args = append(args, x)
+			}
+		}
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.String),
+			Binding: ref,
+			Args:    args,
+		},
+	})
+	return types.String, nil
+}
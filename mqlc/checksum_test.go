@@ -157,3 +157,50 @@ func TestSwitchChecksumming(t *testing.T) {
 
 	require.Equal(t, len(checksums), len(queries))
 }
+
+func TestStringChecksumming(t *testing.T) {
+	checksum := func(q string) string {
+		res, err := Compile(q, nil, conf)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		checksum := res.CodeV2.Checksums[res.CodeV2.TailRef(1<<32)]
+		rechecksum := res.CodeV2.Blocks[0].LastChunk().ChecksumV2(1<<32, res.CodeV2)
+		require.Equal(t, checksum, rechecksum)
+		return checksum
+	}
+
+	t.Run("escaped quotes and raw strings", func(t *testing.T) {
+		require.Equal(t, checksum(`'a"b'`), checksum(`"a\"b"`))
+		require.Equal(t, checksum(`'single\'s'`), checksum(`"single's"`))
+		require.Equal(t, checksum(`'a\\'`), checksum(`"a\\\\"`))
+		require.Equal(t, checksum(`'a\nb'`), checksum("`a\\nb`"))
+		require.NotEqual(t, checksum(`"a\nb"`), checksum("`a\\nb`"))
+	})
+
+	t.Run("interpolation", func(t *testing.T) {
+		require.Equal(t, checksum(`"a ${'b'}"`), checksum(`"a b"`))
+		require.Equal(t, checksum(`"a ${'b'.upcase}"`), checksum(`"a " + 'b'.upcase`))
+		require.Equal(t, checksum(`"a \${b}"`), checksum(`'a ${b}'`))
+		require.Equal(t, checksum(`"x ${ "y" } z"`), checksum(`"x y z"`))
+		require.Equal(t, checksum(`"a ${'}'}"`), checksum(`"a }"`))
+		require.Equal(t, checksum(`"a ${"${'b'}"}"`), checksum(`"a b"`))
+		require.Equal(t, checksum(`"a ${'${b}'} ${'c'.upcase}"`), checksum(`"a \${b} " + 'c'.upcase`))
+
+		queries := []string{
+			`"a ${'b'.upcase}"`,
+			`"a ${'c'.upcase}"`,
+			`"b ${'b'.upcase}"`,
+			`"a ${'b'.upcase} c"`,
+			`"a ${1}"`,
+			`"a ${2}"`,
+			`"a ${ {a:1}['a'] }"`,
+			`"a ${ {a:2}['a'] }"`,
+		}
+		checksums := map[string]struct{}{}
+		for _, q := range queries {
+			checksums[checksum(q)] = struct{}{}
+		}
+		require.Equal(t, len(queries), len(checksums))
+	})
+}
@@ -0,0 +1,139 @@
+package mqlc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alecthomas/participle/lexer"
+	"go.mondoo.com/cnquery/mqlc/parser"
+)
+
+// CompileError is an error in MQL code, which knows where in the code it
+// happened. Its message is the same as the error it wraps.
+type CompileError struct {
+	Err error
+	// Pos in the code where the error happened, it is empty if unknown
+	Pos lexer.Position
+	// Length of the code that caused the error
+	Length int
+	// Source is the code that was compiled
+	Source string
+}
+
+func (e *CompileError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CompileError) Unwrap() error {
+	return e.Err
+}
+
+// Excerpt of the code with a caret that points to the error. It is empty
+// if we don't know where the error happened.
+func (e *CompileError) Excerpt() string {
+	if e.Pos.Line == 0 || e.Source == "" {
+		return ""
+	}
+	return Excerpt("", e.Source, e.Pos.Offset, e.Length)
+}
+
+// Excerpt renders the line of the source that contains the offset and marks
+// length bytes of it with carets. If a name is given, e.g. a filename,
+// it is printed with the line and column on top:
+//
+//	 --> example.mql.yaml:3:21
+//	  |
+//	3 |   mql: users.list { nope }
+//	  |                     ^^^^
+func Excerpt(name string, source string, offset int, length int) string {
+	if offset > len(source) {
+		offset = len(source)
+	}
+	start := strings.LastIndexByte(source[:offset], '\n') + 1
+	end := strings.IndexByte(source[offset:], '\n')
+	if end == -1 {
+		end = len(source)
+	} else {
+		end += offset
+	}
+	if offset+length > end {
+		length = end - offset
+	}
+
+	line := strconv.Itoa(strings.Count(source[:offset], "\n") + 1)
+	pad := strings.Repeat(" ", len(line))
+
+	// keep tabs so that the caret lines up with the code
+	var indent strings.Builder
+	for _, r := range source[start:offset] {
+		if r == '\t' {
+			indent.WriteByte('\t')
+		} else {
+			indent.WriteByte(' ')
+		}
+	}
+	carets := utf8.RuneCountInString(source[offset : offset+length])
+	if carets < 1 {
+		carets = 1
+	}
+
+	var res strings.Builder
+	if name != "" {
+		column := utf8.RuneCountInString(source[start:offset]) + 1
+		res.WriteString(pad + "--> " + name + ":" + line + ":" + strconv.Itoa(column) + "\n")
+	}
+	res.WriteString(pad + " |\n")
+	res.WriteString(line + " | " + source[start:end] + "\n")
+	res.WriteString(pad + " | " + indent.String() + strings.Repeat("^", carets))
+	return res.String()
+}
+
+// cursor points to the node of the AST that is currently compiled, so that
+// errors and diagnostics can point to it
+type cursor struct {
+	ast  *parser.AST
+	node interface{}
+}
+
+// error adds the position of the current node to the error
+func (c *cursor) error(err error) error {
+	if _, ok := err.(*CompileError); ok {
+		return err
+	}
+
+	res := &CompileError{Err: err}
+	if span, ok := c.ast.Span(c.node); ok {
+		res.Pos = span.Start
+	}
+	return res
+}
+
+// at remembers the node that is currently compiled
+func (c *compiler) at(node interface{}) {
+	if c.cursor != nil {
+		c.cursor.node = node
+	}
+}
+
+var reToken = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*`)
+
+// sourceError adds the source code to a compile or syntax error, so that
+// it can be shown to users
+func sourceError(err error, source string) error {
+	res, ok := err.(*CompileError)
+	if !ok {
+		res = &CompileError{Err: err}
+		res.Pos, _ = parser.ErrorPosition(err)
+	}
+
+	res.Source = source
+	if res.Pos.Line != 0 && res.Pos.Offset <= len(source) {
+		res.Length = len(reToken.FindString(source[res.Pos.Offset:]))
+		if res.Length == 0 {
+			res.Length = 1
+		}
+	}
+	return res
+}
@@ -0,0 +1,72 @@
+package mqlc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/mqlc/parser"
+)
+
+func TestCompileError(t *testing.T) {
+	tests := []struct {
+		code   string
+		line   int
+		column int
+		length int
+	}{
+		{"users.list { nope }", 1, 14, 4},
+		{"x = 1\nusers.list { x +  nope }", 2, 19, 4},
+		{"nope", 1, 1, 4},
+		{"users.where(", 1, 13, 1},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.code, func(t *testing.T) {
+			_, err := Compile(cur.code, nil, conf)
+			var compileErr *CompileError
+			require.True(t, errors.As(err, &compileErr), "expected a CompileError, got: %v", err)
+			assert.Equal(t, cur.line, compileErr.Pos.Line)
+			assert.Equal(t, cur.column, compileErr.Pos.Column)
+			assert.Equal(t, cur.length, compileErr.Length)
+			assert.Equal(t, cur.code, compileErr.Source)
+		})
+	}
+
+	t.Run("errors keep their message and type", func(t *testing.T) {
+		_, err := Compile("users.where(", nil, conf)
+		var incomplete *parser.ErrIncomplete
+		assert.True(t, errors.As(err, &incomplete))
+		assert.Equal(t, incomplete.Error(), err.Error())
+	})
+
+	t.Run("excerpt", func(t *testing.T) {
+		_, err := Compile("x = 1\nusers.list { nope }", nil, conf)
+		var compileErr *CompileError
+		require.True(t, errors.As(err, &compileErr))
+		assert.Equal(t, ""+
+			"  |\n"+
+			"2 | users.list { nope }\n"+
+			"  |              ^^^^",
+			compileErr.Excerpt())
+	})
+}
+
+func TestExcerpt(t *testing.T) {
+	source := "a\n\tb c\nd"
+	assert.Equal(t, ""+
+		" --> query.mql:2:4\n"+
+		"  |\n"+
+		"2 | \tb c\n"+
+		"  | \t  ^",
+		Excerpt("query.mql", source, 5, 1))
+
+	// the caret never goes past the end of the line
+	assert.Equal(t, ""+
+		"  |\n"+
+		"3 | d\n"+
+		"  |  ^",
+		Excerpt("", source, len(source), 10))
+}
@@ -0,0 +1,340 @@
+package mqlc
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// Cost is a rough class of how expensive it is to fetch a resource or
+// field, e.g. reading a file is more expensive than looking up its path.
+// It is declared in the manifest of resource packs.
+type Cost int
+
+const (
+	CostLow Cost = iota
+	CostMedium
+	CostHigh
+)
+
+var costNames = map[Cost]string{
+	CostLow:    "low",
+	CostMedium: "medium",
+	CostHigh:   "high",
+}
+
+func (c Cost) String() string {
+	if name, ok := costNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func (c Cost) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// ParseCost from its name, e.g. low, medium, or high
+func ParseCost(name string) (Cost, error) {
+	for cost, cur := range costNames {
+		if cur == name {
+			return cost, nil
+		}
+	}
+	return CostLow, errors.New("unknown cost '" + name + "', expected low, medium, or high")
+}
+
+// Kinds of steps in a query plan
+const (
+	// StepResource creates a resource
+	StepResource = "resource"
+	// StepField fetches the field of a resource
+	StepField = "field"
+	// StepExpand runs a block for every item of a list
+	StepExpand = "expand"
+	// StepBlock runs a block once
+	StepBlock = "block"
+	// StepFunction calls a builtin function or operator
+	StepFunction = "function"
+)
+
+// PlanStep is a chunk of compiled code that will be executed
+type PlanStep struct {
+	// Ref of the chunk, i.e. <block>.<chunk>
+	Ref  string `json:"ref"`
+	Kind string `json:"kind"`
+	// Id of the resource, field, or function, e.g. file.content
+	Id   string `json:"id"`
+	Type string `json:"type"`
+	// Deps are the refs of the steps this step needs
+	Deps []string `json:"deps,omitempty"`
+	// Block that this step runs, for expansions and blocks
+	Block int `json:"block,omitempty"`
+	// Cost that is declared for the resource or field
+	Cost Cost `json:"cost"`
+	// Expansions is the number of list expansions around this step,
+	// i.e. how many nested lists it runs for
+	Expansions int `json:"expansions,omitempty"`
+}
+
+// EstimatedCost of the step, which grows with every list that it runs
+// for, unless it is cheap to begin with
+func (s *PlanStep) EstimatedCost() Cost {
+	if s.Cost == CostLow {
+		return CostLow
+	}
+	res := s.Cost + Cost(s.Expansions)
+	if res > CostHigh {
+		res = CostHigh
+	}
+	return res
+}
+
+// Plan explains how compiled code will be executed
+type Plan struct {
+	// Cost is the highest estimated cost of all steps
+	Cost  Cost        `json:"cost"`
+	Steps []*PlanStep `json:"steps"`
+}
+
+// Explain the steps of compiled code: which resources and fields it fetches,
+// which lists it expands, how steps depend on each other, and how expensive
+// they are. Primitive values aren't steps, since they are free.
+func Explain(bundle *llx.CodeBundle, schema llx.Schema) *Plan {
+	e := explainer{
+		code:    bundle.CodeV2,
+		schema:  schema,
+		callers: map[uint64]uint64{},
+		depth:   map[uint64]int{},
+	}
+	return e.explain()
+}
+
+type explainer struct {
+	code   *llx.CodeV2
+	schema llx.Schema
+	// callers maps blocks to the ref of the chunk that runs them
+	callers map[uint64]uint64
+	// depth of list expansions for every block
+	depth map[uint64]int
+}
+
+func (e *explainer) explain() *Plan {
+	res := &Plan{}
+	if e.code == nil {
+		return res
+	}
+
+	// blocks are always added after the chunk that runs them, so we
+	// know the callers of a block before we get to it
+	for i := range e.code.Blocks {
+		blockRef := uint64(i+1) << 32
+		block := e.code.Blocks[i]
+		for j := range block.Chunks {
+			chunk := block.Chunks[j]
+			if chunk.Call == llx.Chunk_PRIMITIVE {
+				continue
+			}
+
+			step := e.step(blockRef|uint64(j+1), chunk)
+			res.Steps = append(res.Steps, step)
+			if cost := step.EstimatedCost(); cost > res.Cost {
+				res.Cost = cost
+			}
+		}
+	}
+
+	return res
+}
+
+func (e *explainer) step(ref uint64, chunk *llx.Chunk) *PlanStep {
+	res := &PlanStep{
+		Ref:        refLabel(ref),
+		Kind:       StepFunction,
+		Id:         functionName(chunk.Id),
+		Type:       chunk.Type().Label(),
+		Expansions: e.depth[ref>>32],
+	}
+
+	if chunk.Function == nil || chunk.Function.Binding == 0 {
+		if resource := e.schema.Lookup(chunk.Id); resource != nil {
+			res.Kind = StepResource
+			res.Cost = resourceCost(resource, "")
+		}
+	} else {
+		res.addDep(e.resolve(chunk.Function.Binding))
+		bound := e.code.Chunk(chunk.Function.Binding).Type()
+		if bound.IsResource() {
+			if resource := e.schema.Lookup(bound.ResourceName()); resource != nil {
+				if _, ok := resource.Fields[chunk.Id]; ok {
+					res.Kind = StepField
+					res.Id = resource.Id + "." + chunk.Id
+					res.Cost = resourceCost(resource, chunk.Id)
+				}
+			}
+		}
+	}
+
+	if chunk.Function == nil {
+		return res
+	}
+
+	for _, arg := range chunk.Function.Args {
+		typ := types.Type(arg.Type)
+		if typ == types.Ref {
+			if dep, ok := arg.RefV2(); ok {
+				res.addDep(e.resolve(dep))
+			}
+			continue
+		}
+		if typ.Underlying() != types.FunctionLike {
+			continue
+		}
+
+		blockRef, ok := arg.RefV2()
+		if !ok {
+			continue
+		}
+		block := blockRef >> 32
+		res.Block = int(block)
+		e.callers[block] = ref
+		e.depth[block] = e.depth[ref>>32]
+
+		// we run the block for every item if it is bound to a list, or to a
+		// resource which is a list, e.g. users.where(..)
+		if chunk.Function.Binding == 0 || res.Kind == StepField {
+			continue
+		}
+		bound := e.code.Chunk(chunk.Function.Binding).Type()
+		if bound.IsArray() || bound.IsMap() || e.isListResource(bound) {
+			res.Kind = StepExpand
+			e.depth[block]++
+		} else if res.Kind == StepFunction {
+			res.Kind = StepBlock
+		}
+	}
+
+	return res
+}
+
+func (s *PlanStep) addDep(ref uint64, ok bool) {
+	if !ok {
+		return
+	}
+	label := refLabel(ref)
+	for i := range s.Deps {
+		if s.Deps[i] == label {
+			return
+		}
+	}
+	s.Deps = append(s.Deps, label)
+}
+
+// resolve the chunk that a ref depends on: variables are resolved to the
+// chunk they point to, and the parameters of a block to the chunk that runs
+// it. Literal values are no dependency.
+func (e *explainer) resolve(ref uint64) (uint64, bool) {
+	chunk := e.code.Chunk(ref)
+	if chunk == nil {
+		return 0, false
+	}
+	if chunk.Call != llx.Chunk_PRIMITIVE {
+		return ref, true
+	}
+
+	if types.Type(chunk.Primitive.Type) == types.Ref {
+		if target, ok := chunk.Primitive.RefV2(); ok && target != ref {
+			return e.resolve(target)
+		}
+		return 0, false
+	}
+
+	block := e.code.Block(ref)
+	if block != nil && uint32(ref) <= uint32(block.Parameters) {
+		if caller, ok := e.callers[ref>>32]; ok {
+			return caller, true
+		}
+	}
+	return 0, false
+}
+
+func (e *explainer) isListResource(typ types.Type) bool {
+	if !typ.IsResource() {
+		return false
+	}
+	resource := e.schema.Lookup(typ.ResourceName())
+	return resource != nil && resource.ListType != ""
+}
+
+// resourceCost is the cost that is declared for a resource or one of its
+// fields in the schema. Fields without a cost of their own have the cost of
+// their resource.
+func resourceCost(resource *resources.ResourceInfo, field string) Cost {
+	name := resource.Cost
+	if f := resource.Fields[field]; field != "" && f != nil && f.Cost != "" {
+		name = f.Cost
+	}
+	if name == "" {
+		return CostLow
+	}
+
+	res, err := ParseCost(name)
+	if err != nil {
+		return CostLow
+	}
+	return res
+}
+
+// functionName removes the type signature from the IDs of operators, e.g. ==
+func functionName(id string) string {
+	for i := 0; i < len(id); i++ {
+		if id[i] < ' ' {
+			return id[:i]
+		}
+	}
+	return id
+}
+
+func refLabel(ref uint64) string {
+	return strconv.FormatUint(ref>>32, 10) + "." + strconv.FormatUint(ref&0xFFFFFFFF, 10)
+}
+
+// String renders the plan for people, with one step per line
+func (p *Plan) String() string {
+	var res strings.Builder
+	res.WriteString("estimated cost: " + p.Cost.String() + "\n")
+
+	block := ""
+	for _, step := range p.Steps {
+		if cur := step.Ref[:strings.IndexByte(step.Ref, '.')]; cur != block {
+			block = cur
+			res.WriteString("\nblock " + block + "\n")
+		}
+
+		res.WriteString("  " + step.Ref + "  " + step.Kind + strings.Repeat(" ", 9-len(step.Kind)) + step.Id)
+		if step.Type != "" && step.Type != step.Id {
+			res.WriteString(" (" + step.Type + ")")
+		}
+		if len(step.Deps) != 0 {
+			res.WriteString(" <- " + strings.Join(step.Deps, ", "))
+		}
+		switch step.Kind {
+		case StepExpand:
+			res.WriteString(", runs block " + strconv.Itoa(step.Block) + " for every item")
+		case StepBlock:
+			res.WriteString(", runs block " + strconv.Itoa(step.Block))
+		}
+		if cost := step.EstimatedCost(); cost != CostLow {
+			res.WriteString(", cost: " + cost.String())
+			if cost != step.Cost {
+				res.WriteString(" (" + step.Cost.String() + " per item)")
+			}
+		}
+		res.WriteString("\n")
+	}
+	return res.String()
+}
@@ -0,0 +1,77 @@
+package mqlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/resources"
+)
+
+func TestExplain(t *testing.T) {
+	explain := func(code string) *Plan {
+		bundle, err := Compile(code, nil, conf)
+		require.NoError(t, err)
+		return Explain(bundle, conf.Schema)
+	}
+
+	t.Run("list expansion", func(t *testing.T) {
+		plan := explain(`files.find(from: "/").list { content }`)
+		assert.Equal(t, CostHigh, plan.Cost)
+		assert.Equal(t, []*PlanStep{
+			{Ref: "1.1", Kind: StepResource, Id: "files.find", Type: "files.find"},
+			{Ref: "1.2", Kind: StepField, Id: "files.find.list", Type: "[]file", Deps: []string{"1.1"}, Cost: CostHigh},
+			{Ref: "1.3", Kind: StepExpand, Id: "{}", Type: "[]block", Deps: []string{"1.2"}, Block: 2},
+			{Ref: "2.2", Kind: StepField, Id: "file.content", Type: "string", Deps: []string{"1.3"}, Cost: CostMedium, Expansions: 1},
+		}, plan.Steps)
+	})
+
+	t.Run("blocks and variables", func(t *testing.T) {
+		plan := explain("x = sshd.config\nx { params['A'] == 'b' }")
+		assert.Equal(t, CostLow, plan.Cost)
+		require.Len(t, plan.Steps, 5)
+		assert.Equal(t, StepBlock, plan.Steps[1].Kind)
+		assert.Equal(t, []string{"1.1"}, plan.Steps[1].Deps)
+		assert.Equal(t, "sshd.config.params", plan.Steps[2].Id)
+		assert.Equal(t, 0, plan.Steps[2].Expansions)
+		assert.Equal(t, "==", plan.Steps[4].Id)
+	})
+
+	t.Run("cheap fields stay cheap in lists", func(t *testing.T) {
+		plan := explain("users.where(name == 'root').list { uid }")
+		assert.Equal(t, CostLow, plan.Cost)
+		assert.Equal(t, StepExpand, plan.Steps[2].Kind)
+	})
+
+	t.Run("costs come from the schema", func(t *testing.T) {
+		bundle, err := Compile("file('/etc/hosts').size", nil, conf)
+		require.NoError(t, err)
+		schema := &resources.Schema{Resources: map[string]*resources.ResourceInfo{
+			"file": {Id: "file", Cost: "high", Fields: map[string]*resources.Field{
+				"size": {Name: "size"},
+			}},
+		}}
+		plan := Explain(bundle, schema)
+		assert.Equal(t, CostHigh, plan.Cost)
+		assert.Equal(t, CostLow, Explain(bundle, conf.Schema).Cost)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, ""+
+			"estimated cost: medium\n"+
+			"\n"+
+			"block 1\n"+
+			"  1.1  resource file\n"+
+			"  1.2  field    file.content (string) <- 1.1, cost: medium\n",
+			explain("file('/etc/hosts').content").String())
+	})
+}
+
+func TestParseCost(t *testing.T) {
+	cost, err := ParseCost("medium")
+	require.NoError(t, err)
+	assert.Equal(t, CostMedium, cost)
+
+	_, err = ParseCost("cheap")
+	assert.Error(t, err)
+}
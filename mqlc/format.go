@@ -0,0 +1,105 @@
+package mqlc
+
+import (
+	"errors"
+	"math"
+	"reflect"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc/parser"
+)
+
+// ErrFormatChangedCode is returned if formatting would change what a query
+// does. This is always a bug in the formatter.
+var ErrFormatChangedCode = errors.New("formatting would change the compiled code")
+
+// Format the code into canonical MQL, which keeps all comments. If the code
+// compiles, its formatted version must compile to the same code ID. Code
+// that doesn't compile, e.g. because its properties aren't known, must
+// parse into the same syntax tree, apart from its comments.
+func Format(input string, props map[string]*llx.Primitive, conf compilerConfig) (string, error) {
+	ast, err := parser.Parse(input)
+	if err != nil {
+		return "", sourceError(err, input)
+	}
+	res := parser.Format(ast)
+
+	again, err := parser.Parse(res)
+	if err != nil || parser.Format(again) != res {
+		return "", ErrFormatChangedCode
+	}
+
+	if conf.Schema != nil {
+		if original, err := Compile(input, props, conf); err == nil {
+			formatted, err := Compile(res, props, conf)
+			if err != nil || formatted.CodeV2.Id != original.CodeV2.Id {
+				return "", ErrFormatChangedCode
+			}
+			return res, nil
+		}
+	}
+
+	if !sameSyntax(reflect.ValueOf(ast.Expressions), reflect.ValueOf(again.Expressions)) {
+		return "", ErrFormatChangedCode
+	}
+	return res, nil
+}
+
+// sameSyntax compares two parts of a syntax tree and ignores their comments
+func sameSyntax(a reflect.Value, b reflect.Value) bool {
+	if a.Kind() != b.Kind() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return sameSyntax(a.Elem(), b.Elem())
+
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !sameSyntax(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			other := b.MapIndex(iter.Key())
+			if !other.IsValid() || !sameSyntax(iter.Value(), other) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Struct:
+		typ := a.Type()
+		for i := 0; i < a.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() || field.Name == "Comments" {
+				continue
+			}
+			if !sameSyntax(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Float32, reflect.Float64:
+		x, y := a.Float(), b.Float()
+		return x == y || math.IsNaN(x) && math.IsNaN(y)
+
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
@@ -0,0 +1,54 @@
+package mqlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		code string
+		res  string
+	}{
+		{"mondoo.version=='1'", `mondoo.version == "1"`},
+		{"users.where(uid==0) {name\nuid}", "users.where(uid == 0) {\n  name\n  uid\n}"},
+		{"  # only root\n  users.all(name=='root') // really", "// only root\nusers.all(name == \"root\") // really"},
+		{"if (mondoo.version=='1') {1}\nelse {2}", "if (mondoo.version == \"1\") { 1 } else { 2 }"},
+		{"x = 1; [x, 2]", "x = 1;\n[x, 2]"},
+		{"switch { case 1 > 2: 'a'; default: 'b' }", "switch {\ncase 1 > 2:\n  \"a\";\ndefault:\n  \"b\"\n}"},
+		{"\"${ mondoo.version }\" == 'x'", "\"${mondoo.version}\" == \"x\""},
+		// doesn't compile without its properties, but can be formatted
+		{"props.min>1", "props.min > 1"},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.code, func(t *testing.T) {
+			res, err := Format(cur.code, nil, conf)
+			require.NoError(t, err)
+			assert.Equal(t, cur.res, res)
+
+			if original, err := Compile(cur.code, nil, conf); err == nil {
+				formatted, err := Compile(res, nil, conf)
+				require.NoError(t, err)
+				assert.Equal(t, original.CodeV2.Id, formatted.CodeV2.Id)
+			}
+		})
+	}
+
+	t.Run("with properties", func(t *testing.T) {
+		props := map[string]*llx.Primitive{"min": {Type: string(types.Int)}}
+		res, err := Format("props.min>1", props, conf)
+		require.NoError(t, err)
+		assert.Equal(t, "props.min > 1", res)
+	})
+
+	t.Run("syntax errors", func(t *testing.T) {
+		_, err := Format("users.where(", nil, conf)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,297 @@
+package mqlc
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc/parser"
+	"go.mondoo.com/cnquery/types"
+)
+
+// function is a user-defined function. It is inlined wherever it is called,
+// which means that calling it produces the same code (and checksums) as
+// writing out its body by hand.
+type function struct {
+	*parser.Function
+	params []types.Type
+	// scope in which the function was defined
+	scope *varmap
+}
+
+var typeLabels = map[string]types.Type{
+	"any":      types.Any,
+	"bool":     types.Bool,
+	"int":      types.Int,
+	"float":    types.Float,
+	"string":   types.String,
+	"regex":    types.Regex,
+	"time":     types.Time,
+	"version":  types.Version,
+	"duration": types.Duration,
+	"ip":       types.IP,
+	"cidr":     types.CIDR,
+	"dict":     types.Dict,
+	"score":    types.Score,
+}
+
+// parseTypeLabel turns a type label like []string or map[string]int into
+// its type. Resources are looked up in the schema.
+func parseTypeLabel(schema llx.Schema, label string) (types.Type, error) {
+	if strings.HasPrefix(label, "[]") {
+		child, err := parseTypeLabel(schema, label[2:])
+		if err != nil {
+			return types.Nil, err
+		}
+		return types.Array(child), nil
+	}
+
+	if strings.HasPrefix(label, "map[") {
+		depth := 0
+		for i := 3; i < len(label); i++ {
+			switch label[i] {
+			case '[':
+				depth++
+			case ']':
+				depth--
+			}
+			if depth != 0 {
+				continue
+			}
+
+			key, err := parseTypeLabel(schema, label[4:i])
+			if err != nil {
+				return types.Nil, err
+			}
+			value, err := parseTypeLabel(schema, label[i+1:])
+			if err != nil {
+				return types.Nil, err
+			}
+			return types.Map(key, value), nil
+		}
+		return types.Nil, errors.New("missing closing ']' in type '" + label + "'")
+	}
+
+	if typ, ok := typeLabels[label]; ok {
+		return typ, nil
+	}
+
+	if schema.Lookup(label) != nil {
+		return types.Resource(label), nil
+	}
+
+	return types.Nil, errors.New("unknown type '" + label + "'")
+}
+
+// defineFunction makes a user-defined function available in the current scope
+func (c *compiler) defineFunction(fun *parser.Function) error {
+	name := fun.Name
+	if _, ok := operatorsCompilers[name]; ok || name == "props" || name == "_" || name == "return" {
+		return errors.New("cannot define function '" + name + "', it is a reserved keyword")
+	}
+	if c.Schema.Lookup(name) != nil {
+		return errors.New("cannot define function '" + name + "', there is already a resource with this name")
+	}
+	if v, ok := c.vars.vars[name]; ok && v.fn != nil {
+		return errors.New("function '" + name + "' is already defined")
+	}
+
+	res := &function{
+		Function: fun,
+		params:   make([]types.Type, len(fun.Params)),
+		scope:    c.vars,
+	}
+	for i := range fun.Params {
+		param := fun.Params[i]
+		for j := 0; j < i; j++ {
+			if fun.Params[j].Name == param.Name {
+				return errors.New("parameter '" + param.Name + "' is defined more than once in function '" + name + "'")
+			}
+		}
+
+		typ, err := parseTypeLabel(c.Schema, param.Type)
+		if err != nil {
+			return errors.New("failed to define function '" + name + "': " + err.Error() + " for parameter '" + param.Name + "'")
+		}
+		res.params[i] = typ
+	}
+
+	c.vars.add(name, variable{
+		name: name,
+		typ:  types.Nil,
+		fn:   res,
+	})
+	return nil
+}
+
+// isInlining returns true if we are currently compiling the body of the
+// given function, which is used to prevent endless recursions
+func (c *compiler) isInlining(name string) bool {
+	for cur := c; cur != nil; cur = cur.parent {
+		for i := range cur.inlining {
+			if cur.inlining[i] == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compileFunctionCall inlines a user-defined function: all arguments are
+// compiled and then bound to the function's parameters for its body
+func (c *compiler) compileFunctionCall(fun *function, call *parser.Call) (types.Type, error) {
+	if c.isInlining(fun.Name) {
+		return types.Nil, errors.New("function '" + fun.Name + "' cannot call itself recursively")
+	}
+	c.lintFunctionCall(fun.Function)
+
+	var args []*parser.Arg
+	if call != nil {
+		args = call.Function
+	}
+	if len(args) != len(fun.Params) {
+		return types.Nil, errors.New("function '" + fun.Name + "' expects " + strconv.Itoa(len(fun.Params)) +
+			" arguments, got " + strconv.Itoa(len(args)))
+	}
+
+	values := make([]*parser.Expression, len(fun.Params))
+	for i := range args {
+		arg := args[i]
+		idx := i
+		if arg.Name != "" {
+			idx = -1
+			for j := range fun.Params {
+				if fun.Params[j].Name == arg.Name {
+					idx = j
+					break
+				}
+			}
+			if idx == -1 {
+				return types.Nil, errors.New("unknown argument '" + arg.Name + "' when calling function '" + fun.Name + "'")
+			}
+		}
+		if values[idx] != nil {
+			return types.Nil, errors.New("argument '" + fun.Params[idx].Name + "' is provided more than once when calling function '" + fun.Name + "'")
+		}
+		values[idx] = arg.Value
+	}
+
+	scope := newvarmap(c.blockRef, fun.scope)
+	for i := range values {
+		param := fun.Params[i]
+		ref, err := c.compileAndAddExpression(values[i])
+		if err != nil {
+			return types.Nil, err
+		}
+
+		typ, err := c.dereferenceType(llx.RefPrimitiveV2(ref))
+		if err != nil {
+			return types.Nil, err
+		}
+		if fun.params[i] != types.Any && typ != fun.params[i] {
+			return types.Nil, errors.New("incorrect argument '" + param.Name + "' when calling function '" + fun.Name +
+				"': expected " + fun.params[i].Label() + " got " + typ.Label())
+		}
+
+		scope.add(param.Name, variable{
+			name: param.Name,
+			ref:  ref,
+			typ:  typ,
+		})
+	}
+
+	// the body only sees the function's parameters and the scope it was
+	// defined in, but not the caller's variables or bindings
+	vars, binding := c.vars, c.Binding
+	c.vars, c.Binding = scope, nil
+	c.inlining = append(c.inlining, fun.Name)
+
+	ref, err := c.compileFunctionBody(fun)
+
+	c.inlining = c.inlining[:len(c.inlining)-1]
+	c.vars, c.Binding = vars, binding
+
+	if err != nil {
+		return types.Nil, errors.New("failed to compile function '" + fun.Name + "': " + err.Error())
+	}
+
+	// the result needs to be the last chunk, since that's where the
+	// caller continues
+	if ref != c.tailRef() {
+		c.addChunk(&llx.Chunk{
+			Call:      llx.Chunk_PRIMITIVE,
+			Primitive: llx.RefPrimitiveV2(ref),
+		})
+	}
+
+	return c.dereferenceType(llx.RefPrimitiveV2(ref))
+}
+
+// compileFunctionBody compiles all assignments and nested functions of the
+// body. Its result is the value of its remaining expressions. If there is
+// more than one, they are combined via &&, just like multiple assertions.
+func (c *compiler) compileFunctionBody(fun *function) (uint64, error) {
+	body := filterEmptyExpressions(fun.Body)
+
+	var results []*parser.Expression
+	for i := range body {
+		expression := body[i]
+		if err := expression.ProcessOperators(); err != nil {
+			return 0, err
+		}
+
+		value := expression.Operand.Value
+		var ident string
+		if value != nil && value.Ident != nil {
+			ident = *value.Ident
+		}
+
+		switch {
+		case value != nil && value.Function != nil:
+			if err := c.defineFunction(value.Function); err != nil {
+				return 0, err
+			}
+			c.lintFunction(value.Function, expression.Operand)
+
+		case ident == "=":
+			if _, err := c.compileAndAddExpression(expression); err != nil {
+				return 0, err
+			}
+
+		case ident == "if" || ident == "else":
+			return 0, errors.New("if statements are not supported in functions, please use switch instead")
+
+		case ident == "return":
+			if i+2 != len(body) {
+				return 0, errors.New("return statement must be followed by exactly one expression at the end of the function")
+			}
+			if len(results) != 0 {
+				return 0, errors.New("cannot combine a return statement with other expressions")
+			}
+
+		default:
+			results = append(results, expression)
+		}
+	}
+
+	if len(results) == 0 {
+		return 0, errors.New("function has no return value")
+	}
+
+	res := results[0]
+	if len(results) > 1 {
+		res = &parser.Expression{Operand: results[0].Operand}
+		for i := 1; i < len(results); i++ {
+			res.Operations = append(res.Operations, &parser.Operation{
+				Operator: parser.OpAnd,
+				Operand:  results[i].Operand,
+			})
+		}
+		if err := res.ProcessOperators(); err != nil {
+			return 0, err
+		}
+	}
+
+	return c.compileAndAddExpression(res)
+}
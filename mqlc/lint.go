@@ -0,0 +1,602 @@
+package mqlc
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/participle/lexer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc/parser"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// Severity of a diagnostic
+type Severity int
+
+const (
+	SeverityError Severity = iota + 1
+	SeverityWarning
+	SeverityInfo
+)
+
+var severityNames = map[Severity]string{
+	SeverityError:   "error",
+	SeverityWarning: "warning",
+	SeverityInfo:    "info",
+}
+
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Diagnostic is a problem that was found in MQL code
+type Diagnostic struct {
+	Severity Severity
+	// Rule is the ID of the check that found this problem, e.g. unused-variable
+	Rule    string
+	Message string
+	// Pos points to the code with the problem. It is empty if unknown.
+	Pos lexer.Position
+	// Fix is an optional edit to the code, which solves the problem
+	Fix *Fix
+}
+
+func (d *Diagnostic) String() string {
+	var res strings.Builder
+	if d.Pos.Line != 0 {
+		res.WriteString(strconv.Itoa(d.Pos.Line))
+		res.WriteByte(':')
+		res.WriteString(strconv.Itoa(d.Pos.Column))
+		res.WriteString(": ")
+	}
+	res.WriteString(d.Severity.String())
+	res.WriteString(": ")
+	res.WriteString(d.Message)
+	if d.Rule != "" {
+		res.WriteString(" (" + d.Rule + ")")
+	}
+	return res.String()
+}
+
+// Fix replaces the code between the Start and End offsets with Text
+type Fix struct {
+	Title string
+	Start int
+	End   int
+	Text  string
+}
+
+// Apply the fix to the code it was created for
+func (f *Fix) Apply(code string) string {
+	return code[:f.Start] + f.Text + code[f.End:]
+}
+
+// LintOptions configure checks that need more context than the code itself
+type LintOptions struct {
+	// Platforms that the code is filtered to, e.g. ubuntu or aws. If set,
+	// resources that aren't available on any of them are reported.
+	Platforms []string
+}
+
+// Reference is a resource or a field that is used in the code
+type Reference struct {
+	Start lexer.Position
+	// End is the offset right after the reference
+	End      int
+	Resource *resources.ResourceInfo
+	// Field is nil if the reference points to the resource itself
+	Field *resources.Field
+}
+
+// Analysis of MQL code, with all problems in it and all the resources
+// and fields it references
+type Analysis struct {
+	Diagnostics []*Diagnostic
+	References  []*Reference
+}
+
+type linter struct {
+	source      string
+	ast         *parser.AST
+	opts        LintOptions
+	diagnostics []*Diagnostic
+	references  []*Reference
+	symbols     []*lintSymbol
+	// functions maps user-defined functions to their symbols
+	functions map[*parser.Function]*lintSymbol
+	reported  map[string]struct{}
+}
+
+// lintSymbol is a variable or function, which should be used after
+// it is defined
+type lintSymbol struct {
+	kind string
+	name string
+	node interface{}
+	used bool
+}
+
+// Lint compiles the code and analyzes it for problems which don't prevent it
+// from compiling, like unused variables or comparisons that are always false.
+// Compile errors are returned as diagnostics too.
+// Unlike Compile, the input isn't dedented, so that positions and fixes
+// match the code as it was written.
+func Lint(input string, props map[string]*llx.Primitive, conf compilerConfig, opts LintOptions) []*Diagnostic {
+	return Analyze(input, props, conf, opts).Diagnostics
+}
+
+// Analyze the code like Lint and also collect all references to resources
+// and fields in it, e.g. for editors
+func Analyze(input string, props map[string]*llx.Primitive, conf compilerConfig, opts LintOptions) *Analysis {
+	ast, err := parser.Parse(input)
+	if err != nil {
+		d := &Diagnostic{Severity: SeverityError, Rule: "syntax", Message: err.Error()}
+		d.Pos, _ = parser.ErrorPosition(err)
+		return &Analysis{Diagnostics: []*Diagnostic{d}}
+	}
+
+	l := newLinter(opts)
+	l.source = input
+	l.ast = ast
+
+	_, err = compileAST(ast, props, conf, l)
+	l.finish(err)
+
+	return &Analysis{
+		Diagnostics: l.diagnostics,
+		References:  l.references,
+	}
+}
+
+// CompileWithLint compiles the code like Compile and lints it while doing so,
+// for callers that need both. Diagnostics refer to the dedented code in the
+// bundle's Source and are only returned if the code compiles.
+func CompileWithLint(input string, props map[string]*llx.Primitive, conf compilerConfig, opts LintOptions) (*llx.CodeBundle, []*Diagnostic, error) {
+	l := newLinter(opts)
+	res, err := compile(input, props, conf, l)
+	if err = checkCompiled(res, err); err != nil {
+		return res, nil, err
+	}
+
+	l.finish(nil)
+	return res, l.diagnostics, nil
+}
+
+func newLinter(opts LintOptions) *linter {
+	return &linter{
+		opts:      opts,
+		functions: map[*parser.Function]*lintSymbol{},
+		reported:  map[string]struct{}{},
+	}
+}
+
+// finish the analysis after compiling, with the compile error if there was one
+func (l *linter) finish(err error) {
+	if err != nil {
+		d := &Diagnostic{
+			Severity: SeverityError,
+			Rule:     "compile",
+			Message:  err.Error(),
+		}
+		if cerr, ok := err.(*CompileError); ok {
+			d.Pos = cerr.Pos
+		}
+		l.diagnostics = append(l.diagnostics, d)
+	} else {
+		l.reportUnused()
+	}
+
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i].Pos, l.diagnostics[j].Pos
+		if a.Line == 0 || b.Line == 0 {
+			return b.Line == 0 && a.Line != 0
+		}
+		return a.Offset < b.Offset
+	})
+}
+
+func (l *linter) add(node interface{}, severity Severity, rule string, msg string, fix *Fix) {
+	d := &Diagnostic{
+		Severity: severity,
+		Rule:     rule,
+		Message:  msg,
+		Fix:      fix,
+	}
+	if span, ok := l.ast.Span(node); ok {
+		d.Pos = span.Start
+	}
+
+	// the same code may be compiled more than once, e.g. in functions
+	key := strconv.Itoa(d.Pos.Offset) + "\x00" + rule + "\x00" + msg
+	if _, ok := l.reported[key]; ok {
+		return
+	}
+	l.reported[key] = struct{}{}
+
+	l.diagnostics = append(l.diagnostics, d)
+}
+
+// addReference to a resource or field at the given node, which must
+// start with the given identifier
+func (l *linter) addReference(node interface{}, id string, resource *resources.ResourceInfo, field *resources.Field) {
+	span, ok := l.ast.Span(node)
+	if !ok || !strings.HasPrefix(l.source[span.Start.Offset:], id) {
+		return
+	}
+
+	end := span.Start.Offset + len(id)
+	for _, ref := range l.references {
+		if ref.Start.Offset == span.Start.Offset && ref.End == end {
+			return
+		}
+	}
+
+	l.references = append(l.references, &Reference{
+		Start:    span.Start,
+		End:      end,
+		Resource: resource,
+		Field:    field,
+	})
+}
+
+func (l *linter) reportUnused() {
+	for i := range l.symbols {
+		sym := l.symbols[i]
+		if sym.used {
+			continue
+		}
+		l.add(sym.node, SeverityWarning, "unused-"+sym.kind, sym.kind+" '"+sym.name+"' is never used", nil)
+	}
+}
+
+// lintVariable tracks the usage of a variable and returns the callback
+// for when it is used
+func (c *compiler) lintVariable(name string, node *parser.Operand) func() {
+	if c.lint == nil || name == "_" {
+		return nil
+	}
+
+	sym := &lintSymbol{kind: "variable", name: name, node: node}
+	c.lint.symbols = append(c.lint.symbols, sym)
+	return func() {
+		sym.used = true
+	}
+}
+
+// lintFunction tracks the usage of a user-defined function
+func (c *compiler) lintFunction(fun *parser.Function, node *parser.Operand) {
+	if c.lint == nil {
+		return
+	}
+	if _, ok := c.lint.functions[fun]; ok {
+		return
+	}
+
+	sym := &lintSymbol{kind: "function", name: fun.Name, node: node}
+	c.lint.symbols = append(c.lint.symbols, sym)
+	c.lint.functions[fun] = sym
+}
+
+func (c *compiler) lintFunctionCall(fun *parser.Function) {
+	if c.lint == nil {
+		return
+	}
+	if sym, ok := c.lint.functions[fun]; ok {
+		sym.used = true
+	}
+}
+
+// lintComparison checks comparisons with static results and comparisons
+// that can be written in a simpler way
+func (c *compiler) lintComparison(id string, call *parser.Call, left *llx.Chunk, lt types.Type, rt types.Type, name string) {
+	if c.lint == nil || len(call.Function) != 2 {
+		return
+	}
+	leftOp := call.Function[0].Value.Operand
+	rightOp := call.Function[1].Value.Operand
+
+	if res, ok := llx.StaticComparison(lt, name); ok && lt != rt {
+		c.lint.add(leftOp, SeverityWarning, "static-comparison",
+			"comparison is always "+strconv.FormatBool(res)+" because "+lt.Label()+" and "+rt.Label()+" are different types", nil)
+		return
+	}
+
+	var replacement string
+	switch id {
+	case "==":
+		replacement = "none"
+	case "!=", ">":
+		replacement = "any"
+	default:
+		return
+	}
+
+	// we are looking for: <list>.where(<cond>).length == 0
+	if rightOp == nil || rightOp.Value == nil || rightOp.Value.Int == nil || *rightOp.Value.Int != 0 ||
+		len(rightOp.Calls) != 0 || leftOp == nil {
+		return
+	}
+	calls := leftOp.Calls
+	n := len(calls)
+	if n > 0 && calls[n-1].Function != nil && len(calls[n-1].Function) == 0 {
+		n--
+	}
+	if n < 3 || calls[n-1].Ident == nil || *calls[n-1].Ident != "length" ||
+		calls[n-2].Function == nil || calls[n-3].Ident == nil || *calls[n-3].Ident != "where" {
+		return
+	}
+
+	// make sure the list type supports the replacement
+	if left.Function == nil || left.Function.Binding == 0 {
+		return
+	}
+	where := c.Result.CodeV2.Chunk(left.Function.Binding)
+	if where == nil || where.Id != "where" || where.Function == nil {
+		return
+	}
+	if h, _ := builtinFunction(types.Type(where.Function.Type), replacement); h == nil {
+		return
+	}
+
+	var fix *Fix
+	whereSpan, ok1 := c.lint.ast.Span(calls[n-3])
+	argsSpan, ok2 := c.lint.ast.Span(calls[n-2])
+	endSpan, ok3 := c.lint.ast.Span(rightOp)
+	if ok1 && ok2 && ok3 {
+		args := c.lint.source[argsSpan.Start.Offset:argsSpan.End]
+		fix = &Fix{
+			Title: "replace with " + replacement + args,
+			Start: whereSpan.Start.Offset,
+			End:   endSpan.End,
+			Text:  replacement + args,
+		}
+	}
+
+	c.lint.add(leftOp, SeverityInfo, "prefer-"+replacement,
+		"use "+replacement+"(...) instead of where(...).length "+id+" 0", fix)
+}
+
+var (
+	reDeprecated    = regexp.MustCompile(`(?i)\bdeprecated\b`)
+	reDeprecatedUse = regexp.MustCompile("(?i)\\buse [`']?([a-zA-Z0-9_.]+)[`']? instead")
+)
+
+// deprecation checks if the docs of a field or resource mark it as
+// deprecated and returns its replacement, if there is one
+func deprecation(title string, desc string) (bool, string) {
+	doc := title + "\n" + desc
+	if !reDeprecated.MatchString(doc) {
+		return false, ""
+	}
+	if m := reDeprecatedUse.FindStringSubmatch(doc); m != nil {
+		return true, m[1]
+	}
+	return true, ""
+}
+
+// lintDeprecation reports a deprecated identifier at the current node and
+// offers to replace it, if a replacement is known
+func (c *compiler) lintDeprecation(kind string, id string, replacement string) {
+	msg := kind + " '" + id + "' is deprecated"
+	var fix *Fix
+	if replacement != "" {
+		msg += ", use '" + replacement + "' instead"
+		span, ok := c.lint.ast.Span(c.cursor.node)
+		if ok && strings.HasPrefix(c.lint.source[span.Start.Offset:], id) {
+			fix = &Fix{
+				Title: "replace with " + replacement,
+				Start: span.Start.Offset,
+				End:   span.Start.Offset + len(id),
+				Text:  replacement,
+			}
+		}
+	}
+
+	c.lint.add(c.cursor.node, SeverityWarning, "deprecated-"+kind, msg, fix)
+}
+
+// lintField records references to fields and reports deprecated ones
+func (c *compiler) lintField(resource *resources.ResourceInfo, id string, field *resources.Field) {
+	if c.lint == nil {
+		return
+	}
+	c.lint.addReference(c.cursor.node, id, resource, field)
+
+	deprecated, replacement := deprecation(field.Title, field.Desc)
+	if !deprecated {
+		return
+	}
+	if _, ok := resource.Fields[replacement]; !ok {
+		replacement = ""
+	}
+	c.lintDeprecation("field", id, replacement)
+}
+
+// resourcePlatforms lists resource namespaces that are only available on
+// specific platforms. Platforms are matched by name or family, including
+// all platforms whose names start with the given name, e.g. gcp-project for gcp.
+var resourcePlatforms = map[string][]string{
+	"arista":          {"arista"},
+	"aws":             {"aws"},
+	"azure":           {"azure"},
+	"azuread":         {"azure"},
+	"azurerm":         {"azure"},
+	"esxi":            {"vmware", "vsphere", "esxi"},
+	"gcloud":          {"gcp", "google"},
+	"gcp":             {"gcp", "google"},
+	"github":          {"github"},
+	"gitlab":          {"gitlab"},
+	"googleworkspace": {"google-workspace", "google"},
+	"k8s":             {"k8s", "kubernetes"},
+	"macos":           {"macos", "darwin"},
+	"microsoft":       {"microsoft365"},
+	"ms365":           {"microsoft365"},
+	"msgraph":         {"microsoft365"},
+	"oci":             {"oci"},
+	"okta":            {"okta"},
+	"slack":           {"slack"},
+	"terraform":       {"terraform"},
+	"vcd":             {"vcd"},
+	"vsphere":         {"vmware", "vsphere"},
+	"windows":         {"windows"},
+}
+
+func matchesPlatform(target string, platform string) bool {
+	return target == platform || strings.HasPrefix(target, platform+"-")
+}
+
+// lintResource reports resources that are deprecated or that aren't
+// available on the platforms this code is filtered to
+func (c *compiler) lintResource(id string, resource *resources.ResourceInfo) {
+	if c.lint == nil {
+		return
+	}
+	c.lint.addReference(c.cursor.node, id, resource, nil)
+
+	if deprecated, replacement := deprecation(resource.Title, resource.Desc); deprecated {
+		if c.Schema.Lookup(replacement) == nil {
+			replacement = ""
+		}
+		c.lintDeprecation("resource", id, replacement)
+	}
+
+	if len(c.lint.opts.Platforms) == 0 {
+		return
+	}
+
+	namespace := id
+	if idx := strings.IndexByte(id, '.'); idx != -1 {
+		namespace = id[:idx]
+	}
+	platforms, ok := resourcePlatforms[namespace]
+	if !ok {
+		return
+	}
+
+	for _, target := range c.lint.opts.Platforms {
+		for _, platform := range platforms {
+			if matchesPlatform(target, platform) {
+				return
+			}
+		}
+	}
+
+	c.lint.add(c.cursor.node, SeverityWarning, "platform-availability",
+		"resource '"+id+"' is not available on the filtered platforms ("+strings.Join(c.lint.opts.Platforms, ", ")+")", nil)
+}
+
+// FilterPlatforms returns the platforms that the given asset filters are
+// restricted to, e.g. ubuntu for `asset.platform == "ubuntu"` or unix for
+// `asset.family.contains("unix")`. Since any of the filters may match an
+// asset, nil is returned if one of them isn't restricted to platforms.
+func FilterPlatforms(filters []string) []string {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	var res []string
+	for i := range filters {
+		ast, err := parser.Parse(Dedent(filters[i]))
+		if err != nil || len(ast.Expressions) != 1 {
+			return nil
+		}
+		exp := ast.Expressions[0]
+		if err = exp.ProcessOperators(); err != nil {
+			return nil
+		}
+
+		platforms := operandPlatforms(exp.Operand)
+		if platforms == nil {
+			return nil
+		}
+		res = append(res, platforms...)
+	}
+
+	sort.Strings(res)
+	return uniqueStrings(res)
+}
+
+func uniqueStrings(list []string) []string {
+	res := list[:0]
+	for i := range list {
+		if i == 0 || list[i] != list[i-1] {
+			res = append(res, list[i])
+		}
+	}
+	return res
+}
+
+func operandPlatforms(op *parser.Operand) []string {
+	if op == nil || op.Value == nil || op.Value.Ident == nil {
+		return nil
+	}
+
+	ident := *op.Value.Ident
+	switch ident {
+	case "&&", "||":
+		if len(op.Calls) != 1 || len(op.Calls[0].Function) != 2 {
+			return nil
+		}
+		left := operandPlatforms(op.Calls[0].Function[0].Value.Operand)
+		right := operandPlatforms(op.Calls[0].Function[1].Value.Operand)
+		if ident == "||" && (left == nil || right == nil) {
+			return nil
+		}
+		// for && we only need one restricted side; if both are restricted
+		// we are generous and consider all of them
+		return append(left, right...)
+
+	case "==":
+		if len(op.Calls) != 1 || len(op.Calls[0].Function) != 2 {
+			return nil
+		}
+		a := op.Calls[0].Function[0].Value.Operand
+		b := op.Calls[0].Function[1].Value.Operand
+		if isPlatformName(a) && isString(b) {
+			return []string{*b.Value.String}
+		}
+		if isPlatformName(b) && isString(a) {
+			return []string{*a.Value.String}
+		}
+		return nil
+
+	case "asset", "platform":
+		// asset.family.contains("unix")
+		calls := op.Calls
+		if len(calls) == 3 && isIdentCall(calls[0], "family") && isIdentCall(calls[1], "contains") &&
+			len(calls[2].Function) == 1 && isString(calls[2].Function[0].Value.Operand) {
+			return []string{*calls[2].Function[0].Value.Operand.Value.String}
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// isPlatformName checks for asset.platform and platform.name
+func isPlatformName(op *parser.Operand) bool {
+	if op == nil || op.Value == nil || op.Value.Ident == nil || len(op.Calls) != 1 {
+		return false
+	}
+	switch *op.Value.Ident {
+	case "asset":
+		return isIdentCall(op.Calls[0], "platform")
+	case "platform":
+		return isIdentCall(op.Calls[0], "name")
+	}
+	return false
+}
+
+func isIdentCall(call *parser.Call, name string) bool {
+	return call.Ident != nil && *call.Ident == name
+}
+
+func isString(op *parser.Operand) bool {
+	return op != nil && op.Value != nil && op.Value.String != nil && len(op.Calls) == 0
+}
@@ -0,0 +1,108 @@
+package mqlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		code     string
+		opts     LintOptions
+		rule     string
+		severity Severity
+		fixed    string
+	}{
+		{"x = 1\ny = 2\ny + 1", LintOptions{}, "unused-variable", SeverityWarning, ""},
+		{"def f(x int) { x }\n2", LintOptions{}, "unused-function", SeverityWarning, ""},
+		{"true == 1", LintOptions{}, "static-comparison", SeverityWarning, ""},
+		{"users.where(name == 'a').length == 0", LintOptions{}, "prefer-none", SeverityInfo, "users.none(name == 'a')"},
+		{"users.list.where(uid > 1).length() != 0", LintOptions{}, "prefer-any", SeverityInfo, "users.list.any(uid > 1)"},
+		{"platform.release != ''", LintOptions{}, "deprecated-resource", SeverityWarning, "asset.release != ''"},
+		{"platform.release != ''", LintOptions{}, "deprecated-field", SeverityWarning, "platform.version != ''"},
+		{"windows.hotfixes.length", LintOptions{Platforms: []string{"ubuntu"}}, "platform-availability", SeverityWarning, ""},
+		{"nope", LintOptions{}, "compile", SeverityError, ""},
+		{"users.where(", LintOptions{}, "syntax", SeverityError, ""},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.code, func(t *testing.T) {
+			// pick the diagnostic for the rule we are testing, or
+			// whatever else was reported instead
+			var d *Diagnostic
+			for _, x := range Lint(cur.code, nil, conf, cur.opts) {
+				if d == nil || x.Rule == cur.rule {
+					d = x
+				}
+			}
+			require.NotNil(t, d)
+			assert.Equal(t, cur.rule, d.Rule)
+			assert.Equal(t, cur.severity, d.Severity)
+			if cur.fixed == "" {
+				assert.Nil(t, d.Fix)
+			} else if assert.NotNil(t, d.Fix) {
+				assert.Equal(t, cur.fixed, d.Fix.Apply(cur.code))
+			}
+		})
+	}
+
+	t.Run("clean code", func(t *testing.T) {
+		assert.Empty(t, Lint("x = 1\nusers.none(uid == x)", nil, conf, LintOptions{}))
+		assert.Empty(t, Lint("windows.hotfixes.length", nil, conf, LintOptions{Platforms: []string{"windows"}}))
+		assert.Empty(t, Lint("{a: 1}.where(key == 'a').length == 0", nil, conf, LintOptions{}))
+	})
+
+	t.Run("positions", func(t *testing.T) {
+		res := Lint("x = 1\n\n  users.where(name == 'a').length == 0", nil, conf, LintOptions{})
+		require.Len(t, res, 2)
+		assert.Equal(t, "1:1: warning: variable 'x' is never used (unused-variable)", res[0].String())
+		assert.Equal(t, 3, res[1].Pos.Line)
+		assert.Equal(t, 3, res[1].Pos.Column)
+		assert.Equal(t, "x = 1\n\n  users.none(name == 'a')", res[1].Fix.Apply("x = 1\n\n  users.where(name == 'a').length == 0"))
+	})
+}
+
+func TestCompileWithLint(t *testing.T) {
+	code, diagnostics, err := CompileWithLint("  x = 1\n  users.where(name == 'a').length == 0", nil, conf, LintOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, code.CodeV2)
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, "unused-variable", diagnostics[0].Rule)
+	assert.Equal(t, "x = 1\nusers.none(name == 'a')", diagnostics[1].Fix.Apply(code.Source))
+
+	_, diagnostics, err = CompileWithLint("nope", nil, conf, LintOptions{})
+	assert.Error(t, err)
+	assert.Nil(t, diagnostics)
+}
+
+func TestFilterPlatforms(t *testing.T) {
+	assert.Equal(t, []string{"ubuntu", "windows"}, FilterPlatforms([]string{
+		"asset.platform == 'ubuntu' || platform.name == 'windows'",
+	}))
+	assert.Equal(t, []string{"windows"}, FilterPlatforms([]string{
+		"asset.family.contains('windows')",
+	}))
+	assert.Nil(t, FilterPlatforms([]string{
+		"asset.platform == 'ubuntu' || asset.kind == 'api'",
+	}))
+	assert.Nil(t, FilterPlatforms(nil))
+}
+
+func TestAnalyze_References(t *testing.T) {
+	code := "users.list { name }"
+	res := Analyze(code, nil, conf, LintOptions{})
+	require.Empty(t, res.Diagnostics)
+
+	refs := []string{}
+	for _, ref := range res.References {
+		id := ref.Resource.Id
+		if ref.Field != nil {
+			id += "." + ref.Field.Name
+		}
+		refs = append(refs, code[ref.Start.Offset:ref.End]+"="+id)
+	}
+	assert.Equal(t, []string{"users=users", "list=users.list", "name=user.name"}, refs)
+}
@@ -30,6 +30,8 @@ type variable struct {
 	// (like `key` and `value`). One use-case is to tell the
 	// block compiler that its bound value has been used.
 	callback func()
+	// fn is set for user-defined functions
+	fn *function
 }
 
 type varmap struct {
@@ -67,6 +69,9 @@ func (vm *varmap) len() int {
 type compilerConfig struct {
 	Schema          llx.Schema
 	UseAssetContext bool
+	// Functions are user-defined functions, which are available to the
+	// compiled code in addition to those that it defines itself
+	Functions []*parser.Function
 }
 
 func NewConfig(schema llx.Schema, features cnquery.Features) compilerConfig {
@@ -97,6 +102,15 @@ type compiler struct {
 
 	// helps chaining of builtin calls like `if (..) else if (..) else ..`
 	prevID string
+
+	// names of all user-defined functions that are currently inlined
+	inlining []string
+
+	// cursor points to the node that is compiled, it is shared by all blocks
+	cursor *cursor
+
+	// lint collects diagnostics while compiling, it is nil if not linting
+	lint *linter
 }
 
 func (c *compiler) isInMyBlock(ref uint64) bool {
@@ -143,6 +157,8 @@ func (c *compiler) newBlockCompiler(binding *variable) compiler {
 		blockRef:       ref,
 		props:          c.props,
 		standalone:     true,
+		cursor:         c.cursor,
+		lint:           c.lint,
 	}
 }
 
@@ -959,6 +975,7 @@ func (c *compiler) compileBoundIdentifierWithMqlCtx(id string, binding *variable
 			}
 
 			c.Result.MinMondooVersion = getMinMondooVersion(c.Result.MinMondooVersion, typ.ResourceName(), id)
+			c.lintField(resource, id, fieldinfo)
 
 			// this only happens when we call a field of a bridging resource,
 			// in which case we don't call the field (since there is nothing to do)
@@ -1056,6 +1073,7 @@ func (c *compiler) compileBoundIdentifierWithoutMqlCtx(id string, binding *varia
 			}
 
 			c.Result.MinMondooVersion = getMinMondooVersion(c.Result.MinMondooVersion, typ.ResourceName(), id)
+			c.lintField(resource, id, fieldinfo)
 
 			// this only happens when we call a field of a bridging resource,
 			// in which case we don't call the field (since there is nothing to do)
@@ -1122,6 +1140,7 @@ func (c *compiler) compileResource(id string, calls []*parser.Call) (bool, []*pa
 	}
 
 	c.Result.MinMondooVersion = getMinMondooVersion(c.Result.MinMondooVersion, id, "")
+	c.lintResource(id, resource)
 
 	typ, err := c.addResource(id, resource, call)
 	return true, calls, typ, err
@@ -1243,6 +1262,10 @@ func (c *compiler) compileIdentifier(id string, callBinding *variable, calls []*
 	}
 
 	variable, ok := c.vars.lookup(id)
+	if ok && variable.fn != nil {
+		typ, err := c.compileFunctionCall(variable.fn, call)
+		return restCalls, typ, err
+	}
 	if ok {
 		if variable.callback != nil {
 			variable.callback()
@@ -1396,6 +1419,65 @@ func (c *compiler) compileValue(val *parser.Value) (*llx.Primitive, error) {
 	return llx.NilPrimitive, nil
 }
 
+// compileInterpolation turns all parts of an interpolated string into
+// string concatenations, e.g. "uid: ${uid}" becomes "uid: " + uid. Values
+// that aren't strings are formatted first.
+func (c *compiler) compileInterpolation(parts []*parser.Expression) (uint64, error) {
+	var ref uint64
+	for i := range parts {
+		val, err := c.compileExpression(parts[i])
+		if err != nil {
+			return 0, err
+		}
+
+		typ, err := c.dereferenceType(val)
+		if err != nil {
+			return 0, err
+		}
+
+		if typ != types.String {
+			c.addChunk(&llx.Chunk{
+				Call:      llx.Chunk_PRIMITIVE,
+				Primitive: llx.StringPrimitive("%v"),
+			})
+			c.addChunk(&llx.Chunk{
+				Call: llx.Chunk_FUNCTION,
+				Id:   "format",
+				Function: &llx.Function{
+					Type:    string(types.String),
+					Binding: c.tailRef(),
+					Args:    []*llx.Primitive{val},
+				},
+			})
+			val = llx.RefPrimitiveV2(c.tailRef())
+		}
+
+		if i == 0 {
+			if types.Type(val.Type) != types.Ref {
+				c.addChunk(&llx.Chunk{
+					Call:      llx.Chunk_PRIMITIVE,
+					Primitive: val,
+				})
+			}
+			ref = c.tailRef()
+			continue
+		}
+
+		c.addChunk(&llx.Chunk{
+			Call: llx.Chunk_FUNCTION,
+			Id:   "+" + string(types.String),
+			Function: &llx.Function{
+				Type:    string(types.String),
+				Binding: ref,
+				Args:    []*llx.Primitive{val},
+			},
+		})
+		ref = c.tailRef()
+	}
+
+	return ref, nil
+}
+
 func (c *compiler) compileOperand(operand *parser.Operand) (*llx.Primitive, error) {
 	var err error
 	var res *llx.Primitive
@@ -1404,11 +1486,22 @@ func (c *compiler) compileOperand(operand *parser.Operand) (*llx.Primitive, erro
 
 	calls := operand.Calls
 	c.comment = operand.Comments
+	c.at(operand)
 
 	// value:        bool | string | regex | number | array | map | ident
 	// so all simple values are compiled into primitives and identifiers
-	// into function calls
-	if operand.Value.Ident == nil {
+	// into function calls. Interpolated strings are compiled into a
+	// concatenation of all their parts.
+	if operand.Value.Function != nil {
+		return nil, errors.New("function '" + operand.Value.Function.Name + "' must be defined in a standalone statement")
+	} else if operand.Value.Interpolation != nil {
+		ref, err = c.compileInterpolation(operand.Value.Interpolation)
+		if err != nil {
+			return nil, err
+		}
+		typ = types.String
+		res = llx.RefPrimitiveV2(ref)
+	} else if operand.Value.Ident == nil {
 		res, err = c.compileValue(operand.Value)
 		if err != nil {
 			return nil, err
@@ -1488,6 +1581,7 @@ func (c *compiler) compileOperand(operand *parser.Operand) (*llx.Primitive, erro
 			var found bool
 			var resType types.Type
 			id := *call.Ident
+			c.at(call)
 
 			if id == "." {
 				// We get this from the parser if the user called the dot-accessor
@@ -1601,6 +1695,14 @@ func (c *compiler) compileExpressions(expressions []*parser.Expression) error {
 	var prev string
 	for idx := range expressions {
 		expression := expressions[idx]
+		if expression.Operand != nil && expression.Operand.Value != nil && expression.Operand.Value.Function != nil {
+			if err = c.defineFunction(expression.Operand.Value.Function); err != nil {
+				return err
+			}
+			c.lintFunction(expression.Operand.Value.Function, expression.Operand)
+			continue
+		}
+
 		prev = ident
 		ident = ""
 		if expression.Operand != nil && expression.Operand.Value != nil && expression.Operand.Value.Ident != nil {
@@ -2052,6 +2154,10 @@ func getMinMondooVersion(current string, resource string, field string) string {
 
 // CompileAST with a schema into a chunky code
 func CompileAST(ast *parser.AST, props map[string]*llx.Primitive, conf compilerConfig) (*llx.CodeBundle, error) {
+	return compileAST(ast, props, conf, nil)
+}
+
+func compileAST(ast *parser.AST, props map[string]*llx.Primitive, conf compilerConfig, lint *linter) (*llx.CodeBundle, error) {
 	if conf.Schema == nil {
 		return nil, errors.New("mqlc> please provide a schema to compile this code")
 	}
@@ -2085,19 +2191,31 @@ func CompileAST(ast *parser.AST, props map[string]*llx.Primitive, conf compilerC
 		block:          codeBundle.CodeV2.Blocks[0],
 		props:          props,
 		standalone:     true,
+		cursor:         &cursor{ast: ast},
+		lint:           lint,
+	}
+
+	for i := range conf.Functions {
+		if err := c.defineFunction(conf.Functions[i]); err != nil {
+			return c.Result, err
+		}
 	}
 
-	return c.Result, c.CompileParsed(ast)
+	if err := c.CompileParsed(ast); err != nil {
+		return c.Result, c.cursor.error(err)
+	}
+	return c.Result, nil
 }
 
-// Compile a code piece against a schema into chunky code
-func compile(input string, props map[string]*llx.Primitive, conf compilerConfig) (*llx.CodeBundle, error) {
+// Compile a code piece against a schema into chunky code. The code is
+// linted along the way if a linter is provided.
+func compile(input string, props map[string]*llx.Primitive, conf compilerConfig, lint *linter) (*llx.CodeBundle, error) {
 	// remove leading whitespace; we are re-using this later on
 	input = Dedent(input)
 
 	ast, err := parser.Parse(input)
 	if ast == nil {
-		return nil, err
+		return nil, sourceError(err, input)
 	}
 
 	// Special handling for parser errors: We still try to compile it because
@@ -2105,12 +2223,16 @@ func compile(input string, props map[string]*llx.Primitive, conf compilerConfig)
 	// That said, we must return an error either way.
 	if err != nil {
 		res, _ := CompileAST(ast, props, conf)
-		return res, err
+		return res, sourceError(err, input)
 	}
 
-	res, err := CompileAST(ast, props, conf)
+	if lint != nil {
+		lint.source = input
+		lint.ast = ast
+	}
+	res, err := compileAST(ast, props, conf, lint)
 	if err != nil {
-		return res, err
+		return res, sourceError(err, input)
 	}
 
 	err = UpdateLabels(res, conf.Schema)
@@ -2134,16 +2256,20 @@ func Compile(input string, props map[string]*llx.Primitive, conf compilerConfig)
 	// Note: we do not check the conf because it will get checked by the
 	// first CompileAST call. Do not use it earlier or add a check.
 
-	res, err := compile(input, props, conf)
+	res, err := compile(input, props, conf, nil)
+	return res, checkCompiled(res, err)
+}
+
+func checkCompiled(res *llx.CodeBundle, err error) error {
 	if err != nil {
-		return res, err
+		return err
 	}
 
 	if res.CodeV2 == nil || res.CodeV2.Id == "" {
-		return res, errors.New("failed to compile: received an unspecified empty code structure")
+		return errors.New("failed to compile: received an unspecified empty code structure")
 	}
 
-	return res, nil
+	return nil
 }
 
 // MustCompile a code piece that should not fail (otherwise panic)
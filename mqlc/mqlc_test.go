@@ -889,6 +889,72 @@ func TestCompiler_ArrayAll(t *testing.T) {
 	})
 }
 
+func TestCompiler_ArraySort(t *testing.T) {
+	compileT(t, "[3,1,2].sort", func(res *llx.CodeBundle) {
+		assertFunction(t, "sort", &llx.Function{
+			Type:    string(types.Array(types.Int)),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assert.Equal(t, 2, len(res.CodeV2.Blocks[0].Chunks))
+	})
+
+	compileT(t, "[3,1,2].sortDesc(_ * 2)", func(res *llx.CodeBundle) {
+		assertFunction(t, "fieldSortDesc", &llx.Function{
+			Type:    string(types.Array(types.Int)),
+			Binding: (1 << 32) | 1,
+			Args: []*llx.Primitive{
+				llx.RefPrimitiveV2((1 << 32) | 1),
+				llx.FunctionPrimitive(2 << 32),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assert.Equal(t, 2, len(res.CodeV2.Blocks[0].Chunks))
+	})
+
+	compileErroneous(t, "users.list.sort", errors.New("cannot sort array of user, try calling 'sort' with a field or expression to sort by"), nil)
+	compileErroneous(t, "users.list.sort(group)", errors.New("cannot sort by values of type group when calling 'sort'"), nil)
+}
+
+func TestCompiler_ArraySlice(t *testing.T) {
+	compileT(t, "[1,2,3].reverse.take(2)", func(res *llx.CodeBundle) {
+		assertFunction(t, "reverse", &llx.Function{
+			Type:    string(types.Array(types.Int)),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "take", &llx.Function{
+			Type:    string(types.Array(types.Int)),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.IntPrimitive(2)},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileErroneous(t, "[1,2,3].skip('a')", errors.New("incorrect argument 0: expected int got string"), nil)
+}
+
+func TestCompiler_ArrayAggregate(t *testing.T) {
+	compileT(t, "[1,2,3].sum", func(res *llx.CodeBundle) {
+		assertFunction(t, "sum", &llx.Function{
+			Type:    string(types.Int),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "[1,2,3].avg", func(res *llx.CodeBundle) {
+		assertFunction(t, "avg", &llx.Function{
+			Type:    string(types.Float),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "[1.5,2.5].max", func(res *llx.CodeBundle) {
+		assertFunction(t, "max", &llx.Function{
+			Type:    string(types.Float),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "['a'].sum", errors.New("cannot compute 'sum' of array of string, try calling 'sum' with a field or expression"), nil)
+}
+
 //    =================
 //   👋   RESOURCES   🍹
 //    =================
@@ -1163,6 +1229,92 @@ func TestCompiler_ResourceFieldGlob(t *testing.T) {
 	})
 }
 
+func TestCompiler_ResourceSort(t *testing.T) {
+	compileT(t, "users.sort(uid).take(3)", func(res *llx.CodeBundle) {
+		assertFunction(t, "users", nil, res.CodeV2.Blocks[0].Chunks[0])
+		assertFunction(t, "list", &llx.Function{
+			Type:    string(types.Array(types.Resource("user"))),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "fieldSort", &llx.Function{
+			Type:    string(types.Array(types.Resource("user"))),
+			Binding: (1 << 32) | 2,
+			Args: []*llx.Primitive{
+				llx.RefPrimitiveV2((1 << 32) | 2),
+				llx.FunctionPrimitive(2 << 32),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+		assertFunction(t, "take", &llx.Function{
+			Type:    string(types.Array(types.Resource("user"))),
+			Binding: (1 << 32) | 3,
+			Args:    []*llx.Primitive{llx.IntPrimitive(3)},
+		}, res.CodeV2.Blocks[0].Chunks[3])
+	})
+}
+
+func TestCompiler_ResourceAggregate(t *testing.T) {
+	compileT(t, "users.median(uid)", func(res *llx.CodeBundle) {
+		assertFunction(t, "users", nil, res.CodeV2.Blocks[0].Chunks[0])
+		assertFunction(t, "list", &llx.Function{
+			Type:    string(types.Array(types.Resource("user"))),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "fieldMedian", &llx.Function{
+			Type:    string(types.Float),
+			Binding: (1 << 32) | 2,
+			Args: []*llx.Primitive{
+				llx.RefPrimitiveV2((1 << 32) | 2),
+				llx.FunctionPrimitive(2 << 32),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileErroneous(t, "users.sum(name)", errors.New("cannot compute 'sum' of values of type string"), nil)
+}
+
+func TestCompiler_ResourceGroupBy(t *testing.T) {
+	compileT(t, "users.groupBy(gid)", func(res *llx.CodeBundle) {
+		assertFunction(t, "groupBy", &llx.Function{
+			Type:    string(types.Map(types.Int, types.Array(types.Resource("user")))),
+			Binding: (1 << 32) | 2,
+			Args: []*llx.Primitive{
+				llx.RefPrimitiveV2((1 << 32) | 2),
+				llx.FunctionPrimitive(2 << 32),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileT(t, "users.countBy(shell)", func(res *llx.CodeBundle) {
+		assertFunction(t, "countBy", &llx.Function{
+			Type:    string(types.Map(types.String, types.Int)),
+			Binding: (1 << 32) | 2,
+			Args: []*llx.Primitive{
+				llx.RefPrimitiveV2((1 << 32) | 2),
+				llx.FunctionPrimitive(2 << 32),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileT(t, "users.groupBy(gid)[1001]", func(res *llx.CodeBundle) {
+		assertFunction(t, "[]", &llx.Function{
+			Type:    string(types.Array(types.Resource("user"))),
+			Binding: (1 << 32) | 3,
+			Args:    []*llx.Primitive{llx.IntPrimitive(1001)},
+		}, res.CodeV2.Blocks[0].Chunks[3])
+	})
+
+	compileT(t, "users.groupBy(name).keys", func(res *llx.CodeBundle) {
+		assertFunction(t, "keys", &llx.Function{
+			Type:    string(types.Array(types.String)),
+			Binding: (1 << 32) | 3,
+		}, res.CodeV2.Blocks[0].Chunks[3])
+	})
+
+	compileErroneous(t, "users.groupBy(gid)['1001']", errors.New("incorrect argument 0: expected int got string"), nil)
+	compileErroneous(t, "users.groupBy()", errors.New("function 'groupBy' needs exactly one argument"), nil)
+	compileErroneous(t, "users.countBy(authorizedkeys)", errors.New("cannot group by values of type authorizedkeys when calling 'countBy'"), nil)
+}
+
 func TestCompiler_ArrayResourceFieldGlob(t *testing.T) {
 	compileT(t, "groups { * }", func(res *llx.CodeBundle) {
 		assertFunction(t, "groups", nil, res.CodeV2.Blocks[0].Chunks[0])
@@ -1537,6 +1689,260 @@ func TestCompiler_StringContainsWithInt(t *testing.T) {
 	})
 }
 
+func TestCompiler_StringMethods(t *testing.T) {
+	compileT(t, "'a-b'.replace('-', '+')", func(res *llx.CodeBundle) {
+		assertFunction(t, "replace", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive("-"), llx.StringPrimitive("+")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "'a=b'.find(/(\\w)=(\\w)/)", func(res *llx.CodeBundle) {
+		assertFunction(t, "find", &llx.Function{
+			Type:    string(types.Array(types.Array(types.String))),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.RegexPrimitive("(\\w)=(\\w)")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "'%s=%d'.format('a', 1)", func(res *llx.CodeBundle) {
+		assertFunction(t, "format", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive("a"), llx.IntPrimitive(1)},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "'abc'.replaceAll('b', 'c')", errors.New("incorrect argument 0: expected regex got string"), nil)
+	compileErroneous(t, "'abc'.padLeft()", errors.New("no arguments given (expected 1-2)"), nil)
+}
+
+func TestCompiler_Version(t *testing.T) {
+	compileT(t, "version('1:1.0', type: 'deb')", func(res *llx.CodeBundle) {
+		assertFunction(t, "version", &llx.Function{
+			Type: string(types.Version),
+			Args: []*llx.Primitive{llx.StringPrimitive("1:1.0"), llx.StringPrimitive("deb")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+	})
+
+	compileT(t, "version('1.2') >= '1.1'", func(res *llx.CodeBundle) {
+		assertFunction(t, ">="+string(types.String), &llx.Function{
+			Type:    string(types.Bool),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive("1.1")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "version('1.2').inRange('<2.0, >=1.4')", func(res *llx.CodeBundle) {
+		assertFunction(t, "inRange", &llx.Function{
+			Type:    string(types.Bool),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive("<2.0, >=1.4")},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "version(1)", errors.New("called 'version' with int, expected a string"), nil)
+	compileT(t, "version('1:1.0', 'deb')", func(res *llx.CodeBundle) {
+		assertFunction(t, "version", &llx.Function{
+			Type: string(types.Version),
+			Args: []*llx.Primitive{llx.StringPrimitive("1:1.0"), llx.StringPrimitive("deb")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+	})
+
+	compileT(t, "[version('1.2'), version('1.10')].sort", func(res *llx.CodeBundle) {
+		assertFunction(t, "sort", &llx.Function{
+			Type:    string(types.Array(types.Version)),
+			Binding: (1 << 32) | 3,
+		}, res.CodeV2.Blocks[0].Chunks[3])
+	})
+
+	compileT(t, "[version('1.2'), version('1.10')].max", func(res *llx.CodeBundle) {
+		assertFunction(t, "max", &llx.Function{
+			Type:    string(types.Version),
+			Binding: (1 << 32) | 3,
+		}, res.CodeV2.Blocks[0].Chunks[3])
+	})
+
+	compileErroneous(t, "version(1)", errors.New("called 'version' with int, expected a string"), nil)
+	compileErroneous(t, "version('1.2', format: 'deb')", errors.New("the second argument of 'version' must be the type, e.g. version(\"1.2\", \"deb\") or version(\"1.2\", type: \"deb\")"), nil)
+	compileErroneous(t, "[version('1.2')].sum", errors.New("cannot compute 'sum' of array of version, try calling 'sum' with a field or expression"), nil)
+}
+
+func TestCompiler_IP(t *testing.T) {
+	compileT(t, "ip('10.0.0.1').isPrivate", func(res *llx.CodeBundle) {
+		assertFunction(t, "ip", &llx.Function{
+			Type: string(types.IP),
+			Args: []*llx.Primitive{llx.StringPrimitive("10.0.0.1")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+		assertFunction(t, "isPrivate", &llx.Function{
+			Type:    string(types.Bool),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "cidr('10.0.0.0/8').contains(ip('10.0.0.1'))", func(res *llx.CodeBundle) {
+		assertFunction(t, "cidr", &llx.Function{
+			Type: string(types.CIDR),
+			Args: []*llx.Primitive{llx.StringPrimitive("10.0.0.0/8")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+		assertFunction(t, "contains", &llx.Function{
+			Type:    string(types.Bool),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.RefPrimitiveV2((1 << 32) | 2)},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileT(t, "port.address.contains('10.')", func(res *llx.CodeBundle) {
+		assertFunction(t, "contains"+string(types.String), &llx.Function{
+			Type:    string(types.Bool),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.StringPrimitive("10.")},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileT(t, "port.ip.isPrivate", func(res *llx.CodeBundle) {
+		assertFunction(t, "ip", &llx.Function{
+			Type:    string(types.IP),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "socket.ip.isPrivate", func(res *llx.CodeBundle) {
+		assertFunction(t, "ip", &llx.Function{
+			Type:    string(types.IP),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "ip(1)", errors.New("called 'ip' with int, expected a string"), nil)
+	compileErroneous(t, "cidr(version('1.0'))", errors.New("called 'cidr' with version, expected a string"), nil)
+}
+
+func TestCompiler_Duration(t *testing.T) {
+	compileT(t, "duration('90d').days", func(res *llx.CodeBundle) {
+		assertFunction(t, "duration", &llx.Function{
+			Type: string(types.Duration),
+			Args: []*llx.Primitive{llx.StringPrimitive("90d")},
+		}, res.CodeV2.Blocks[0].Chunks[0])
+		assertFunction(t, "days", &llx.Function{
+			Type:    string(types.Int),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "x = 3 * duration('1d'); x.hours", func(res *llx.CodeBundle) {
+		assertFunction(t, "*"+string(types.Duration), &llx.Function{
+			Type:    string(types.Duration),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.RefPrimitiveV2((1 << 32) | 2)},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+		assertFunction(t, "hours", &llx.Function{
+			Type:    string(types.Int),
+			Binding: (1 << 32) | 4,
+		}, res.CodeV2.Blocks[0].Chunks[4])
+	})
+
+	compileT(t, "time.now - 3 * time.month", func(res *llx.CodeBundle) {
+		assertFunction(t, string(types.Resource("time"))+".month", &llx.Function{
+			Type:    string(types.Duration),
+			Binding: (1 << 32) | 4,
+		}, res.CodeV2.Blocks[0].Chunks[4])
+		assertFunction(t, "-"+string(types.Duration), &llx.Function{
+			Type:    string(types.Time),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.RefPrimitiveV2((1 << 32) | 6)},
+		}, res.CodeV2.Blocks[0].Chunks[6])
+	})
+
+	compileT(t, "time.parse('2024-01-31 10:00', '2006-01-02 15:04', 'Europe/Berlin').format('rfc3339', 'UTC')", func(res *llx.CodeBundle) {
+		assertFunction(t, string(types.Resource("time"))+".parse", &llx.Function{
+			Type:    string(types.Time),
+			Binding: (1 << 32) | 1,
+			Args: []*llx.Primitive{
+				llx.StringPrimitive("2024-01-31 10:00"),
+				llx.StringPrimitive("2006-01-02 15:04"),
+				llx.StringPrimitive("Europe/Berlin"),
+			},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "format", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.StringPrimitive("rfc3339"), llx.StringPrimitive("UTC")},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+	})
+
+	compileT(t, "time.day", func(res *llx.CodeBundle) {
+		assertFunction(t, string(types.Resource("time"))+".day", &llx.Function{
+			Type:    string(types.Duration),
+			Binding: (1 << 32) | 1,
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "duration('2d') / 2", func(res *llx.CodeBundle) {
+		assertFunction(t, "/"+string(types.Int), &llx.Function{
+			Type:    string(types.Duration),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.IntPrimitive(2)},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileT(t, "-duration('1d')", func(res *llx.CodeBundle) {
+		assertFunction(t, "*"+string(types.Int), &llx.Function{
+			Type:    string(types.Duration),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.IntPrimitive(-1)},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	compileErroneous(t, "duration(true)", errors.New("called 'duration' with bool, expected a string"), nil)
+	compileErroneous(t, "-'a'", errors.New("cannot find operator handler: string * int"), nil)
+	compileErroneous(t, "time.month(1)", errors.New("time.month does not take any arguments"), nil)
+}
+
+func TestCompiler_StringInterpolation(t *testing.T) {
+	compileT(t, `"a ${'b'}"`, func(res *llx.CodeBundle) {
+		assertPrimitive(t, llx.StringPrimitive("a b"), res.CodeV2.Blocks[0].Chunks[0])
+	})
+
+	compileT(t, `"a ${'b' + 'c'}"`, func(res *llx.CodeBundle) {
+		assertPrimitive(t, llx.StringPrimitive("a "), res.CodeV2.Blocks[0].Chunks[0])
+		assertPrimitive(t, llx.StringPrimitive("b"), res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "+"+string(types.String), &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.StringPrimitive("c")},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+		assertFunction(t, "+"+string(types.String), &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.RefPrimitiveV2((1 << 32) | 3)},
+		}, res.CodeV2.Blocks[0].Chunks[3])
+	})
+
+	compileT(t, `"n ${1}"`, func(res *llx.CodeBundle) {
+		assertPrimitive(t, llx.StringPrimitive("n "), res.CodeV2.Blocks[0].Chunks[0])
+		assertPrimitive(t, llx.StringPrimitive("%v"), res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "format", &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.IntPrimitive(1)},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+		assertFunction(t, "+"+string(types.String), &llx.Function{
+			Type:    string(types.String),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.RefPrimitiveV2((1 << 32) | 3)},
+		}, res.CodeV2.Blocks[0].Chunks[3])
+		assert.Equal(t, []uint64{(1 << 32) | 4}, res.CodeV2.Blocks[0].Entrypoints)
+	})
+
+	compileT(t, `"a \${b}"`, func(res *llx.CodeBundle) {
+		assertPrimitive(t, llx.StringPrimitive("a ${b}"), res.CodeV2.Blocks[0].Chunks[0])
+	})
+
+	compileErroneous(t, `"a ${}"`, errors.New("string interpolation '${}' must contain exactly one expression at <source>:1:1"), nil)
+}
+
 func TestCompiler_CallWithResource(t *testing.T) {
 	compileT(t, "users { file(home) }", func(res *llx.CodeBundle) {
 		assertFunction(t, "users", nil, res.CodeV2.Blocks[0].Chunks[0])
@@ -1758,7 +2164,7 @@ func TestSuggestions(t *testing.T) {
 		{
 			// list resource with empty field call
 			"users.",
-			[]string{"all", "any", "contains", "length", "list", "map", "none", "one", "where"},
+			[]string{"all", "any", "avg", "contains", "countBy", "groupBy", "length", "list", "map", "max", "median", "min", "none", "one", "reverse", "skip", "sort", "sortDesc", "sum", "take", "where"},
 			errors.New("incomplete query, missing identifier after '.' at <source>:1:7"),
 			nil,
 		},
@@ -2058,3 +2464,36 @@ func allCodepoints(code *llx.CodeV2) ([]uint64, []uint64) {
 	}
 	return entrypoints, datapoints
 }
+
+func TestCompiler_Functions(t *testing.T) {
+	t.Run("inlined function", func(t *testing.T) {
+		fn, err := Compile("def inc(x int) { x + 1 }\ninc(2)", nil, conf)
+		require.NoError(t, err)
+		manual, err := Compile("x = 2\nx + 1", nil, conf)
+		require.NoError(t, err)
+		assert.Equal(t, manual.CodeV2.Checksums[manual.CodeV2.Entrypoints()[0]], fn.CodeV2.Checksums[fn.CodeV2.Entrypoints()[0]])
+	})
+
+	compileT(t, "def inc(x int) { x + 1 }\ninc(x: 2)", func(res *llx.CodeBundle) {
+		assertPrimitive(t, llx.IntPrimitive(2), res.CodeV2.Blocks[0].Chunks[0])
+		assertPrimitive(t, llx.RefPrimitiveV2((1<<32)|1), res.CodeV2.Blocks[0].Chunks[1])
+		assertFunction(t, "+"+string(types.Int), &llx.Function{
+			Type:    string(types.Int),
+			Binding: (1 << 32) | 2,
+			Args:    []*llx.Primitive{llx.IntPrimitive(1)},
+		}, res.CodeV2.Blocks[0].Chunks[2])
+		assert.Equal(t, []uint64{(1 << 32) | 3}, res.CodeV2.Blocks[0].Entrypoints)
+	})
+
+	compileT(t, "def valid(s string) { s != ''\ns.length < 10 }\nvalid('a')", func(res *llx.CodeBundle) {
+		entrypoints := res.CodeV2.Blocks[0].Entrypoints
+		require.Len(t, entrypoints, 1)
+		assert.Equal(t, types.Bool, res.CodeV2.Chunk(entrypoints[0]).Type())
+	})
+
+	compileErroneous(t, "def f(x int) { f(x) }\nf(1)", errors.New("failed to compile function 'f': function 'f' cannot call itself recursively"), nil)
+	compileErroneous(t, "def f(x int) { x }\nf()", errors.New("function 'f' expects 1 arguments, got 0"), nil)
+	compileErroneous(t, "def f(x int) { x }\nf('a')", errors.New("incorrect argument 'x' when calling function 'f': expected int got string"), nil)
+	compileErroneous(t, "def f(x nope) { x }\nf(1)", errors.New("failed to define function 'f': unknown type 'nope' for parameter 'x'"), nil)
+	compileErroneous(t, "def file(x int) { x }", errors.New("cannot define function 'file', there is already a resource with this name"), nil)
+}
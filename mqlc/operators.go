@@ -15,30 +15,34 @@ var operatorsCompilers map[string]fieldCompiler
 
 func init() {
 	operatorsCompilers = map[string]fieldCompiler{
-		"==":     compileComparable,
-		"=~":     compileComparable,
-		"!=":     compileComparable,
-		"!~":     compileComparable,
-		">=":     compileComparable,
-		">":      compileComparable,
-		"<=":     compileComparable,
-		"<":      compileComparable,
-		"+":      compileTransformation,
-		"-":      compileTransformation,
-		"*":      compileTransformation,
-		"/":      compileTransformation,
-		"%":      nil,
-		"=":      compileAssignment,
-		"||":     compileComparable,
-		"&&":     compileComparable,
-		"{}":     compileBlock,
-		"if":     compileIf,
-		"else":   compileElse,
-		"expect": compileExpect,
-		"score":  compileScore,
-		"typeof": compileTypeof,
-		"switch": compileSwitch,
-		"Never":  compileNever,
+		"==":       compileComparable,
+		"=~":       compileComparable,
+		"!=":       compileComparable,
+		"!~":       compileComparable,
+		">=":       compileComparable,
+		">":        compileComparable,
+		"<=":       compileComparable,
+		"<":        compileComparable,
+		"+":        compileTransformation,
+		"-":        compileSubtraction,
+		"*":        compileTransformation,
+		"/":        compileTransformation,
+		"%":        nil,
+		"=":        compileAssignment,
+		"||":       compileComparable,
+		"&&":       compileComparable,
+		"{}":       compileBlock,
+		"if":       compileIf,
+		"else":     compileElse,
+		"expect":   compileExpect,
+		"score":    compileScore,
+		"typeof":   compileTypeof,
+		"version":  compileVersion,
+		"ip":       compileIP,
+		"cidr":     compileCIDR,
+		"duration": compileDuration,
+		"switch":   compileSwitch,
+		"Never":    compileNever,
 	}
 }
 
@@ -153,9 +157,10 @@ func compileAssignment(c *compiler, id string, call *parser.Call) (types.Type, e
 	}
 
 	c.vars.add(name, variable{
-		name: name,
-		ref:  ref,
-		typ:  c.Result.CodeV2.Chunk(ref).Type(),
+		name:     name,
+		ref:      ref,
+		typ:      c.Result.CodeV2.Chunk(ref).Type(),
+		callback: c.lintVariable(name, varIdent.Value.Operand),
 	})
 
 	return types.Nil, nil
@@ -198,6 +203,7 @@ func compileComparable(c *compiler, id string, call *parser.Call) (types.Type, e
 			return types.Nil, err
 		}
 	}
+	c.lintComparison(id, call, left, lt, rt, name)
 
 	c.addChunk(&llx.Chunk{
 		Call: llx.Chunk_FUNCTION,
@@ -219,6 +225,20 @@ func compileComparable(c *compiler, id string, call *parser.Call) (types.Type, e
 	return types.Bool, nil
 }
 
+// compileSubtraction compiles a - b, as well as the unary minus -a, which
+// is compiled as a * -1
+func compileSubtraction(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) == 1 {
+		minusOne := int64(-1)
+		call = &parser.Call{Function: []*parser.Arg{
+			call.Function[0],
+			{Value: &parser.Expression{Operand: &parser.Operand{Value: &parser.Value{Int: &minusOne}}}},
+		}}
+		return compileTransformation(c, "*", call)
+	}
+	return compileTransformation(c, id, call)
+}
+
 func compileTransformation(c *compiler, id string, call *parser.Call) (types.Type, error) {
 	leftRef, left, right, _, err := compileABOperation(c, id, call)
 	if err != nil {
@@ -264,7 +284,7 @@ func compileTransformation(c *compiler, id string, call *parser.Call) (types.Typ
 		},
 	})
 
-	return lt, nil
+	return returnType, nil
 }
 
 func (c *compiler) generateEntrypoints(arg *llx.Primitive) error {
@@ -483,6 +503,118 @@ func compileTypeof(c *compiler, id string, call *parser.Call) (types.Type, error
 	return types.String, nil
 }
 
+// compileVersion creates a version from a string, with an optional
+// ecosystem that decides how it is compared, e.g. version("1.2", "deb") or
+// version("1.2", type: "deb")
+func compileVersion(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) < 1 {
+		return types.Nil, errors.New("missing parameter for '" + id + "', it requires 1")
+	}
+	if len(call.Function) > 2 {
+		return types.Nil, errors.New("too many arguments for '" + id + "', it takes a version and an optional type")
+	}
+
+	arg := call.Function[0]
+	if arg.Name != "" {
+		return types.Nil, errors.New("the first argument of '" + id + "' must not be named")
+	}
+	argValue, err := c.compileExpression(arg.Value)
+	if err != nil {
+		return types.Nil, err
+	}
+	typ := types.Type(argValue.Type)
+	if typ == types.Ref {
+		typ = (&llx.Chunk{Primitive: argValue}).DereferencedTypeV2(c.Result.CodeV2)
+	}
+	if typ != types.String && typ != types.Dict && typ != types.Version {
+		return types.Nil, errors.New("called '" + id + "' with " + typ.Label() + ", expected a string")
+	}
+	args := []*llx.Primitive{argValue}
+
+	if len(call.Function) == 2 {
+		arg := call.Function[1]
+		if arg.Name != "" && arg.Name != "type" {
+			return types.Nil, errors.New("the second argument of '" + id + "' must be the type, e.g. version(\"1.2\", \"deb\") or version(\"1.2\", type: \"deb\")")
+		}
+		ecosystem, err := c.compileExpression(arg.Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		args = append(args, ecosystem)
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   "version",
+		Function: &llx.Function{
+			Type: string(types.Version),
+			Args: args,
+		},
+	})
+
+	return types.Version, nil
+}
+
+// compileIP creates an IP address from a string, e.g. ip("10.0.0.1")
+func compileIP(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	return compileParse(c, id, call, types.IP, types.String, types.Dict)
+}
+
+// compileCIDR creates a network from a string or an IP address,
+// e.g. cidr("10.0.0.0/8")
+func compileCIDR(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	return compileParse(c, id, call, types.CIDR, types.String, types.Dict, types.IP)
+}
+
+// compileDuration creates a duration from a string, e.g. duration("90d"),
+// from a number of seconds, or from a time that is used as a duration
+func compileDuration(c *compiler, id string, call *parser.Call) (types.Type, error) {
+	return compileParse(c, id, call, types.Duration, types.String, types.Dict, types.Int, types.Float, types.Time)
+}
+
+// compileParse compiles global functions which turn one value into the
+// given type by parsing it, e.g. ip("10.0.0.1")
+func compileParse(c *compiler, id string, call *parser.Call, typ types.Type, from ...types.Type) (types.Type, error) {
+	if call == nil || len(call.Function) < 1 {
+		return types.Nil, errors.New("missing parameter for '" + id + "', it requires 1")
+	}
+	if len(call.Function) > 1 {
+		return types.Nil, errors.New("too many arguments for '" + id + "', it requires 1")
+	}
+
+	arg := call.Function[0]
+	if arg.Name != "" {
+		return types.Nil, errors.New("called '" + id + "' with a named argument, which is not supported")
+	}
+	argValue, err := c.compileExpression(arg.Value)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	argType := types.Type(argValue.Type)
+	if argType == types.Ref {
+		argType = (&llx.Chunk{Primitive: argValue}).DereferencedTypeV2(c.Result.CodeV2)
+	}
+	supported := argType == typ
+	for i := range from {
+		supported = supported || argType == from[i]
+	}
+	if !supported {
+		return types.Nil, errors.New("called '" + id + "' with " + argType.Label() + ", expected a string")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type: string(typ),
+			Args: []*llx.Primitive{argValue},
+		},
+	})
+
+	return typ, nil
+}
+
 func compileSwitch(c *compiler, id string, call *parser.Call) (types.Type, error) {
 	var ref *llx.Primitive
 
@@ -0,0 +1,528 @@
+package parser
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxLineWidth is the width up to which blocks, arrays, and maps are kept
+// on one line
+const maxLineWidth = 80
+
+var (
+	reIdent         = regexp.MustCompile(`^[a-zA-Z$_][a-zA-Z0-9_]*$`)
+	reRegexModifier = regexp.MustCompile(`^\(\?([msi]+)\)`)
+)
+
+// Format prints the AST as canonical MQL. Comments are kept with the code
+// they belong to. Parsing the result again leads to the same AST.
+func Format(ast *AST) string {
+	if ast == nil {
+		return ""
+	}
+	p := printer{ast: ast}
+	p.expressions(ast.Expressions, 0)
+	return p.res.String()
+}
+
+type printer struct {
+	ast *AST
+	res strings.Builder
+}
+
+func (p *printer) write(s string) {
+	p.res.WriteString(s)
+}
+
+func (p *printer) newline(indent int) {
+	p.res.WriteByte('\n')
+	p.res.WriteString(strings.Repeat("  ", indent))
+}
+
+// trimNewline removes the line break and indentation at the end of the
+// code, if there is one
+func (p *printer) trimNewline() bool {
+	res := strings.TrimRight(p.res.String(), " ")
+	if !strings.HasSuffix(res, "\n") || strings.TrimSpace(res) == "" {
+		return false
+	}
+	p.res.Reset()
+	p.res.WriteString(strings.TrimSuffix(res, "\n"))
+	return true
+}
+
+// inline prints the code with a new printer, so that it can be measured
+// before it is written
+func (p *printer) inline(indent int, f func(p *printer)) string {
+	c := printer{ast: p.ast}
+	f(&c)
+	return c.res.String()
+}
+
+// comments prints every line of a node's comments in front of the code
+// that follows it at the given indentation. Trailing comments start at the
+// end of the line before.
+func (p *printer) comments(node interface{}, comments string, indent int) {
+	if comments == "" {
+		return
+	}
+	lines := strings.Split(strings.TrimSuffix(comments, "\n"), "\n")
+	for i := range lines {
+		if i == 0 && p.ast.TrailingComment(node) && p.trimNewline() {
+			p.write(" ")
+		}
+		if lines[i] == "" {
+			p.write("//")
+		} else {
+			p.write("// " + lines[i])
+		}
+		p.newline(indent)
+	}
+}
+
+// expressions prints a list of expressions, one per line
+func (p *printer) expressions(list []*Expression, indent int) {
+	for i := range list {
+		if i != 0 {
+			prev := list[i-1]
+			cur := list[i]
+			switch {
+			case isReturn(prev) && !hasComments(cur):
+				p.write(" ")
+			case continuesIf(prev, cur):
+				p.write(" ")
+			case startsWithLiteral(cur):
+				// otherwise it would be read as an accessor or block of
+				// the previous expression
+				p.write(";")
+				p.newline(indent)
+			default:
+				p.newline(indent)
+			}
+		}
+		p.expression(list[i], indent)
+	}
+}
+
+func isReturn(x *Expression) bool {
+	return x != nil && x.Operand != nil && len(x.Operations) == 0 &&
+		x.Operand.Value != nil && x.Operand.Value.Ident != nil && *x.Operand.Value.Ident == "return" &&
+		len(x.Operand.Calls) == 0 && len(x.Operand.Block) == 0
+}
+
+// continuesIf is true if the expression is the else of an if, e.g. the
+// else in if (a) { 1 } else { 2 }, or the if of an else if
+func continuesIf(prev *Expression, cur *Expression) bool {
+	if hasComments(cur) || !isKeyword(prev) || !isKeyword(cur) {
+		return false
+	}
+	prevIdent, curIdent := *prev.Operand.Value.Ident, *cur.Operand.Value.Ident
+	switch {
+	case curIdent == "else":
+		return prevIdent == "if" && prev.Operand.Block != nil || prevIdent == "else" && prev.Operand.Block != nil
+	case curIdent == "if":
+		return prevIdent == "else" && prev.Operand.Block == nil
+	default:
+		return false
+	}
+}
+
+// isKeyword is true if the expression starts with an identifier and has
+// no operations, e.g. if (a) { 1 }
+func isKeyword(x *Expression) bool {
+	return x != nil && x.Operand != nil && len(x.Operations) == 0 &&
+		x.Operand.Value != nil && x.Operand.Value.Ident != nil
+}
+
+func hasComments(x *Expression) bool {
+	return x != nil && x.Operand != nil && x.Operand.Comments != ""
+}
+
+func startsWithLiteral(x *Expression) bool {
+	return x != nil && x.Operand != nil && x.Operand.Comments == "" && x.Operand.Value != nil &&
+		(x.Operand.Value.Array != nil || x.Operand.Value.Map != nil)
+}
+
+func (p *printer) expression(x *Expression, indent int) {
+	if x == nil {
+		return
+	}
+	if x.Operand != nil && x.Operand.Value == nil && len(x.Operand.Calls) == 0 && x.Operand.Block == nil {
+		// comment-only expressions, e.g. at the end of a block
+		p.comments(x.Operand, x.Operand.Comments, indent)
+		p.trimNewline()
+		return
+	}
+
+	p.operand(x.Operand, indent)
+	for i := range x.Operations {
+		op := x.Operations[i]
+		p.write(" " + op.Operator.String())
+		if op.Operand != nil && op.Operand.Comments != "" {
+			p.newline(indent + 1)
+			p.operand(op.Operand, indent+1)
+		} else {
+			p.write(" ")
+			p.operand(op.Operand, indent)
+		}
+	}
+}
+
+func (p *printer) operand(o *Operand, indent int) {
+	if o == nil {
+		return
+	}
+	p.comments(o, o.Comments, indent)
+
+	if x, ok := negation(o); ok {
+		p.write("-")
+		p.expression(x, indent)
+		return
+	}
+
+	if left, op, right, ok := processedOperator(o); ok {
+		p.expression(left, indent)
+		p.write(" " + op + " ")
+		p.expression(right, indent)
+		return
+	}
+
+	if o.Value != nil && o.Value.Ident != nil && *o.Value.Ident == "switch" && o.Block != nil {
+		p.value(o.Value, indent)
+		p.calls(o.Calls, indent)
+		p.switchBlock(o.Block, indent)
+		return
+	}
+
+	p.value(o.Value, indent)
+	if o.Value != nil && o.Value.Ident != nil && *o.Value.Ident == "if" && len(o.Calls) != 0 && o.Calls[0].Ident == nil && o.Calls[0].Accessor == nil {
+		p.write(" ")
+	}
+	p.calls(o.Calls, indent)
+	p.block(o.Block, indent)
+}
+
+// processedOperator detects operators which were turned into calls, e.g.
+// a == b into ==(a, b), as it happens for switch cases and interpolations
+func processedOperator(o *Operand) (*Expression, string, *Expression, bool) {
+	if o.Value == nil || o.Value.Ident == nil || len(o.Calls) != 1 || o.Block != nil {
+		return nil, "", nil, false
+	}
+	if _, ok := operatorsMap[*o.Value.Ident]; !ok {
+		return nil, "", nil, false
+	}
+	call := o.Calls[0]
+	if len(call.Function) != 2 || call.Comments != "" || call.Function[0].Name != "" || call.Function[1].Name != "" {
+		return nil, "", nil, false
+	}
+	return call.Function[0].Value, *o.Value.Ident, call.Function[1].Value, true
+}
+
+// negation detects the unary minus, e.g. -duration("1d"), which is parsed
+// into a call of the "-" operator with only one argument
+func negation(o *Operand) (*Expression, bool) {
+	if o.Value == nil || o.Value.Ident == nil || *o.Value.Ident != "-" || len(o.Calls) != 1 || o.Block != nil {
+		return nil, false
+	}
+	call := o.Calls[0]
+	if len(call.Function) != 1 || call.Comments != "" || call.Function[0].Name != "" {
+		return nil, false
+	}
+	return call.Function[0].Value, true
+}
+
+func (p *printer) value(v *Value, indent int) {
+	switch {
+	case v == nil:
+		return
+	case v.Bool != nil:
+		p.write(strconv.FormatBool(*v.Bool))
+	case v.Int != nil:
+		p.write(strconv.FormatInt(*v.Int, 10))
+	case v.Float != nil:
+		p.write(formatFloat(*v.Float))
+	case v.String != nil:
+		p.write(quote(*v.String))
+	case v.Regex != nil:
+		p.write(formatRegex(*v.Regex))
+	case v.Ident != nil:
+		p.write(*v.Ident)
+	case v.Interpolation != nil:
+		p.interpolation(v.Interpolation)
+	case v.Array != nil:
+		p.array(v.Array, indent)
+	case v.Map != nil:
+		p.mapValue(v.Map, indent)
+	case v.Function != nil:
+		p.function(v.Function, indent)
+	default:
+		p.write("null")
+	}
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	}
+	res := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(res, ".") {
+		res += ".0"
+	}
+	return res
+}
+
+func formatRegex(re string) string {
+	mods := ""
+	if m := reRegexModifier.FindStringSubmatch(re); m != nil {
+		mods = m[1]
+		re = re[len(m[0]):]
+	}
+	return "/" + re + "/" + mods
+}
+
+// escapes are the characters that double-quoted strings can escape
+var escapes = map[rune]string{
+	'\\': `\\`,
+	'"':  `\"`,
+	'\n': `\n`,
+	'\t': `\t`,
+	'\v': `\v`,
+	'\b': `\b`,
+	'\f': `\f`,
+	0:    `\0`,
+}
+
+// quote a string. Strings are double-quoted, unless they contain characters
+// that would have to be escaped, in which case we prefer raw strings.
+func quote(s string) string {
+	escaped := strings.Contains(s, "${")
+	control := false
+	rawOnly := false
+	for _, r := range s {
+		_, ok := escapes[r]
+		switch {
+		case r < ' ' && !ok:
+			// these can't be escaped and only survive in raw strings
+			rawOnly = true
+		case r < ' ':
+			control = true
+			escaped = true
+		case ok:
+			escaped = true
+		}
+	}
+
+	switch {
+	case !escaped && !rawOnly:
+		return `"` + s + `"`
+	case (rawOnly || !control) && !strings.Contains(s, "'") && !endsInEscape(s):
+		return "'" + s + "'"
+	case rawOnly && !strings.Contains(s, "`"):
+		return "`" + s + "`"
+	default:
+		return `"` + escape(s) + `"`
+	}
+}
+
+// endsInEscape is true if the closing quote of a raw string would be escaped
+// by the string's trailing backslash
+func endsInEscape(s string) bool {
+	n := len(s) - len(strings.TrimRight(s, `\`))
+	return n%2 == 1
+}
+
+func escape(s string) string {
+	var res strings.Builder
+	for i, r := range s {
+		if e, ok := escapes[r]; ok {
+			res.WriteString(e)
+			continue
+		}
+		if r == '$' && strings.HasPrefix(s[i:], "${") {
+			res.WriteString(`\$`)
+			continue
+		}
+		res.WriteRune(r)
+	}
+	return res.String()
+}
+
+func (p *printer) interpolation(parts []*Expression) {
+	p.write(`"`)
+	for i := range parts {
+		part := parts[i]
+		if str := stringLiteral(part); str != nil {
+			p.write(escape(*str))
+			continue
+		}
+		p.write("${")
+		p.expression(part, 0)
+		p.write("}")
+	}
+	p.write(`"`)
+}
+
+// list prints items inline if they fit, otherwise one per line
+func (p *printer) list(open string, close string, n int, item func(p *printer, i int, indent int), indent int) {
+	if n == 0 {
+		p.write(open + close)
+		return
+	}
+
+	items := p.inline(indent, func(c *printer) {
+		for i := 0; i < n; i++ {
+			if i != 0 {
+				c.write(", ")
+			}
+			item(c, i, indent)
+		}
+	})
+	if !strings.Contains(items, "\n") && len(items)+2*indent+len(open)+len(close) <= maxLineWidth {
+		p.write(open + items + close)
+		return
+	}
+
+	p.write(open)
+	for i := 0; i < n; i++ {
+		p.newline(indent + 1)
+		item(p, i, indent+1)
+		p.write(",")
+	}
+	p.newline(indent)
+	p.write(close)
+}
+
+func (p *printer) array(list []*Expression, indent int) {
+	p.list("[", "]", len(list), func(p *printer, i int, indent int) {
+		p.expression(list[i], indent)
+	}, indent)
+}
+
+func (p *printer) mapValue(m map[string]*Expression, indent int) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	p.list("{", "}", len(keys), func(p *printer, i int, indent int) {
+		key := keys[i]
+		if reIdent.MatchString(key) {
+			p.write(key)
+		} else {
+			p.write(quote(key))
+		}
+		p.write(": ")
+		p.expression(m[key], indent)
+	}, indent)
+}
+
+func (p *printer) function(f *Function, indent int) {
+	p.write("def " + f.Name + "(")
+	for i := range f.Params {
+		if i != 0 {
+			p.write(", ")
+		}
+		p.write(f.Params[i].Name + " " + f.Params[i].Type)
+	}
+	p.write(") ")
+	p.body(f.Body, indent)
+}
+
+func (p *printer) calls(calls []*Call, indent int) {
+	for i := range calls {
+		call := calls[i]
+		switch {
+		case call.Ident != nil:
+			if call.Comments != "" {
+				p.newline(indent + 1)
+				p.comments(call, call.Comments, indent+1)
+			}
+			p.write("." + *call.Ident)
+
+		case call.Accessor != nil:
+			p.write("[")
+			p.expression(call.Accessor, indent)
+			p.write("]")
+
+		default:
+			p.write("(")
+			for j := range call.Function {
+				if j != 0 {
+					p.write(", ")
+				}
+				arg := call.Function[j]
+				if arg.Name != "" {
+					p.write(arg.Name + ": ")
+				}
+				p.expression(arg.Value, indent)
+			}
+			p.write(")")
+		}
+	}
+}
+
+func (p *printer) block(block []*Expression, indent int) {
+	if block == nil {
+		return
+	}
+	p.write(" ")
+	p.body(block, indent)
+}
+
+// body prints the expressions of a block in curly brackets. Blocks that
+// fit on one line are kept there.
+func (p *printer) body(list []*Expression, indent int) {
+	if len(list) == 0 {
+		p.write("{}")
+		return
+	}
+
+	line := p.inline(indent, func(c *printer) {
+		c.expressions(list, indent)
+	})
+	if !strings.Contains(line, "\n") && len(line)+2*indent+4 <= maxLineWidth {
+		p.write("{ " + line + " }")
+		return
+	}
+
+	p.write("{")
+	p.newline(indent + 1)
+	p.expressions(list, indent+1)
+	p.newline(indent)
+	p.write("}")
+}
+
+// switchBlock prints the cases of a switch, which are stored as pairs of
+// their condition (nil for default) and the block that follows
+func (p *printer) switchBlock(block []*Expression, indent int) {
+	p.write(" {")
+	for i := 0; i+1 < len(block); i += 2 {
+		if i != 0 {
+			p.write(";")
+		}
+		p.newline(indent)
+		if block[i] == nil {
+			p.write("default:")
+		} else {
+			p.write("case ")
+			p.expression(block[i], indent)
+			p.write(":")
+		}
+
+		var body []*Expression
+		if block[i+1] != nil && block[i+1].Operand != nil {
+			body = block[i+1].Operand.Block
+		}
+		p.newline(indent + 1)
+		p.expressions(body, indent+1)
+	}
+	p.newline(indent)
+	p.write("}")
+}
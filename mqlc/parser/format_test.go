@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		code string
+		res  string
+	}{
+		{"", ""},
+		{"true", "true"},
+		{"null", "null"},
+		{"1.50", "1.5"},
+		{"3.0", "3.0"},
+		{"010", "8"},
+		{"NaN", "NaN"},
+		{"'hi'", `"hi"`},
+		{`"it's"`, `"it's"`},
+		{`"say \"hi\""`, `'say "hi"'`},
+		{`'C:\Windows'`, `'C:\Windows'`},
+		{`"a\nb"`, `"a\nb"`},
+		{`"a\n'b'"`, `"a\n'b'"`},
+		{`"\${a}"`, `'${a}'`},
+		{"/ab+c/i", "/ab+c/i"},
+		{`"id: ${ uid  + 1 }"`, `"id: ${uid + 1}"`},
+		{`"x ${ "y" } z"`, `"x y z"`},
+		{`"${'}'}"`, `"}"`},
+		{`"${ {a:1}['a'] }"`, `"${{a: 1}["a"]}"`},
+		{`'single\'s'`, `"single's"`},
+		{`'a\\'`, `'a\\'`},
+		{`'C:\'`, `"C:\\"`},
+		{`"a\\"`, `"a\\"`},
+		{"[1,2 ,3]", "[1, 2, 3]"},
+		{"{b: 1, 'a': 2, 'a b': 3}", `{a: 2, "a b": 3, b: 1}`},
+		{"a==1&&b!=2", "a == 1 && b != 2"},
+		{"x=  -1", "x = -1"},
+		{"- 1", "-1"},
+		{"a - -b", "a - -b"},
+		{"-  duration('1d') * 2", `-duration("1d") * 2`},
+		{"sshd.config.params['Ciphers']", `sshd.config.params["Ciphers"]`},
+		{"users.where( name=='root' ).list {name uid}", "users.where(name == \"root\").list {\n  name\n  uid\n}"},
+		{"users.list{name}", "users.list { name }"},
+		{"users { * }", "users { * }"},
+		{"users {}", "users {}"},
+		{"parse.date(value: 'x', format: 'y')", `parse.date(value: "x", format: "y")`},
+		{"a\n\n\nb", "a\nb"},
+		{"a; [1]", "a;\n[1]"},
+		{"// check root\n#   and more\nuser.name=='root' // trailing", "// check root\n//   and more\nuser.name == \"root\" // trailing"},
+		{"[1, // one\n2]", "[\n  1, // one\n  2,\n]"},
+		{"a { // first\nx }", "a { // first\n  x\n}"},
+		{"if (a) {1} else if (b) {2}\nelse {3}", "if (a) { 1 } else if (b) { 2 } else { 3 }"},
+		{"users.list\n// only some\n.where(uid==0)", "users.list\n  // only some\n  .where(uid == 0)"},
+		{"def root(u users.user) {u.uid==0}\nusers.all(root(_))", "def root(u users.user) { u.uid == 0 }\nusers.all(root(_))"},
+		{"def f() { return   1 }", "def f() { return 1 }"},
+		{
+			"switch(x) { case _ > 0: true; case _<0: a=1\n a==1; default: false }",
+			"switch(x) {\ncase _ > 0:\n  true;\ncase _ < 0:\n  a = 1\n  a == 1;\ndefault:\n  false\n}",
+		},
+		{
+			"['aaaaaaaaaaaaaaaaaaaa', 'bbbbbbbbbbbbbbbbbbbbbbbbbbb', 'cccccccccccccccccccccccc', 'ddd']",
+			"[\n  \"aaaaaaaaaaaaaaaaaaaa\",\n  \"bbbbbbbbbbbbbbbbbbbbbbbbbbb\",\n  \"cccccccccccccccccccccccc\",\n  \"ddd\",\n]",
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.code, func(t *testing.T) {
+			ast, err := Parse(test.code)
+			require.NoError(t, err)
+			res := Format(ast)
+			assert.Equal(t, test.res, res)
+
+			// formatting is stable
+			again, err := Parse(res)
+			require.NoError(t, err)
+			assert.Equal(t, res, Format(again))
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/alecthomas/participle/lexer"
+)
+
+// mqlDefinition lexes MQL with a regular expression, except for
+// double-quoted strings. Their ${..} interpolations may contain any
+// expression, including braces and other strings, so they are scanned
+// by hand.
+type mqlDefinition struct {
+	re      *regexp.Regexp
+	symbols map[string]rune
+}
+
+func newMqlDefinition(pattern string) *mqlDefinition {
+	re := regexp.MustCompile(pattern)
+	symbols := map[string]rune{
+		"EOF": lexer.EOF,
+	}
+	for i, sym := range re.SubexpNames()[1:] {
+		if sym != "" {
+			symbols[sym] = lexer.EOF - 1 - rune(i)
+		}
+	}
+	return &mqlDefinition{re: re, symbols: symbols}
+}
+
+func (d *mqlDefinition) Lex(r io.Reader) (lexer.Lexer, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return &mqlTokenizer{
+		pos: lexer.Position{
+			Filename: lexer.NameOfReader(r),
+			Line:     1,
+			Column:   1,
+		},
+		b:     b,
+		def:   d,
+		names: d.re.SubexpNames(),
+	}, nil
+}
+
+func (d *mqlDefinition) Symbols() map[string]rune {
+	return d.symbols
+}
+
+type mqlTokenizer struct {
+	pos   lexer.Position
+	b     []byte
+	def   *mqlDefinition
+	names []string
+}
+
+func (t *mqlTokenizer) Next() (lexer.Token, error) {
+nextToken:
+	for len(t.b) != 0 {
+		if t.b[0] == '"' {
+			end := doubleQuotedEnd(t.b, 0)
+			if end < 0 {
+				return lexer.Token{}, lexer.Errorf(t.pos, "invalid token %q", '"')
+			}
+			return t.consume(end+1, t.def.symbols["String"]), nil
+		}
+
+		matches := t.def.re.FindSubmatchIndex(t.b)
+		if matches == nil || matches[0] != 0 {
+			rn, _ := utf8.DecodeRune(t.b)
+			return lexer.Token{}, lexer.Errorf(t.pos, "invalid token %q", rn)
+		}
+
+		// only named groups are tokens, everything else is skipped
+		var typ rune
+		for i := 2; i < len(matches); i += 2 {
+			if matches[i] != -1 && t.names[i/2] != "" {
+				typ = lexer.EOF - rune(i/2)
+				break
+			}
+		}
+		token := t.consume(matches[1], typ)
+		if typ == 0 {
+			continue nextToken
+		}
+		return token, nil
+	}
+
+	return lexer.EOFToken(t.pos), nil
+}
+
+// consume the next n bytes as a token of the given type
+func (t *mqlTokenizer) consume(n int, typ rune) lexer.Token {
+	match := t.b[:n]
+	token := lexer.Token{
+		Type:  typ,
+		Pos:   t.pos,
+		Value: string(match),
+	}
+
+	t.pos.Offset += n
+	lines := bytes.Count(match, []byte("\n"))
+	t.pos.Line += lines
+	if lines == 0 {
+		t.pos.Column += utf8.RuneCount(match)
+	} else {
+		t.pos.Column = utf8.RuneCount(match[bytes.LastIndexByte(match, '\n'):])
+	}
+	t.b = t.b[n:]
+
+	return token
+}
+
+// doubleQuotedEnd returns the index of the quote that closes the
+// double-quoted string starting at b[start], or -1 if it isn't closed
+func doubleQuotedEnd(b []byte, start int) int {
+	for i := start + 1; i < len(b); i++ {
+		switch b[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		case '$':
+			if i+1 < len(b) && b[i+1] == '{' {
+				end := interpolationEnd(b, i+1)
+				if end < 0 {
+					return -1
+				}
+				i = end
+			}
+		}
+	}
+	return -1
+}
+
+// interpolationEnd returns the index of the brace that closes the
+// interpolation opened by b[start], or -1 if it isn't closed. Braces
+// within strings don't count.
+func interpolationEnd(b []byte, start int) int {
+	depth := 0
+	for i := start; i < len(b); i++ {
+		switch b[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		case '"':
+			i = doubleQuotedEnd(b, i)
+		case '\'':
+			i = quotedEnd(b, i, '\'', true)
+		case '`':
+			i = quotedEnd(b, i, '`', false)
+		}
+		if i < 0 {
+			return -1
+		}
+	}
+	return -1
+}
+
+// quotedEnd returns the index of the quote that closes the string
+// starting at b[start], or -1 if it isn't closed. Like the lexer, escaped
+// quotes only count if a later quote closes the string.
+func quotedEnd(b []byte, start int, quote byte, escapes bool) int {
+	for i := start + 1; i < len(b); i++ {
+		switch b[i] {
+		case '\\':
+			if escapes {
+				i++
+			}
+		case quote:
+			return i
+		}
+	}
+	if escapes {
+		return quotedEnd(b, start, quote, false)
+	}
+	return -1
+}
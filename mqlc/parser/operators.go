@@ -151,6 +151,14 @@ func (e *Expression) processChildOperators() error {
 					return err
 				}
 			}
+
+			if v.Value.Function != nil {
+				for j := range v.Value.Function.Body {
+					if err := v.Value.Function.Body[j].ProcessOperators(); err != nil {
+						return err
+					}
+				}
+			}
 		}
 
 		for fi := range v.Block {
@@ -27,16 +27,20 @@ var (
 var tokenNames map[rune]string
 
 func init() {
-	mqlLexer = lexer.Must(lexer.Regexp(`(\s+)` +
+	// double-quoted strings are scanned by the lexer itself, since their
+	// interpolations may contain braces and other strings.
+	// \' only escapes a quote in single-quoted strings if a later quote
+	// closes the string, so that strings like 'C:\' still end at their quote.
+	mqlLexer = newMqlDefinition(`(\s+)` +
 		`|(?P<Ident>[a-zA-Z$_][a-zA-Z0-9_]*)` +
 		`|(?P<Float>[-+]?\d*\.\d+([eE][-+]?\d+)?)` +
 		`|(?P<Int>[-+]?\d+([eE][-+]?\d+)?)` +
-		`|(?P<String>'[^']*'|"[^"]*")` +
+		`|(?P<String>'(\\.|[^'\\])*'|'[^']*'|` + "`[^`]*`" + `)` +
 		`|(?P<Comment>(//|#)[^\n]*(\n|\z))` +
 		`|(?P<Regex>/([^\\/]+|\\.)+/[msi]*)` +
 		`|(?P<Op>[-+*/%,:.=<>!|&~;])` +
 		`|(?P<Call>[(){}\[\]])`,
-	))
+	)
 
 	syms := mqlLexer.Symbols()
 
@@ -85,6 +89,36 @@ func (e *ErrIncorrect) Error() string {
 	return "expected " + e.expected + ", got '" + e.got + "' at " + e.pos.String()
 }
 
+// ErrSyntax is a syntax error at a position in the query
+type ErrSyntax struct {
+	msg string
+	pos lexer.Position
+	// in is the parser function in which the error occurred, if any
+	in string
+}
+
+func (e *ErrSyntax) Error() string {
+	if e.in == "" {
+		return e.msg + " at " + e.pos.String()
+	}
+	return e.msg + " at " + e.pos.String() + " in function " + e.in
+}
+
+// ErrorPosition returns the position in the query where parsing failed,
+// if the error carries one
+func ErrorPosition(err error) (lexer.Position, bool) {
+	switch e := err.(type) {
+	case *ErrSyntax:
+		return e.pos, true
+	case *ErrIncomplete:
+		return e.pos, true
+	case *ErrIncorrect:
+		return e.pos, true
+	default:
+		return lexer.Position{}, false
+	}
+}
+
 var blockCall string = "{}"
 
 // Expression at the root of mqlc
@@ -122,6 +156,24 @@ type Value struct {
 	Array  []*Expression          `json:",omitempty"`
 	Map    map[string]*Expression `json:",omitempty"`
 	Ident  *string                `json:",omitempty"`
+	// Interpolation holds all parts of an interpolated string, e.g. "a${b}"
+	Interpolation []*Expression `json:",omitempty"`
+	// Function holds a user-defined function, e.g. def name(a int) { a > 1 }
+	Function *Function `json:",omitempty"`
+}
+
+// Function is a user-defined function
+type Function struct {
+	Name   string
+	Params []*FunctionParam
+	Body   []*Expression
+}
+
+// FunctionParam is a typed parameter of a user-defined function
+type FunctionParam struct {
+	Name string
+	// Type is the type label of this parameter, e.g. int, []string or file
+	Type string
 }
 
 // Call to a value
@@ -141,6 +193,40 @@ type Arg struct {
 // AST holds the parsed syntax tree
 type AST struct {
 	Expressions []*Expression
+	// spans of all operands and calls in the source code
+	spans map[interface{}]Span
+	// trailing holds all operands and calls whose comments start at the end
+	// of the line before them
+	trailing map[interface{}]struct{}
+}
+
+// Span is the range of source code that a node was parsed from
+type Span struct {
+	Start lexer.Position
+	// End is the offset right after the node's last character
+	End int
+}
+
+// Span returns the source range of an operand or a call, if it is known.
+// Nodes that are generated during parsing, e.g. when operators are turned
+// into function calls, have no span.
+func (a *AST) Span(node interface{}) (Span, bool) {
+	if a == nil || a.spans == nil {
+		return Span{}, false
+	}
+	res, ok := a.spans[node]
+	return res, ok
+}
+
+// TrailingComment is true if the comments of an operand or call start at
+// the end of the line before it. In "a // note\nb" the comment belongs
+// to b, but trails a.
+func (a *AST) TrailingComment(node interface{}) bool {
+	if a == nil || a.trailing == nil {
+		return false
+	}
+	_, ok := a.trailing[node]
+	return ok
 }
 
 var (
@@ -163,6 +249,15 @@ type parser struct {
 	nextTokens []lexer.Token
 	lex        lexer.Lexer
 	comments   bytes.Buffer
+	// end is the offset right after the last consumed token
+	end int
+	// endLine is the line on which the last consumed token ends
+	endLine int
+	// trailingComment is true if the buffered comments start on the line
+	// of the last consumed token
+	trailingComment bool
+	spans           map[interface{}]Span
+	trailing        map[interface{}]struct{}
 	// indent indicates optimal indentation given strict formatting
 	// and using only tabs
 	indent int
@@ -179,15 +274,20 @@ func (p *parser) expected(typ string, in string) error {
 }
 
 func (p *parser) error(msg string, in string) error {
-	return errors.New(msg + " at " + p.token.Pos.String() + " in function " + in)
+	return &ErrSyntax{msg: msg, pos: p.token.Pos, in: in}
 }
 
 func (p *parser) errorMsg(msg string) error {
-	return errors.New(msg + " at " + p.token.Pos.String())
+	return &ErrSyntax{msg: msg, pos: p.token.Pos}
 }
 
 // nextToken loads the next token into p.token
 func (p *parser) nextToken() error {
+	if !p.token.EOF() {
+		p.end = p.token.Pos.Offset + len(p.token.Value)
+		p.endLine = p.token.Pos.Line + strings.Count(p.token.Value, "\n")
+	}
+
 	if p.nextTokens == nil {
 		var err error
 
@@ -217,6 +317,10 @@ func (p *parser) nextToken() error {
 }
 
 func (p *parser) parseComment() {
+	if p.comments.Len() == 0 {
+		p.trailingComment = p.endLine != 0 && p.token.Pos.Line == p.endLine
+	}
+
 	// we only need the comment's body
 	if p.token.Value[0] == '#' {
 		if len(p.token.Value) != 1 && p.token.Value[1] == ' ' {
@@ -233,11 +337,17 @@ func (p *parser) parseComment() {
 	}
 }
 
-func (p *parser) flushComments() string {
+// flushComments returns all buffered comments, which belong to the given
+// node of the syntax tree
+func (p *parser) flushComments(node interface{}) string {
 	if p.comments.Len() == 0 {
 		return ""
 	}
 
+	if p.trailingComment {
+		p.trailing[node] = struct{}{}
+		p.trailingComment = false
+	}
 	res := p.comments.String()
 	p.comments.Reset()
 	return res
@@ -261,42 +371,165 @@ var (
 	}
 )
 
+// token2string returns the value of the current string token. Single-quoted
+// and backtick strings are raw, double-quoted strings support escape sequences.
+// The only escape in single-quoted strings is \' for a quote.
 func (p *parser) token2string() string {
 	v := p.token.Value
 	vv := v[1 : len(v)-1]
 
-	if v[0] == '\'' {
+	if v[0] == '`' {
 		return vv
 	}
+	if v[0] == '\'' {
+		return unescapeQuotes(vv)
+	}
+
+	return unescape(vv)
+}
+
+// unescapeQuotes turns \' into ' and leaves all other backslashes as they are
+func unescapeQuotes(s string) string {
+	if !strings.Contains(s, `\'`) {
+		return s
+	}
 
-	vv = reUnescape.ReplaceAllStringFunc(vv, func(match string) string {
+	var res strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			if s[i+1] != '\'' {
+				res.WriteByte('\\')
+			}
+			res.WriteByte(s[i+1])
+			i++
+			continue
+		}
+		res.WriteByte(s[i])
+	}
+	return res.String()
+}
+
+func unescape(s string) string {
+	return reUnescape.ReplaceAllStringFunc(s, func(match string) string {
 		if found := unescapeMap[match]; found != "" {
 			return found
 		}
 		return string(match[1])
 	})
-	return vv
 }
 
-func (p *parser) parseValue() *Value {
+// parseInterpolation splits a double-quoted string that contains ${..}
+// expressions into its literal parts and the parsed expressions, e.g.
+// "uid: ${uid}" turns into the parts "uid: " and uid
+func (p *parser) parseInterpolation() ([]*Expression, error) {
+	v := p.token.Value
+	raw := v[1 : len(v)-1]
+
+	var res []*Expression
+	var literal strings.Builder
+	flushLiteral := func() {
+		if literal.Len() == 0 {
+			return
+		}
+		s := unescape(literal.String())
+		res = append(res, &Expression{Operand: &Operand{Value: &Value{String: &s}}})
+		literal.Reset()
+	}
+
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '\\' && i+1 < len(raw) {
+			literal.WriteString(raw[i : i+2])
+			i++
+			continue
+		}
+
+		if raw[i] != '$' || i+1 == len(raw) || raw[i+1] != '{' {
+			literal.WriteByte(raw[i])
+			continue
+		}
+
+		end := interpolationEnd([]byte(raw), i+1)
+		if end < 0 {
+			return nil, p.errorMsg("missing closing '}' in string interpolation")
+		}
+		code := raw[i+2 : end]
+
+		ast, err := Parse(code)
+		if err != nil {
+			return nil, p.errorMsg("failed to parse string interpolation '${" + code + "}': " + err.Error())
+		}
+		if len(ast.Expressions) != 1 {
+			return nil, p.errorMsg("string interpolation '${" + code + "}' must contain exactly one expression")
+		}
+		if err = ast.Expressions[0].processOperators(); err != nil {
+			return nil, err
+		}
+		p.addSpans(ast.spans, i+3)
+
+		// string literals like "${'}'}" are part of the surrounding text
+		if str := stringLiteral(ast.Expressions[0]); str != nil {
+			literal.WriteString(escape(*str))
+			i = end
+			continue
+		}
+
+		flushLiteral()
+		res = append(res, ast.Expressions[0])
+		i = end
+	}
+	flushLiteral()
+
+	return res, nil
+}
+
+// stringLiteral returns the value of expressions that are only a string
+func stringLiteral(e *Expression) *string {
+	if e.Operations != nil || e.Operand == nil || e.Operand.Calls != nil ||
+		e.Operand.Value == nil || e.Operand.Value.String == nil {
+		return nil
+	}
+	return e.Operand.Value.String
+}
+
+// addSpans of nodes that were parsed from within the current token, e.g.
+// in string interpolations. The offset is relative to the token.
+func (p *parser) addSpans(spans map[interface{}]Span, offset int) {
+	pos := p.token.Pos
+	for node, span := range spans {
+		start := span.Start
+		if start.Line == 1 {
+			start.Column += pos.Column + offset - 1
+		}
+		start.Line += pos.Line - 1
+		start.Offset += pos.Offset + offset
+		start.Filename = pos.Filename
+
+		p.spans[node] = Span{
+			Start: start,
+			End:   span.End + pos.Offset + offset,
+		}
+	}
+}
+
+func (p *parser) parseValue() (*Value, error) {
 	switch p.token.Type {
 	case Ident:
 		switch p.token.Value {
 		case "true":
-			return &trueValue
+			return &trueValue, nil
 		case "false":
-			return &falseValue
+			return &falseValue, nil
 		case "null":
-			return &nilValue
+			return &nilValue, nil
 		case "NaN":
-			return &nanValue
+			return &nanValue, nil
 		case "Infinity":
-			return &infinityValue
+			return &infinityValue, nil
 		case "Never":
-			return &neverValue
+			return &neverValue, nil
 		default:
 			v := p.token.Value
-			return &Value{Ident: &v}
+			return &Value{Ident: &v}, nil
 		}
 
 	case Float:
@@ -304,7 +537,7 @@ func (p *parser) parseValue() *Value {
 		if err != nil {
 			panic("Failed to parse float: " + err.Error())
 		}
-		return &Value{Float: &v}
+		return &Value{Float: &v}, nil
 
 	case Int:
 		var v int64
@@ -318,11 +551,22 @@ func (p *parser) parseValue() *Value {
 		if err != nil {
 			panic("Failed to parse integer: " + err.Error())
 		}
-		return &Value{Int: &v}
+		return &Value{Int: &v}, nil
 
 	case String:
+		if p.token.Value[0] == '"' && strings.Contains(p.token.Value, "${") {
+			parts, err := p.parseInterpolation()
+			if err != nil {
+				return nil, err
+			}
+			// escaped interpolations like "\${a}" leave only a literal behind
+			if len(parts) == 1 && stringLiteral(parts[0]) != nil {
+				return parts[0].Operand.Value, nil
+			}
+			return &Value{Interpolation: parts}, nil
+		}
 		vv := p.token2string()
-		return &Value{String: &vv}
+		return &Value{String: &vv}, nil
 
 	case Regex:
 		v := p.token.Value
@@ -342,10 +586,10 @@ func (p *parser) parseValue() *Value {
 			vv = "(?" + mods + ")" + vv
 		}
 
-		return &Value{Regex: &vv}
+		return &Value{Regex: &vv}, nil
 
 	}
-	return nil
+	return nil, nil
 }
 
 func (p *parser) parseArg() (*Arg, error) {
@@ -470,9 +714,61 @@ func (p *parser) parseMap() (*Value, error) {
 
 // parseOperand and return the operand, and true if the operand is standalone
 func (p *parser) parseOperand() (*Operand, bool, error) {
+	start := p.token.Pos
+	if p.token.Type == Op && p.token.Value == "-" {
+		return p.parseNegation(start)
+	}
+
+	res, standalone, err := p.parseOperandCalls()
+	if res != nil {
+		p.spans[res] = Span{Start: start, End: p.end}
+	}
+	return res, standalone, err
+}
+
+// parseNegation parses the unary minus, e.g. -duration("1d"). Negative
+// numbers are folded into their value, everything else is turned into a
+// call of the "-" operator with only one argument.
+func (p *parser) parseNegation(start lexer.Position) (*Operand, bool, error) {
+	p.nextToken()
+	operand, _, err := p.parseOperand()
+	if err != nil {
+		return nil, false, err
+	}
+	if operand == nil {
+		return nil, false, p.expected("operand", "parseNegation")
+	}
+
+	if operand.Value != nil && len(operand.Calls) == 0 && operand.Block == nil {
+		switch {
+		case operand.Value.Int != nil:
+			v := -*operand.Value.Int
+			operand.Value = &Value{Int: &v}
+			p.spans[operand] = Span{Start: start, End: p.end}
+			return operand, false, nil
+		case operand.Value.Float != nil:
+			v := -*operand.Value.Float
+			operand.Value = &Value{Float: &v}
+			p.spans[operand] = Span{Start: start, End: p.end}
+			return operand, false, nil
+		}
+	}
+
+	op := "-"
+	res := &Operand{
+		Value: &Value{Ident: &op},
+		Calls: []*Call{{Function: []*Arg{{Value: &Expression{Operand: operand}}}}},
+	}
+	p.spans[res] = Span{Start: start, End: p.end}
+	return res, false, nil
+}
+
+func (p *parser) parseOperandCalls() (*Operand, bool, error) {
 	// operand:      value [ call | accessor | '.' ident ]+ [ block ]
-	value := p.parseValue()
-	var err error
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, false, err
+	}
 
 	if value == nil {
 		// arrays
@@ -513,10 +809,20 @@ func (p *parser) parseOperand() (*Operand, bool, error) {
 		return &Operand{Value: value}, true, nil
 	}
 
-	res := Operand{
-		Comments: p.flushComments(),
-		Value:    value,
+	if value.Ident != nil && *value.Ident == "def" {
+		res := &Operand{}
+		res.Comments = p.flushComments(res)
+		p.nextToken()
+		fun, err := p.parseFunction()
+		if err != nil {
+			return nil, false, err
+		}
+		res.Value = &Value{Function: fun}
+		return res, true, nil
 	}
+
+	res := Operand{Value: value}
+	res.Comments = p.flushComments(&res)
 	p.nextToken()
 
 	for {
@@ -538,13 +844,15 @@ func (p *parser) parseOperand() (*Operand, bool, error) {
 			}
 
 			v := p.token.Value
-			res.Calls = append(res.Calls, &Call{
-				Ident:    &v,
-				Comments: p.flushComments(),
-			})
+			call := &Call{Ident: &v}
+			call.Comments = p.flushComments(call)
+			start := p.token.Pos
+			res.Calls = append(res.Calls, call)
 			p.nextToken()
+			p.spans[call] = Span{Start: start, End: p.end}
 
 		case "(":
+			start := p.token.Pos
 			p.indent++
 			p.nextToken()
 			args := []*Arg{}
@@ -572,10 +880,13 @@ func (p *parser) parseOperand() (*Operand, bool, error) {
 			}
 
 			p.indent--
-			res.Calls = append(res.Calls, &Call{Function: args})
+			call := &Call{Function: args}
+			res.Calls = append(res.Calls, call)
 			p.nextToken()
+			p.spans[call] = Span{Start: start, End: p.end}
 
 		case "[":
+			start := p.token.Pos
 			p.indent++
 			p.nextToken()
 
@@ -595,10 +906,12 @@ func (p *parser) parseOperand() (*Operand, bool, error) {
 			if exp == nil {
 				return nil, false, p.errorMsg("missing value inside of `[]`")
 			}
-			res.Calls = append(res.Calls, &Call{
+			call := &Call{
 				Accessor: exp,
-			})
+			}
+			res.Calls = append(res.Calls, call)
 			p.nextToken()
+			p.spans[call] = Span{Start: start, End: p.end}
 
 		case "{":
 			p.indent++
@@ -705,6 +1018,147 @@ func (p *parser) parseOperand() (*Operand, bool, error) {
 	}
 }
 
+// parseFunction parses a function definition after the `def` keyword, e.g.
+//
+//	def ownedByRoot(f file) { f.user.name == "root" }
+func (p *parser) parseFunction() (*Function, error) {
+	if p.token.Type != Ident {
+		if p.token.EOF() {
+			return nil, &ErrIncomplete{missing: "function name after 'def'", pos: p.token.Pos, Indent: p.indent}
+		}
+		return nil, p.expected("function name", "parseFunction")
+	}
+	res := Function{Name: p.token.Value}
+	p.nextToken()
+
+	if p.token.Value != "(" {
+		if p.token.EOF() {
+			return nil, &ErrIncomplete{missing: "'(' after function name", pos: p.token.Pos, Indent: p.indent}
+		}
+		return nil, &ErrIncorrect{expected: "'(' after function name", got: p.token.Value, pos: p.token.Pos}
+	}
+	p.nextToken()
+
+	for p.token.Value != ")" {
+		if p.token.Type != Ident {
+			if p.token.EOF() {
+				return nil, &ErrIncomplete{missing: "closing ')'", pos: p.token.Pos, Indent: p.indent}
+			}
+			return nil, p.expected("parameter name", "parseFunction")
+		}
+		param := FunctionParam{Name: p.token.Value}
+		p.nextToken()
+
+		typ, err := p.parseTypeLabel()
+		if err != nil {
+			return nil, err
+		}
+		param.Type = typ
+		res.Params = append(res.Params, &param)
+
+		if p.token.Value == "," {
+			p.nextToken()
+		} else if p.token.Value != ")" {
+			if p.token.EOF() {
+				return nil, &ErrIncomplete{missing: "closing ')'", pos: p.token.Pos, Indent: p.indent}
+			}
+			return nil, &ErrIncorrect{expected: "closing ')'", got: p.token.Value, pos: p.token.Pos}
+		}
+	}
+	p.nextToken()
+
+	if p.token.Value != "{" {
+		if p.token.EOF() {
+			return nil, &ErrIncomplete{missing: "function body", pos: p.token.Pos, Indent: p.indent}
+		}
+		return nil, &ErrIncorrect{expected: "'{' to start the function body", got: p.token.Value, pos: p.token.Pos}
+	}
+	p.indent++
+	p.nextToken()
+
+	for {
+		exp, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if exp == nil || (exp.Operand == nil && exp.Operations == nil) {
+			break
+		}
+		res.Body = append(res.Body, exp)
+
+		for p.token.Value == ";" {
+			p.nextToken()
+		}
+	}
+
+	if p.token.Value != "}" {
+		if p.token.EOF() {
+			return nil, &ErrIncomplete{missing: "closing '}'", pos: p.token.Pos, Indent: p.indent}
+		}
+		return nil, &ErrIncorrect{expected: "closing '}'", got: p.token.Value, pos: p.token.Pos}
+	}
+	p.indent--
+	p.nextToken()
+
+	return &res, nil
+}
+
+// parseTypeLabel parses the label of a type, e.g. int, []string,
+// map[string]int or resources like users.user
+func (p *parser) parseTypeLabel() (string, error) {
+	switch {
+	case p.token.Value == "[":
+		p.nextToken()
+		if p.token.Value != "]" {
+			return "", p.expected("']' in array type", "parseTypeLabel")
+		}
+		p.nextToken()
+		child, err := p.parseTypeLabel()
+		if err != nil {
+			return "", err
+		}
+		return "[]" + child, nil
+
+	case p.token.Value == "map":
+		p.nextToken()
+		if p.token.Value != "[" {
+			return "", p.expected("'[' in map type", "parseTypeLabel")
+		}
+		p.nextToken()
+		key, err := p.parseTypeLabel()
+		if err != nil {
+			return "", err
+		}
+		if p.token.Value != "]" {
+			return "", p.expected("']' in map type", "parseTypeLabel")
+		}
+		p.nextToken()
+		value, err := p.parseTypeLabel()
+		if err != nil {
+			return "", err
+		}
+		return "map[" + key + "]" + value, nil
+
+	case p.token.Type == Ident:
+		res := p.token.Value
+		p.nextToken()
+		for p.token.Value == "." {
+			p.nextToken()
+			if p.token.Type != Ident {
+				return "", p.expected("resource name", "parseTypeLabel")
+			}
+			res += "." + p.token.Value
+			p.nextToken()
+		}
+		return res, nil
+	}
+
+	if p.token.EOF() {
+		return "", &ErrIncomplete{missing: "parameter type", pos: p.token.Pos, Indent: p.indent}
+	}
+	return "", p.expected("parameter type", "parseTypeLabel")
+}
+
 func (p *parser) parseOperation() (*Operation, error) {
 	if p.token.Type != Op {
 		return nil, nil
@@ -806,11 +1260,9 @@ func (p *parser) flushExpression() *Expression {
 		return nil
 	}
 
-	return &Expression{
-		Operand: &Operand{
-			Comments: p.flushComments(),
-		},
-	}
+	res := &Operand{}
+	res.Comments = p.flushComments(res)
+	return &Expression{Operand: res}
 }
 
 func (p *parser) parseExpression() (*Expression, error) {
@@ -857,10 +1309,15 @@ func Parse(input string) (*AST, error) {
 	if err != nil {
 		return nil, err
 	}
-	res := AST{}
+	res := AST{
+		spans:    map[interface{}]Span{},
+		trailing: map[interface{}]struct{}{},
+	}
 
 	thisParser := parser{
-		lex: lex,
+		lex:      lex,
+		spans:    res.spans,
+		trailing: res.trailing,
 	}
 
 	err = thisParser.nextToken()
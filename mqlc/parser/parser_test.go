@@ -22,6 +22,13 @@ func TestParser_Lex(t *testing.T) {
 		{Int, "123"},
 		{String, "'hi'"},
 		{String, "\"hi\""},
+		{String, "\"h\\\"i\""},
+		{String, "`h\ni`"},
+		{String, "\"h${'i'}\""},
+		{String, "\"h${'}'}\""},
+		{String, "\"h${ {a: \"}\"}['a'] }\""},
+		{String, "'h\\'i'"},
+		{String, "'C:\\'"},
 		{Regex, "/regex/"},
 		{Op, "+"},
 	}
@@ -117,12 +124,30 @@ func TestParser_ParseValues(t *testing.T) {
 		{"name", &Expression{Operand: &Operand{Value: vIdent("name")}}},
 		{"1.23", &Expression{Operand: &Operand{Value: vFloat(1.23)}}},
 		{"123", &Expression{Operand: &Operand{Value: vInt(123)}}},
+		{"- 12", &Expression{Operand: &Operand{Value: vInt(-12)}}},
+		{"-1.5", &Expression{Operand: &Operand{Value: vFloat(-1.5)}}},
+		{"-name", &Expression{Operand: &Operand{
+			Value: vIdent("-"),
+			Calls: []*Call{{Function: []*Arg{{Value: &Expression{Operand: &Operand{Value: vIdent("name")}}}}}},
+		}}},
 		{"'hi'", &Expression{Operand: &Operand{Value: vString("hi")}}},
 		{"'h\\ni'", &Expression{Operand: &Operand{Value: vString("h\\ni")}}},
 		{"'h\\i'", &Expression{Operand: &Operand{Value: vString("h\\i")}}},
+		{"'h\\'i'", &Expression{Operand: &Operand{Value: vString("h'i")}}},
+		{"'C:\\'", &Expression{Operand: &Operand{Value: vString("C:\\")}}},
+		{"\"dir: ${'C:\\'}\"", &Expression{Operand: &Operand{Value: vString("dir: C:\\")}}},
 		{"\"hi\"", &Expression{Operand: &Operand{Value: vString("hi")}}},
 		{"\"h\\ni\"", &Expression{Operand: &Operand{Value: vString("h\ni")}}},
 		{"\"h\\i\"", &Expression{Operand: &Operand{Value: vString("hi")}}},
+		{"\"h\\\"i\"", &Expression{Operand: &Operand{Value: vString("h\"i")}}},
+		{"`h\\n\ni`", &Expression{Operand: &Operand{Value: vString("h\\n\ni")}}},
+		{"\"h\\${i}\"", &Expression{Operand: &Operand{Value: vString("h${i}")}}},
+		{"\"h${'i'}\"", &Expression{Operand: &Operand{Value: vString("hi")}}},
+		{"\"${name} is ${12}\"", &Expression{Operand: &Operand{Value: &Value{Interpolation: []*Expression{
+			{Operand: &Operand{Value: vIdent("name")}},
+			{Operand: &Operand{Value: vString(" is ")}},
+			{Operand: &Operand{Value: vInt(12)}},
+		}}}}},
 		{"/hi/", &Expression{Operand: &Operand{Value: vRegex("hi")}}},
 		{"[]", &Expression{Operand: &Operand{Value: &Value{Array: []*Expression{}}}}},
 		{"[1]", &Expression{Operand: &Operand{Value: &Value{Array: []*Expression{
@@ -339,3 +364,61 @@ func TestParser_Multiline(t *testing.T) {
 		}},
 	})
 }
+
+func TestParser_Functions(t *testing.T) {
+	runMultiTest(t, []multiTest{
+		{"def inc(x int, list []string) { x }\ninc(1)", []*Expression{
+			{Operand: &Operand{Value: &Value{Function: &Function{
+				Name: "inc",
+				Params: []*FunctionParam{
+					{Name: "x", Type: "int"},
+					{Name: "list", Type: "[]string"},
+				},
+				Body: []*Expression{
+					{Operand: &Operand{Value: vIdent("x")}},
+				},
+			}}}},
+			{Operand: &Operand{
+				Value: vIdent("inc"),
+				Calls: []*Call{{Function: []*Arg{
+					{Value: &Expression{Operand: &Operand{Value: vInt(1)}}},
+				}}},
+			}},
+		}},
+	})
+}
+
+func TestParser_Spans(t *testing.T) {
+	code := "x = 1\n  users.where(name == \"a\")"
+	ast, err := Parse(code)
+	require.NoError(t, err)
+	require.Len(t, ast.Expressions, 2)
+
+	op := ast.Expressions[1].Operand
+	span, ok := ast.Span(op)
+	require.True(t, ok)
+	assert.Equal(t, 2, span.Start.Line)
+	assert.Equal(t, 3, span.Start.Column)
+	assert.Equal(t, "users.where(name == \"a\")", code[span.Start.Offset:span.End])
+
+	require.Len(t, op.Calls, 2)
+	span, ok = ast.Span(op.Calls[1])
+	require.True(t, ok)
+	assert.Equal(t, "(name == \"a\")", code[span.Start.Offset:span.End])
+
+	_, ok = ast.Span(&Operand{})
+	assert.False(t, ok)
+}
+
+func TestParser_ErrorPosition(t *testing.T) {
+	_, err := Parse("users.where(")
+	pos, ok := ErrorPosition(err)
+	require.True(t, ok)
+	assert.Equal(t, 1, pos.Line)
+	assert.Equal(t, 13, pos.Column)
+
+	_, err = Parse("x = 1\n[1,2]}")
+	pos, ok = ErrorPosition(err)
+	require.True(t, ok, err.Error())
+	assert.Equal(t, 2, pos.Line)
+}
@@ -0,0 +1,241 @@
+package providers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/cli/config"
+	"go.mondoo.com/cnquery/cli/diskcache"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/motor/asset"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+// DefaultResourceCachePath is the directory where resource caches are stored
+// if no other path is configured
+func DefaultResourceCachePath() (string, error) {
+	return config.HomePath("cache", "resources")
+}
+
+// ResourceCache persists resource fields on disk, so that they can be reused
+// in later runs against the same asset. Only resources with a cache policy
+// in their schema are cached. Their fields expire after the policy's TTL or
+// when any of the files it lists are modified on the asset.
+type ResourceCache struct {
+	// Path is the directory with one cache file per asset
+	Path string
+
+	lock    sync.Mutex
+	asset   string
+	file    *diskcache.File
+	fields  map[string]*cachedField
+	changed bool
+	// modified looks up when a file on the asset was last modified
+	modified func(path string) (int64, error)
+	// lookups of modification times are done once per run
+	lookups map[string]modifiedLookup
+	now     func() time.Time
+}
+
+type modifiedLookup struct {
+	unix int64
+	err  error
+}
+
+type resourceCacheFile struct {
+	Asset  string         `json:"asset"`
+	Fields []*cachedField `json:"fields"`
+}
+
+type cachedField struct {
+	Resource string      `json:"resource"`
+	ID       string      `json:"id"`
+	Field    string      `json:"field"`
+	Data     *llx.Result `json:"data"`
+	Expires  time.Time   `json:"expires"`
+	// Modified is when each invalidating file was last modified, at the time
+	// this field was cached
+	Modified map[string]int64 `json:"modified,omitempty"`
+}
+
+// NewResourceCache creates a resource cache in the given directory. It has
+// to be loaded for an asset before it can be used.
+func NewResourceCache(path string) *ResourceCache {
+	return &ResourceCache{
+		Path:    path,
+		fields:  map[string]*cachedField{},
+		lookups: map[string]modifiedLookup{},
+		now:     time.Now,
+	}
+}
+
+// Load the cache of an asset. The asset is identified by its platform ID.
+// Modified is used to find out if any files that invalidate cached fields
+// were changed on the asset.
+func (c *ResourceCache) Load(asset *asset.Asset, modified func(path string) (int64, error)) error {
+	if len(asset.PlatformIds) == 0 {
+		return errors.New("cannot cache resources for asset " + asset.Name + ", it has no platform ID")
+	}
+
+	platformID := asset.PlatformIds[0]
+	file := diskcache.New(c.Path, "resource cache", platformID)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.asset = platformID
+	c.file = &file
+	c.modified = modified
+	c.fields = map[string]*cachedField{}
+	c.lookups = map[string]modifiedLookup{}
+	c.changed = false
+
+	var res resourceCacheFile
+	found, err := file.Load(&res)
+	if err != nil || !found {
+		return err
+	}
+
+	now := c.now()
+	for i := range res.Fields {
+		field := res.Fields[i]
+		if field.Data == nil || now.After(field.Expires) {
+			c.changed = true
+			continue
+		}
+		c.fields[fieldUID(field.Resource, field.ID, field.Field)] = field
+	}
+	return nil
+}
+
+// Get a cached field. Fields are only returned if they haven't expired and
+// none of the files that invalidate them were modified.
+func (c *ResourceCache) Get(info *resources.ResourceInfo, id string, field string) (*llx.RawData, bool) {
+	if info == nil || info.Cache == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	uid := fieldUID(info.Id, id, field)
+	entry, ok := c.fields[uid]
+	if !ok {
+		return nil, false
+	}
+
+	if c.now().After(entry.Expires) {
+		delete(c.fields, uid)
+		c.changed = true
+		return nil, false
+	}
+
+	for _, path := range info.Cache.Invalidate {
+		unix, err := c.lastModified(path)
+		if err != nil || unix != entry.Modified[path] {
+			log.Debug().Str("resource", info.Id).Str("path", path).Msg("resource cache> invalidated field")
+			delete(c.fields, uid)
+			c.changed = true
+			return nil, false
+		}
+	}
+
+	return entry.Data.RawResultV2().Data, true
+}
+
+// Add a field to the cache. Fields with errors are not cached, nor are fields
+// that reference resources or whose invalidating files cannot be checked.
+func (c *ResourceCache) Add(info *resources.ResourceInfo, id string, field string, data *llx.RawData) {
+	if info == nil || info.Cache == nil || info.Cache.Ttl <= 0 || data.Error != nil {
+		return
+	}
+	if hasResources(data.Type) {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil {
+		return
+	}
+
+	modified := make(map[string]int64, len(info.Cache.Invalidate))
+	for _, path := range info.Cache.Invalidate {
+		unix, err := c.lastModified(path)
+		if err != nil {
+			return
+		}
+		modified[path] = unix
+	}
+
+	c.fields[fieldUID(info.Id, id, field)] = &cachedField{
+		Resource: info.Id,
+		ID:       id,
+		Field:    field,
+		Data:     data.Result(),
+		Expires:  c.now().Add(time.Duration(info.Cache.Ttl) * time.Second),
+		Modified: modified,
+	}
+	c.changed = true
+}
+
+// hasResources is true if the type is a resource or contains resources.
+// Resources only exist in the provider that created them, so a cached
+// reference would point to nothing in the next run.
+func hasResources(typ types.Type) bool {
+	for {
+		if typ.IsResource() {
+			return true
+		}
+		if !typ.IsArray() && !typ.IsMap() {
+			return false
+		}
+		typ = typ.Child()
+	}
+}
+
+// lastModified of a file on the asset. Files that don't exist are reported
+// with 0. Callers must hold the lock.
+func (c *ResourceCache) lastModified(path string) (int64, error) {
+	if res, ok := c.lookups[path]; ok {
+		return res.unix, res.err
+	}
+
+	if c.modified == nil {
+		return 0, errors.New("cannot look up modified files on asset")
+	}
+
+	unix, err := c.modified(path)
+	c.lookups[path] = modifiedLookup{unix: unix, err: err}
+	return unix, err
+}
+
+// Save the cache to disk, if anything changed
+func (c *ResourceCache) Save() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil || !c.changed {
+		return nil
+	}
+
+	res := resourceCacheFile{
+		Asset:  c.asset,
+		Fields: make([]*cachedField, 0, len(c.fields)),
+	}
+	for _, field := range c.fields {
+		res.Fields = append(res.Fields, field)
+	}
+
+	if err := c.file.Save(res); err != nil {
+		return err
+	}
+
+	c.changed = false
+	log.Debug().Str("path", c.file.Path).Int("fields", len(res.Fields)).Msg("stored resource cache")
+	return nil
+}
@@ -0,0 +1,151 @@
+package providers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/motor/asset"
+	v1 "go.mondoo.com/cnquery/motor/inventory/v1"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/providers/plugin"
+	"go.mondoo.com/cnquery/providers/proto"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/types"
+)
+
+func TestResourceCache(t *testing.T) {
+	dir := t.TempDir()
+	host := &asset.Asset{Name: "host", PlatformIds: []string{"//platformid.api.mondoo.app/hostname/host"}}
+	packages := &resources.ResourceInfo{
+		Id:    "packages",
+		Cache: &resources.CachePolicy{Ttl: 60, Invalidate: []string{"/var/lib/dpkg/status"}},
+	}
+	mtimes := map[string]int64{"/var/lib/dpkg/status": 1000}
+	modified := func(path string) (int64, error) {
+		return mtimes[path], nil
+	}
+
+	cache := NewResourceCache(dir)
+	require.NoError(t, cache.Load(host, modified))
+	cache.Add(packages, "", "list", &llx.RawData{Type: types.Array(types.String), Value: []interface{}{"bash"}})
+	cache.Add(&resources.ResourceInfo{Id: "command"}, "uptime", "stdout", llx.StringData("1 day"))
+	require.NoError(t, cache.Save())
+
+	t.Run("reuse fields in the next run", func(t *testing.T) {
+		cache := NewResourceCache(dir)
+		require.NoError(t, cache.Load(host, modified))
+
+		res, ok := cache.Get(packages, "", "list")
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{"bash"}, res.Value)
+
+		_, ok = cache.Get(&resources.ResourceInfo{Id: "command"}, "uptime", "stdout")
+		assert.False(t, ok, "resources without cache policy are not cached")
+	})
+
+	t.Run("fields are per asset", func(t *testing.T) {
+		cache := NewResourceCache(dir)
+		require.NoError(t, cache.Load(&asset.Asset{PlatformIds: []string{"other"}}, modified))
+		_, ok := cache.Get(packages, "", "list")
+		assert.False(t, ok)
+	})
+
+	t.Run("fields expire", func(t *testing.T) {
+		cache := NewResourceCache(dir)
+		cache.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+		require.NoError(t, cache.Load(host, modified))
+		_, ok := cache.Get(packages, "", "list")
+		assert.False(t, ok)
+	})
+
+	t.Run("fields are invalidated if files change", func(t *testing.T) {
+		cache := NewResourceCache(dir)
+		require.NoError(t, cache.Load(host, func(path string) (int64, error) {
+			return 2000, nil
+		}))
+		_, ok := cache.Get(packages, "", "list")
+		assert.False(t, ok)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		cache := NewResourceCache(t.TempDir())
+		require.NoError(t, cache.Load(host, modified))
+		cache.Add(packages, "", "list", &llx.RawData{Error: assert.AnError})
+		_, ok := cache.Get(packages, "", "list")
+		assert.False(t, ok)
+	})
+
+	t.Run("resources are not cached", func(t *testing.T) {
+		cache := NewResourceCache(t.TempDir())
+		require.NoError(t, cache.Load(host, modified))
+		cache.Add(packages, "", "list", &llx.RawData{Type: types.Array(types.Resource("package")), Value: []interface{}{}})
+		_, ok := cache.Get(packages, "", "list")
+		assert.False(t, ok)
+	})
+
+	t.Run("assets need a platform ID", func(t *testing.T) {
+		cache := NewResourceCache(t.TempDir())
+		assert.Error(t, cache.Load(&asset.Asset{Name: "unknown"}, modified))
+	})
+}
+
+// countingPlugin returns the uptime of a host and counts how often its data
+// was requested
+type countingPlugin struct {
+	plugin.ProviderPlugin
+	calls int
+}
+
+func (p *countingPlugin) GetData(req *proto.DataReq, callback plugin.ProviderCallback) (*proto.DataRes, error) {
+	p.calls++
+	return &proto.DataRes{Data: llx.StringPrimitive("1 day")}, nil
+}
+
+func TestRuntime_ResourceCache(t *testing.T) {
+	dir := t.TempDir()
+	provider := &countingPlugin{}
+	schema := &resources.Schema{Resources: map[string]*resources.ResourceInfo{
+		"host": {
+			Id:       "host",
+			Provider: "test",
+			Fields:   map[string]*resources.Field{"uptime": {Name: "uptime", Type: string(types.String)}},
+			Cache:    &resources.CachePolicy{Ttl: 60},
+		},
+	}}
+
+	run := func() interface{} {
+		c := &coordinator{Running: []*RunningProvider{
+			{Name: "test", ID: "test", Plugin: provider, Schema: schema},
+		}}
+		runtime := c.NewRuntime()
+		runtime.DeactivateProviderDiscovery()
+		runtime.Cache = NewResourceCache(dir)
+		require.NoError(t, runtime.UseProvider("test"))
+		require.NoError(t, runtime.Connect(&proto.ConnectReq{
+			Asset: &v1.Inventory{Spec: &v1.InventorySpec{Assets: []*asset.Asset{{
+				Name:        "host",
+				PlatformIds: []string{"//platformid.api.mondoo.app/hostname/host"},
+				Connections: []*providers.Config{{Id: 1}},
+			}}}},
+		}))
+		defer runtime.Close()
+
+		var res interface{}
+		err := runtime.WatchAndUpdate(&llx.MockResource{Name: "host", ID: "host"}, "uptime", "w", func(value interface{}, err error) {
+			require.NoError(t, err)
+			res = value
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	assert.Equal(t, "1 day", run())
+	assert.Equal(t, 1, provider.calls)
+
+	// the second run is served from disk
+	assert.Equal(t, "1 day", run())
+	assert.Equal(t, 1, provider.calls)
+}
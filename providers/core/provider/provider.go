@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/providers/core/resources"
@@ -16,6 +17,9 @@ const defaultConnection uint32 = 1
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// lock guards runtimes, which are accessed concurrently when fields
+	// are resolved in parallel
+	lock sync.Mutex
 }
 
 func Init() *Service {
@@ -38,12 +42,9 @@ func (s *Service) Connect(req *proto.ConnectReq) (*proto.Connection, error) {
 		return nil, errors.New("no asset provided for connection")
 	}
 
-	s.lastConnectionID++
-	connID := s.lastConnectionID
 	runtime := &plugin.Runtime{
 		Resources: map[string]plugin.Resource{},
 	}
-	s.runtimes[connID] = runtime
 
 	asset := req.Asset.Spec.Assets[0]
 	assetObj, err := resources.CreateResource(runtime, "asset", map[string]interface{}{
@@ -64,6 +65,11 @@ func (s *Service) Connect(req *proto.ConnectReq) (*proto.Connection, error) {
 	}
 	runtime.Resources["asset\x00"] = assetObj
 
+	s.lock.Lock()
+	s.lastConnectionID++
+	s.runtimes[s.lastConnectionID] = runtime
+	s.lock.Unlock()
+
 	return &proto.Connection{
 		Id:   defaultConnection,
 		Name: "core",
@@ -71,7 +77,9 @@ func (s *Service) Connect(req *proto.ConnectReq) (*proto.Connection, error) {
 }
 
 func (s *Service) GetData(req *proto.DataReq, callback plugin.ProviderCallback) (*proto.DataRes, error) {
+	s.lock.Lock()
 	runtime, ok := s.runtimes[req.Connection]
+	s.lock.Unlock()
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -87,29 +95,35 @@ func (s *Service) GetData(req *proto.DataReq, callback plugin.ProviderCallback)
 			return nil, err
 		}
 
-		name := res.MqlName()
-		id := res.MqlID()
-		if x, ok := runtime.Resources[name+"\x00"+id]; ok {
-			res = x
-		} else {
-			runtime.Resources[name+"\x00"+id] = res
-		}
-
-		rd := llx.ResourceData(res, name).Result()
+		res = runtime.AddResource(res)
+		rd := llx.ResourceData(res, res.MqlName()).Result()
 		return &proto.DataRes{
 			Data: rd.Data,
 		}, nil
 	}
 
-	resource, ok := runtime.Resources[req.Resource+"\x00"+req.ResourceId]
+	resource, ok := runtime.Resource(req.Resource, req.ResourceId)
 	if !ok {
 		return nil, errors.New("resource '" + req.Resource + "' (id: " + req.ResourceId + ") doesn't exist")
 	}
 
+	if req.PageSize > 0 {
+		offset, max := int(req.PageOffset), int(req.PageSize)
+		if pager, ok := resource.(plugin.ListPager); ok {
+			if res, ok := pager.PageList(req.Field, offset, max); ok {
+				return res, nil
+			}
+		}
+		return plugin.PageDataRes(resources.GetData(resource, req.Field, args), offset, max), nil
+	}
+
 	return resources.GetData(resource, req.Field, args), nil
 }
 
 func (s *Service) StoreData(req *proto.StoreReq) (*proto.StoreRes, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	runtime, ok := s.runtimes[req.Connection]
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
@@ -125,15 +139,14 @@ func (s *Service) StoreData(req *proto.StoreReq) (*proto.StoreRes, error) {
 			continue
 		}
 
-		resource, ok := runtime.Resources[info.Name+"\x00"+info.Id]
+		resource, ok := runtime.Resource(info.Name, info.Id)
 		if !ok {
 			resource, err = resources.CreateResource(runtime, info.Name, args)
 			if err != nil {
 				errs = append(errs, "failed to add cached "+info.Name+" (id: "+info.Id+"), creation failed: "+err.Error())
 				continue
 			}
-
-			runtime.Resources[info.Name+"\x00"+info.Id] = resource
+			resource = runtime.AddResource(resource)
 		}
 
 		for k, v := range args {
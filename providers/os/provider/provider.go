@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/llx"
@@ -24,6 +25,9 @@ import (
 type Service struct {
 	runtimes         map[uint32]*plugin.Runtime
 	lastConnectionID uint32
+	// lock guards runtimes, which are accessed concurrently when fields
+	// are resolved in parallel
+	lock sync.Mutex
 }
 
 func Init() *Service {
@@ -241,16 +245,20 @@ func (s *Service) connect(asset *asset.Asset, inventory inventory.InventoryManag
 	}
 
 	asset.Connections[0].Id = conn.ID()
+	s.lock.Lock()
 	s.runtimes[conn.ID()] = &plugin.Runtime{
 		Connection: conn,
 		Resources:  map[string]plugin.Resource{},
 	}
+	s.lock.Unlock()
 
 	return conn, err
 }
 
 func (s *Service) GetData(req *proto.DataReq, callback plugin.ProviderCallback) (*proto.DataRes, error) {
+	s.lock.Lock()
 	runtime, ok := s.runtimes[req.Connection]
+	s.lock.Unlock()
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
 	}
@@ -266,29 +274,35 @@ func (s *Service) GetData(req *proto.DataReq, callback plugin.ProviderCallback)
 			return nil, err
 		}
 
-		name := res.MqlName()
-		id := res.MqlID()
-		if x, ok := runtime.Resources[name+"\x00"+id]; ok {
-			res = x
-		} else {
-			runtime.Resources[name+"\x00"+id] = res
-		}
-
-		rd := llx.ResourceData(res, name).Result()
+		res = runtime.AddResource(res)
+		rd := llx.ResourceData(res, res.MqlName()).Result()
 		return &proto.DataRes{
 			Data: rd.Data,
 		}, nil
 	}
 
-	resource, ok := runtime.Resources[req.Resource+"\x00"+req.ResourceId]
+	resource, ok := runtime.Resource(req.Resource, req.ResourceId)
 	if !ok {
 		return nil, errors.New("resource '" + req.Resource + "' (id: " + req.ResourceId + ") doesn't exist")
 	}
 
+	if req.PageSize > 0 {
+		offset, max := int(req.PageOffset), int(req.PageSize)
+		if pager, ok := resource.(plugin.ListPager); ok {
+			if res, ok := pager.PageList(req.Field, offset, max); ok {
+				return res, nil
+			}
+		}
+		return plugin.PageDataRes(resources.GetData(resource, req.Field, args), offset, max), nil
+	}
+
 	return resources.GetData(resource, req.Field, args), nil
 }
 
 func (s *Service) StoreData(req *proto.StoreReq) (*proto.StoreRes, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	runtime, ok := s.runtimes[req.Connection]
 	if !ok {
 		return nil, errors.New("connection " + strconv.FormatUint(uint64(req.Connection), 10) + " not found")
@@ -304,15 +318,14 @@ func (s *Service) StoreData(req *proto.StoreReq) (*proto.StoreRes, error) {
 			continue
 		}
 
-		resource, ok := runtime.Resources[info.Name+"\x00"+info.Id]
+		resource, ok := runtime.Resource(info.Name, info.Id)
 		if !ok {
 			resource, err = resources.CreateResource(runtime, info.Name, args)
 			if err != nil {
 				errs = append(errs, "failed to add cached "+info.Name+" (id: "+info.Id+"), creation failed: "+err.Error())
 				continue
 			}
-
-			runtime.Resources[info.Name+"\x00"+info.Id] = resource
+			resource = runtime.AddResource(resource)
 		}
 
 		for k, v := range args {
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/plugin"
+)
+
+func (f *mqlFile) id() (string, error) {
+	return f.Path.Data, f.Path.Error
+}
+
+// stat the file and set all fields that depend on it
+func (f *mqlFile) stat() error {
+	if f.Path.Error != nil {
+		return f.Path.Error
+	}
+
+	info, err := f.MqlRuntime.Connection.(shared.Connection).FileSystem().Stat(f.Path.Data)
+	if errors.Is(err, os.ErrNotExist) {
+		f.Exists = plugin.TValue[bool]{Data: false, State: plugin.StateIsSet}
+		err = errors.New("file '" + f.Path.Data + "' does not exist")
+		f.Size = plugin.TValue[int64]{Error: err, State: plugin.StateIsSet}
+		f.Modified = plugin.TValue[*time.Time]{Error: err, State: plugin.StateIsSet}
+		return nil
+	}
+	if err != nil {
+		f.Exists = plugin.TValue[bool]{Error: err, State: plugin.StateIsSet}
+		f.Size = plugin.TValue[int64]{Error: err, State: plugin.StateIsSet}
+		f.Modified = plugin.TValue[*time.Time]{Error: err, State: plugin.StateIsSet}
+		return err
+	}
+
+	modified := info.ModTime()
+	f.Exists = plugin.TValue[bool]{Data: true, State: plugin.StateIsSet}
+	f.Size = plugin.TValue[int64]{Data: info.Size(), State: plugin.StateIsSet}
+	f.Modified = plugin.TValue[*time.Time]{Data: &modified, State: plugin.StateIsSet}
+	return nil
+}
+
+func (f *mqlFile) exists() (bool, error) {
+	// note: we ignore the return value because everything is set in stat
+	return false, f.stat()
+}
+
+func (f *mqlFile) size() (int64, error) {
+	// note: we ignore the return value because everything is set in stat
+	return 0, f.stat()
+}
+
+func (f *mqlFile) modified() (*time.Time, error) {
+	// note: we ignore the return value because everything is set in stat
+	return nil, f.stat()
+}
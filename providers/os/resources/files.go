@@ -0,0 +1,119 @@
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/providers/plugin"
+	"go.mondoo.com/cnquery/providers/proto"
+	"go.mondoo.com/cnquery/types"
+)
+
+type mqlFilesFindInternal struct {
+	lock sync.Mutex
+	// paths of all files that were found, sorted; nil until searched
+	paths []string
+}
+
+func (l *mqlFilesFind) init(args map[string]interface{}) (map[string]interface{}, *mqlFilesFind, error) {
+	if _, ok := args["name"]; !ok {
+		args["name"] = ""
+	}
+	return args, nil, nil
+}
+
+func (l *mqlFilesFind) id() (string, error) {
+	if l.From.Error != nil {
+		return "", l.From.Error
+	}
+	return l.From.Data + "\x00" + l.Name.Data, l.Name.Error
+}
+
+// find searches all files once and returns their paths
+func (l *mqlFilesFind) find() ([]string, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.paths != nil {
+		return l.paths, nil
+	}
+
+	fs := l.MqlRuntime.Connection.(shared.Connection).FileSystem()
+	paths := []string{}
+	err := afero.Walk(fs, l.From.Data, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if l.Name.Data != "" {
+			matches, err := filepath.Match(l.Name.Data, info.Name())
+			if err != nil || !matches {
+				return err
+			}
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+	l.paths = paths
+	return paths, nil
+}
+
+// files creates the file resources for all paths and registers them
+// with the runtime, so that their fields can be requested
+func (l *mqlFilesFind) files(paths []string) ([]interface{}, error) {
+	res := make([]interface{}, len(paths))
+	for i := range paths {
+		file, err := CreateResource(l.MqlRuntime, "file", map[string]interface{}{
+			"path": paths[i],
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = l.MqlRuntime.AddResource(file)
+	}
+	return res, nil
+}
+
+func (l *mqlFilesFind) list() ([]interface{}, error) {
+	paths, err := l.find()
+	if err != nil {
+		return nil, err
+	}
+	return l.files(paths)
+}
+
+// PageList only creates the files of the requested page, so that long
+// lists don't need to be held in memory at once
+func (l *mqlFilesFind) PageList(field string, offset int, max int) (*proto.DataRes, bool) {
+	if field != "list" {
+		return nil, false
+	}
+	if l.List.State&plugin.StateIsSet != 0 {
+		return plugin.PageDataRes(l.List.ToDataRes(types.Array(types.Resource("file"))), offset, max), true
+	}
+
+	res := plugin.TValue[[]interface{}]{State: plugin.StateIsSet}
+	paths, err := l.find()
+	if err == nil {
+		if offset > len(paths) {
+			offset = len(paths)
+		}
+		end := offset + max
+		if end > len(paths) {
+			end = len(paths)
+		}
+		res.Data, err = l.files(paths[offset:end])
+	}
+	res.Error = err
+	return res.ToDataRes(types.Array(types.Resource("file"))), true
+}
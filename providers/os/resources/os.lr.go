@@ -3,6 +3,7 @@ package resources
 
 import (
 	"errors"
+	"time"
 
 	"go.mondoo.com/cnquery/providers/plugin"
 	"go.mondoo.com/cnquery/providers/proto"
@@ -11,6 +12,8 @@ import (
 
 var newResource = map[string]func(runtime *plugin.Runtime, args map[string]interface{}) (plugin.Resource, error){
 	"command": NewCommand,
+	"file": NewFile,
+	"files.find": NewFilesFind,
 }
 
 // CreateResource is used by the runtime of this plugin
@@ -36,6 +39,27 @@ var getDataFields = map[string]func(r plugin.Resource) *proto.DataRes{
 	"command.exitcode": func(r plugin.Resource) *proto.DataRes {
 		return (r.(*mqlCommand).GetExitcode()).ToDataRes(types.Int)
 	},
+	"file.path": func(r plugin.Resource) *proto.DataRes {
+		return (r.(*mqlFile).GetPath()).ToDataRes(types.String)
+	},
+	"file.exists": func(r plugin.Resource) *proto.DataRes {
+		return (r.(*mqlFile).GetExists()).ToDataRes(types.Bool)
+	},
+	"file.size": func(r plugin.Resource) *proto.DataRes {
+		return (r.(*mqlFile).GetSize()).ToDataRes(types.Int)
+	},
+	"file.modified": func(r plugin.Resource) *proto.DataRes {
+		return (r.(*mqlFile).GetModified()).ToDataRes(types.Time)
+	},
+	"files.find.from": func(r plugin.Resource) *proto.DataRes {
+		return (r.(*mqlFilesFind).GetFrom()).ToDataRes(types.String)
+	},
+	"files.find.name": func(r plugin.Resource) *proto.DataRes {
+		return (r.(*mqlFilesFind).GetName()).ToDataRes(types.String)
+	},
+	"files.find.list": func(r plugin.Resource) *proto.DataRes {
+		return (r.(*mqlFilesFind).GetList()).ToDataRes(types.Array(types.Resource("file")))
+	},
 }
 
 func GetData(resource plugin.Resource, field string, args map[string]interface{}) *proto.DataRes {
@@ -68,6 +92,41 @@ var setDataFields = map[string]func(r plugin.Resource, v interface{}) bool {
 		r.(*mqlCommand).Exitcode, ok = plugin.RawToTValue[int64](v)
 		return ok
 	},
+	"file.path": func(r plugin.Resource, v interface{}) bool {
+		var ok bool
+		r.(*mqlFile).Path, ok = plugin.RawToTValue[string](v)
+		return ok
+	},
+	"file.exists": func(r plugin.Resource, v interface{}) bool {
+		var ok bool
+		r.(*mqlFile).Exists, ok = plugin.RawToTValue[bool](v)
+		return ok
+	},
+	"file.size": func(r plugin.Resource, v interface{}) bool {
+		var ok bool
+		r.(*mqlFile).Size, ok = plugin.RawToTValue[int64](v)
+		return ok
+	},
+	"file.modified": func(r plugin.Resource, v interface{}) bool {
+		var ok bool
+		r.(*mqlFile).Modified, ok = plugin.RawToTValue[*time.Time](v)
+		return ok
+	},
+	"files.find.from": func(r plugin.Resource, v interface{}) bool {
+		var ok bool
+		r.(*mqlFilesFind).From, ok = plugin.RawToTValue[string](v)
+		return ok
+	},
+	"files.find.name": func(r plugin.Resource, v interface{}) bool {
+		var ok bool
+		r.(*mqlFilesFind).Name, ok = plugin.RawToTValue[string](v)
+		return ok
+	},
+	"files.find.list": func(r plugin.Resource, v interface{}) bool {
+		var ok bool
+		r.(*mqlFilesFind).List, ok = plugin.RawToTValue[[]interface{}](v)
+		return ok
+	},
 }
 
 func SetData(resource plugin.Resource, field string, val interface{}) error {
@@ -161,3 +220,122 @@ func (c *mqlCommand) GetExitcode() *plugin.TValue[int64] {
 		return c.exitcode(vargCommand.Data)
 	})
 }
+
+// mqlFile for the file resource
+type mqlFile struct {
+	MqlRuntime *plugin.Runtime
+	_id string
+	// optional: if you define mqlFileInternal it will be used here
+
+	Path plugin.TValue[string]
+	Exists plugin.TValue[bool]
+	Size plugin.TValue[int64]
+	Modified plugin.TValue[*time.Time]
+}
+
+// NewFile creates a new instance of this resource
+func NewFile(runtime *plugin.Runtime, args map[string]interface{}) (plugin.Resource, error) {
+	res := &mqlFile{
+		MqlRuntime: runtime,
+	}
+
+	var err error
+
+	for k, v := range args {
+		if err = SetData(res, k, v); err != nil {
+			return res, err
+		}
+	}
+
+	res._id, err = res.id()
+	return res, err
+}
+
+func (c *mqlFile) MqlName() string {
+	return "file"
+}
+
+func (c *mqlFile) MqlID() string {
+	return c._id
+}
+
+func (c *mqlFile) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlFile) GetExists() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Exists, func() (bool, error) {
+		return c.exists()
+	})
+}
+
+func (c *mqlFile) GetSize() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Size, func() (int64, error) {
+		return c.size()
+	})
+}
+
+func (c *mqlFile) GetModified() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Modified, func() (*time.Time, error) {
+		return c.modified()
+	})
+}
+
+// mqlFilesFind for the files.find resource
+type mqlFilesFind struct {
+	MqlRuntime *plugin.Runtime
+	_id string
+	mqlFilesFindInternal
+
+	From plugin.TValue[string]
+	Name plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
+}
+
+// NewFilesFind creates a new instance of this resource
+func NewFilesFind(runtime *plugin.Runtime, args map[string]interface{}) (plugin.Resource, error) {
+	res := &mqlFilesFind{
+		MqlRuntime: runtime,
+	}
+
+	var err error
+	var existing *mqlFilesFind
+	args, existing, err = res.init(args)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	for k, v := range args {
+		if err = SetData(res, k, v); err != nil {
+			return res, err
+		}
+	}
+
+	res._id, err = res.id()
+	return res, err
+}
+
+func (c *mqlFilesFind) MqlName() string {
+	return "files.find"
+}
+
+func (c *mqlFilesFind) MqlID() string {
+	return c._id
+}
+
+func (c *mqlFilesFind) GetFrom() *plugin.TValue[string] {
+	return &c.From
+}
+
+func (c *mqlFilesFind) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlFilesFind) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		return c.list()
+	})
+}
@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"sync"
+
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 	"go.mondoo.com/cnquery/llx"
@@ -11,6 +13,32 @@ import (
 type Runtime struct {
 	Connection Connection
 	Resources  map[string]Resource
+	// lock guards Resources, which are also added to while fields are
+	// resolved in parallel
+	lock sync.Mutex
+}
+
+// AddResource registers a resource with the runtime, so that its fields
+// can be requested. If a resource with the same name and ID is registered
+// already, that one is kept and returned instead.
+func (r *Runtime) AddResource(res Resource) Resource {
+	key := res.MqlName() + "\x00" + res.MqlID()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if existing, ok := r.Resources[key]; ok {
+		return existing
+	}
+	r.Resources[key] = res
+	return res
+}
+
+// Resource looks up a registered resource by its name and ID
+func (r *Runtime) Resource(name string, id string) (Resource, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	res, ok := r.Resources[name+"\x00"+id]
+	return res, ok
 }
 
 type Connection interface{}
@@ -20,6 +48,35 @@ type Resource interface {
 	MqlName() string
 }
 
+// ListPager is implemented by resources that can fetch a page of a list
+// field without getting all of its items first. It returns false for fields
+// it doesn't page.
+type ListPager interface {
+	PageList(field string, offset int, max int) (*proto.DataRes, bool)
+}
+
+// PageDataRes only keeps up to max items of a list result, starting at
+// offset. Results that aren't lists are returned as they are.
+func PageDataRes(res *proto.DataRes, offset int, max int) *proto.DataRes {
+	if res == nil || res.Data == nil || res.Error != "" || !types.Type(res.Data.Type).IsArray() {
+		return res
+	}
+
+	items := res.Data.Array
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + max
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return &proto.DataRes{
+		Data: &llx.Primitive{Type: res.Data.Type, Array: items[offset:end]},
+		Id:   res.Id,
+	}
+}
+
 type TValue[T any] struct {
 	Data  T
 	State State
@@ -3,6 +3,7 @@ package providers
 import (
 	"encoding/json"
 	"os"
+	"sync"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -26,6 +27,8 @@ type recording struct {
 	Path   string           `json:"-"`
 	// assets is used for fast connection to asset lookup
 	assets map[uint32]*assetRecording `json:"-"`
+	// lock guards all data, since fields may be resolved concurrently
+	lock sync.Mutex `json:"-"`
 }
 
 type assetRecording struct {
@@ -81,6 +84,9 @@ func (n *readOnlyRecording) Save() error {
 func (n *readOnlyRecording) EnsureAsset(asset *asset.Asset, provider string, conf *providers.Config) {
 	// For read-only recordings we are still loading from file, so that means
 	// we are severly lacking connection IDs.
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
 	found, _ := n.findAssetConnID(asset, conf)
 	if found != -1 {
 		n.assets[conf.Id] = &n.Assets[found]
@@ -149,9 +155,10 @@ func LoadRecordingFile(path string) (*recording, error) {
 }
 
 func (r *recording) Save() error {
+	r.lock.Lock()
 	r.finalize()
-
 	raw, err := json.Marshal(r)
+	r.lock.Unlock()
 	if err != nil {
 		return errors.New("failed to marshal json for recording: " + err.Error())
 	}
@@ -232,6 +239,9 @@ func (r *recording) findAssetConnID(asset *asset.Asset, conf *providers.Config)
 }
 
 func (r *recording) EnsureAsset(asset *asset.Asset, provider string, conf *providers.Config) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	found, id := r.findAssetConnID(asset, conf)
 
 	if found == -1 {
@@ -256,6 +266,9 @@ func (r *recording) EnsureAsset(asset *asset.Asset, provider string, conf *provi
 }
 
 func (r *recording) AddData(connectionID uint32, resource string, id string, field string, data *llx.RawData) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		log.Error().Uint32("connectionID", connectionID).Msg("cannot store recording, cannot find connection ID")
@@ -277,6 +290,9 @@ func (r *recording) AddData(connectionID uint32, resource string, id string, fie
 }
 
 func (r *recording) GetData(connectionID uint32, resource string, id string, field string) (*llx.RawData, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		return nil, false
@@ -296,6 +312,9 @@ func (r *recording) GetData(connectionID uint32, resource string, id string, fie
 }
 
 func (r *recording) GetResource(connectionID uint32, resource string, id string) (map[string]*llx.RawData, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	asset, ok := r.assets[connectionID]
 	if !ok {
 		return nil, false
@@ -306,7 +325,11 @@ func (r *recording) GetResource(connectionID uint32, resource string, id string)
 		return nil, false
 	}
 
-	return obj.Fields, true
+	res := make(map[string]*llx.RawData, len(obj.Fields))
+	for k, v := range obj.Fields {
+		res[k] = v
+	}
+	return res, true
 }
 
 func RawDataArgsToPrimitiveArgs(args map[string]*llx.RawData) (map[string]*llx.Primitive, error) {
@@ -2,7 +2,9 @@ package providers
 
 import (
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -21,6 +23,8 @@ type Runtime struct {
 	Provider       *ConnectedProvider
 	UpstreamConfig *UpstreamConfig
 	Recording      Recording
+	// Cache persists resource fields across runs; it is nil if disabled
+	Cache *ResourceCache
 
 	features []byte
 	asset    *asset.Asset
@@ -28,6 +32,8 @@ type Runtime struct {
 	coordinator *coordinator
 	// providers for with open connections
 	providers map[string]*ConnectedProvider
+	// providersLock guards providers, since fields may be resolved concurrently
+	providersLock sync.Mutex
 	// schema aggregates all resources executable on this asset
 	schema   extensibleSchema
 	isClosed bool
@@ -78,6 +84,12 @@ func (r *Runtime) Close() {
 		log.Error().Err(err).Msg("failed to save recording")
 	}
 
+	if r.Cache != nil {
+		if err := r.Cache.Save(); err != nil {
+			log.Error().Err(err).Msg("failed to save resource cache")
+		}
+	}
+
 	r.coordinator.Close(r.Provider.Instance)
 	r.schema.Close()
 }
@@ -88,6 +100,9 @@ func (r *Runtime) DeactivateProviderDiscovery() {
 
 // UseProvider sets the main provider for this runtime.
 func (r *Runtime) UseProvider(id string) error {
+	r.providersLock.Lock()
+	defer r.providersLock.Unlock()
+
 	res, err := r.addProvider(id)
 	if err != nil {
 		return err
@@ -97,6 +112,8 @@ func (r *Runtime) UseProvider(id string) error {
 	return nil
 }
 
+// addProvider starts a provider for this runtime. Callers must hold the
+// providers lock.
 func (r *Runtime) addProvider(id string) (*ConnectedProvider, error) {
 	var running *RunningProvider
 	for _, p := range r.coordinator.Running {
@@ -145,6 +162,7 @@ func (r *Runtime) Connect(req *proto.ConnectReq) error {
 	if conn.Id != 0 {
 		r.Provider.Connection = &proto.Connection{Id: conn.Id, Name: conn.ToUrl()}
 		r.Recording.EnsureAsset(asset, r.Provider.Instance.Name, conn)
+		r.loadCache()
 		return nil
 	}
 
@@ -155,15 +173,98 @@ func (r *Runtime) Connect(req *proto.ConnectReq) error {
 	}
 
 	r.Recording.EnsureAsset(asset, r.Provider.Instance.Name, conn)
+	r.loadCache()
 	return nil
 }
 
+// loadCache loads the resource cache for the connected asset. The cache is
+// disabled if it cannot be loaded.
+func (r *Runtime) loadCache() {
+	if r.Cache == nil {
+		return
+	}
+
+	if err := r.Cache.Load(r.asset, r.lastModified); err != nil {
+		log.Warn().Err(err).Msg("disabled resource cache")
+		r.Cache = nil
+	}
+}
+
+// lastModified returns when a file on the asset was last modified, as unix
+// timestamp. Files that don't exist are reported with 0.
+func (r *Runtime) lastModified(path string) (int64, error) {
+	provider, _, err := r.lookupResourceProvider("file")
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := provider.Instance.Plugin.GetData(&proto.DataReq{
+		Connection: provider.Connection.Id,
+		Resource:   "file",
+		Args:       map[string]*llx.Primitive{"path": llx.StringPrimitive(path)},
+	}, nil)
+	if err != nil {
+		return 0, err
+	}
+	id := string(res.Data.Value)
+
+	getField := func(field string) (*llx.RawData, error) {
+		data, err := provider.Instance.Plugin.GetData(&proto.DataReq{
+			Connection: provider.Connection.Id,
+			Resource:   "file",
+			ResourceId: id,
+			Field:      field,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		if data.Error != "" {
+			return nil, errors.New(data.Error)
+		}
+		return data.Data.RawData(), nil
+	}
+
+	exists, err := getField("exists")
+	if err != nil {
+		return 0, err
+	}
+	if found, _ := exists.Value.(bool); !found {
+		return 0, nil
+	}
+
+	modified, err := getField("modified")
+	if err != nil {
+		return 0, err
+	}
+	ts, ok := modified.Value.(*time.Time)
+	if !ok || ts == nil {
+		return 0, errors.New("cannot get modification time of file '" + path + "'")
+	}
+	return ts.Unix(), nil
+}
+
+// ConnectionType is the type of connection to the asset, e.g. "ssh" or
+// "winrm". It is empty if the runtime isn't connected yet.
+func (r *Runtime) ConnectionType() string {
+	if r.asset == nil || len(r.asset.Connections) == 0 {
+		return ""
+	}
+	return r.asset.Connections[0].Backend.Id()
+}
+
 func (r *Runtime) CreateResource(name string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	return r.CreateResourceTraced(nil, name, args)
+}
+
+// CreateResourceTraced creates a resource and records its provider round
+// trips in the span, if it isn't nil
+func (r *Runtime) CreateResourceTraced(span *llx.Span, name string, args map[string]*llx.Primitive) (llx.Resource, error) {
 	provider, _, err := r.lookupResourceProvider(name)
 	if err != nil {
 		return nil, err
 	}
 
+	span.AddRoundTrip()
 	res, err := provider.Instance.Plugin.GetData(&proto.DataReq{
 		Connection: provider.Connection.Id,
 		Resource:   name,
@@ -210,6 +311,12 @@ func fieldUID(resource string, id string, field string) string {
 
 // WatchAndUpdate a resource field and call the function if it changes with its current value
 func (r *Runtime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	return r.WatchAndUpdateTraced(nil, resource, field, watcherUID, callback)
+}
+
+// WatchAndUpdateTraced works like WatchAndUpdate and records the field's
+// provider round trips and cache use in the span, if it isn't nil
+func (r *Runtime) WatchAndUpdateTraced(span *llx.Span, resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
 	name := resource.MqlName()
 	id := resource.MqlID()
 
@@ -218,7 +325,8 @@ func (r *Runtime) WatchAndUpdate(resource llx.Resource, field string, watcherUID
 		return err
 	}
 
-	if _, ok := info.Fields[field]; !ok {
+	fieldInfo, ok := info.Fields[field]
+	if !ok {
 		return errors.New("cannot get field '" + field + "' for resource '" + name + "'")
 	}
 
@@ -227,6 +335,29 @@ func (r *Runtime) WatchAndUpdate(resource llx.Resource, field string, watcherUID
 		return nil
 	}
 
+	if r.Cache != nil && info.Cache != nil {
+		if cached, ok := r.Cache.Get(info, id, field); ok {
+			span.SetCache(llx.CacheHit)
+			callback(cached.Value, cached.Error)
+			return nil
+		}
+		span.SetCache(llx.CacheMiss)
+	}
+
+	// lists are fetched one page at a time, unless all of their items have
+	// to be recorded or cached
+	_, notRecording := r.Recording.(nullRecording)
+	if notRecording && (r.Cache == nil || info.Cache == nil) && types.Type(fieldInfo.Type).IsArray() {
+		callback(&providerList{
+			provider: provider,
+			resource: name,
+			id:       id,
+			field:    field,
+		}, nil)
+		return nil
+	}
+
+	span.AddRoundTrip()
 	data, err := provider.Instance.Plugin.GetData(&proto.DataReq{
 		Connection: provider.Connection.Id,
 		Resource:   name,
@@ -243,17 +374,87 @@ func (r *Runtime) WatchAndUpdate(resource llx.Resource, field string, watcherUID
 	raw := data.Data.RawData()
 
 	r.Recording.AddData(provider.Connection.Id, name, id, field, raw)
+	if r.Cache != nil && err == nil {
+		r.Cache.Add(info, id, field, raw)
+	}
 
 	callback(raw.Value, err)
 	return nil
 }
 
+// providerList is a list field that is fetched from its provider one page
+// at a time
+type providerList struct {
+	provider *ConnectedProvider
+	resource string
+	id       string
+	field    string
+
+	// all items of the list, if its provider doesn't page it
+	all  []interface{}
+	lock sync.Mutex
+}
+
+func (l *providerList) Page(offset int, max int) ([]interface{}, error) {
+	l.lock.Lock()
+	all := l.all
+	l.lock.Unlock()
+	if all != nil {
+		return pageItems(all, offset, max), nil
+	}
+
+	data, err := l.provider.Instance.Plugin.GetData(&proto.DataReq{
+		Connection: l.provider.Connection.Id,
+		Resource:   l.resource,
+		ResourceId: l.id,
+		Field:      l.field,
+		PageOffset: uint32(offset),
+		PageSize:   uint32(max),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if data.Error != "" {
+		return nil, errors.New(data.Error)
+	}
+
+	raw := data.Data.RawData()
+	if raw.Error != nil {
+		return nil, raw.Error
+	}
+	items, _ := raw.Value.([]interface{})
+	if len(items) <= max {
+		return items, nil
+	}
+
+	// the provider sent all items instead of the page, which we keep for
+	// all following pages
+	l.lock.Lock()
+	l.all = items
+	l.lock.Unlock()
+	return pageItems(items, offset, max), nil
+}
+
+func pageItems(items []interface{}, offset int, max int) []interface{} {
+	if offset >= len(items) {
+		return nil
+	}
+	end := offset + max
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
 func (r *Runtime) lookupResourceProvider(resource string) (*ConnectedProvider, *resources.ResourceInfo, error) {
 	info := r.schema.Lookup(resource)
 	if info == nil {
 		return nil, nil, errors.New("cannot find resource '" + resource + "' in schema")
 	}
 
+	r.providersLock.Lock()
+	defer r.providersLock.Unlock()
+
 	if provider := r.providers[info.Provider]; provider != nil {
 		return provider, info, nil
 	}
@@ -291,7 +492,7 @@ type extensibleSchema struct {
 	runtime   *Runtime
 	allLoaded bool
 	lockAll   sync.Mutex // only used in getting all schemas
-	lockAdd   sync.Mutex // only used when adding a schema
+	lockAdd   sync.Mutex // used when adding a schema and looking up resources
 }
 
 func (x *extensibleSchema) loadAllSchemas() {
@@ -325,7 +526,7 @@ func (x *extensibleSchema) Close() {
 }
 
 func (x *extensibleSchema) Lookup(name string) *resources.ResourceInfo {
-	if found, ok := x.Resources[name]; ok {
+	if found := x.lookup(name); found != nil {
 		return found
 	}
 	if x.allLoaded {
@@ -333,6 +534,12 @@ func (x *extensibleSchema) Lookup(name string) *resources.ResourceInfo {
 	}
 
 	x.loadAllSchemas()
+	return x.lookup(name)
+}
+
+func (x *extensibleSchema) lookup(name string) *resources.ResourceInfo {
+	x.lockAdd.Lock()
+	defer x.lockAdd.Unlock()
 	return x.Resources[name]
 }
 
@@ -356,6 +563,36 @@ func (x *extensibleSchema) Add(name string, schema *resources.Schema) {
 	for k, v := range schema.Resources {
 		x.Schema.Resources[k] = v
 	}
+	for k, v := range schema.Resources {
+		x.addParents(k, v.Provider)
+	}
+}
+
+// addParents adds the resources that a resource's name implies, if they
+// don't exist, e.g. files for files.find. Callers must hold lockAdd.
+func (x *extensibleSchema) addParents(name string, provider string) {
+	parts := strings.Split(name, ".")
+	for i := 1; i < len(parts); i++ {
+		parent := strings.Join(parts[:i], ".")
+		if _, ok := x.Schema.Resources[parent]; ok {
+			continue
+		}
+
+		field := parts[i]
+		x.Schema.Resources[parent] = &resources.ResourceInfo{
+			Id:       parent,
+			Name:     parent,
+			Provider: provider,
+			Fields: map[string]*resources.Field{
+				field: {
+					Name:               field,
+					Type:               string(types.Resource(parent + "." + field)),
+					IsImplicitResource: true,
+					Provider:           provider,
+				},
+			},
+		}
+	}
 }
 
 func (x *extensibleSchema) AllResources() map[string]*resources.ResourceInfo {
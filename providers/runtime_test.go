@@ -0,0 +1,132 @@
+package providers
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mqlc"
+	osprovider "go.mondoo.com/cnquery/providers/os/provider"
+	"go.mondoo.com/cnquery/providers/plugin"
+	"go.mondoo.com/cnquery/providers/proto"
+	"go.mondoo.com/cnquery/resources/lr"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// pagingPlugin records the pages that are requested from the os provider.
+// If ignorePages is set, it requests all items instead, like providers that
+// don't page lists.
+type pagingPlugin struct {
+	plugin.ProviderPlugin
+	ignorePages bool
+
+	lock     sync.Mutex
+	pages    int
+	maxItems int
+}
+
+func (p *pagingPlugin) GetData(req *proto.DataReq, callback plugin.ProviderCallback) (*proto.DataRes, error) {
+	if req.PageSize > 0 && p.ignorePages {
+		req.PageOffset, req.PageSize = 0, 0
+	}
+
+	res, err := p.ProviderPlugin.GetData(req, callback)
+	if err != nil || req.PageSize == 0 {
+		return res, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.pages++
+	if n := len(res.Data.Array); n > p.maxItems {
+		p.maxItems = n
+	}
+	return res, nil
+}
+
+func runOsQuery(t *testing.T, provider *pagingPlugin, query string) *llx.RawData {
+	ast, err := lr.Resolve("os/resources/os.lr", os.ReadFile)
+	require.NoError(t, err)
+	schema, err := lr.Schema(ast)
+	require.NoError(t, err)
+
+	c := &coordinator{Running: []*RunningProvider{
+		{Name: "os", ID: "go.mondoo.com/cnquery/providers/os", Plugin: provider, Schema: schema},
+	}}
+	runtime := c.NewRuntime()
+	runtime.DeactivateProviderDiscovery()
+	require.NoError(t, runtime.UseProvider("go.mondoo.com/cnquery/providers/os"))
+	req := protobuf.Clone(osprovider.LocalAssetReq).(*proto.ConnectReq)
+	require.NoError(t, runtime.Connect(req))
+	defer runtime.Close()
+
+	scheduler := llx.NewScheduler(runtime, llx.SchedulerOptions{Workers: 4, ListWindow: 10})
+	bundle, err := mqlc.Compile(query, nil, mqlc.NewConfig(scheduler.Schema(), cnquery.DefaultFeatures))
+	require.NoError(t, err)
+	checksum := bundle.CodeV2.Checksums[bundle.CodeV2.Entrypoints()[0]]
+
+	var once sync.Once
+	done := make(chan *llx.RawData, 1)
+	executor, err := llx.NewExecutorV2(bundle.CodeV2, scheduler, nil, func(res *llx.RawResult) {
+		if res.CodeID == checksum {
+			once.Do(func() { done <- res.Data })
+		}
+	})
+	require.NoError(t, err)
+	require.NoError(t, executor.Run())
+
+	select {
+	case res := <-done:
+		require.NoError(t, executor.Unregister())
+		return res
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for results")
+		return nil
+	}
+}
+
+func TestRuntime_ListPages(t *testing.T) {
+	// 35 config files, whose size is their number, and 5 other files
+	dir := t.TempDir()
+	for i := 1; i <= 40; i++ {
+		name := "file" + strconv.Itoa(i) + ".conf"
+		if i > 35 {
+			name = "file" + strconv.Itoa(i) + ".txt"
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), make([]byte, i), 0o600))
+	}
+	find := "files.find(from: '" + dir + "', name: '*.conf').list"
+
+	t.Run("where reads one page at a time", func(t *testing.T) {
+		provider := &pagingPlugin{ProviderPlugin: osprovider.Init()}
+		res := runOsQuery(t, provider, find+".where(size > 30).length")
+		require.NoError(t, res.Error)
+		assert.Equal(t, int64(5), res.Value)
+		assert.Equal(t, 4, provider.pages)
+		assert.Equal(t, 10, provider.maxItems)
+	})
+
+	t.Run("fields that need all items get all pages", func(t *testing.T) {
+		provider := &pagingPlugin{ProviderPlugin: osprovider.Init()}
+		res := runOsQuery(t, provider, find)
+		require.NoError(t, res.Error)
+		assert.Len(t, res.Value, 35)
+		assert.Equal(t, 4, provider.pages)
+	})
+
+	t.Run("providers that don't page send all items once", func(t *testing.T) {
+		provider := &pagingPlugin{ProviderPlugin: osprovider.Init(), ignorePages: true}
+		res := runOsQuery(t, provider, find+".map(size)")
+		require.NoError(t, res.Error)
+		assert.Len(t, res.Value, 35)
+		assert.Contains(t, res.Value, int64(35))
+		assert.Equal(t, 0, provider.pages)
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"go.mondoo.com/cnquery/resources/lr"
+	"go.mondoo.com/cnquery/resources/lr/docs"
+	"sigs.k8s.io/yaml"
 )
 
 var goCmd = &cobra.Command{
@@ -52,6 +54,19 @@ var goCmd = &cobra.Command{
 			log.Fatal().Err(err).Msg("failed to generate schema")
 		}
 
+		// the manifest is optional, but has metadata the runtime needs
+		if raw, err := os.ReadFile(file + ".manifest.yaml"); err == nil {
+			var manifest docs.LrDocs
+			if err = yaml.Unmarshal(raw, &manifest); err != nil {
+				log.Fatal().Err(err).Msg("failed to load manifest")
+			}
+			if err = lr.ApplyManifest(schema, &manifest); err != nil {
+				log.Fatal().Err(err).Msg("failed to apply manifest to schema")
+			}
+		} else if !os.IsNotExist(err) {
+			log.Fatal().Err(err).Msg("failed to read manifest")
+		}
+
 		schemaData, err := json.Marshal(schema)
 		if err != nil {
 			log.Fatal().Err(err).Msg("failed to generate schema json")
@@ -23,6 +23,16 @@ type LrDocsEntry struct {
 	Snippets         []LrDocsSnippet         `json:"snippets,omitempty"`
 	IsPrivate        bool                    `json:"is_private,omitempty"`
 	MinMondooVersion string                  `json:"min_mondoo_version,omitempty"`
+	// Cost of fetching the resource and its fields: low, medium, high
+	// default cost is low if nothing is provided
+	Cost string `json:"cost,omitempty"`
+	// Concurrency is the max number of fields that are resolved at the same
+	// time, per connection type, e.g. winrm: 1. The "default" limit applies
+	// to all other connections. There is no limit if nothing is provided.
+	Concurrency map[string]int32 `json:"concurrency,omitempty"`
+	// Serial resolves the fields of one resource one after the other, for
+	// resources whose fields share their state
+	Serial bool `json:"serial,omitempty"`
 }
 
 type LrDocsPlatform struct {
@@ -58,6 +68,8 @@ type LrDocsRefs struct {
 
 type LrDocsField struct {
 	MinMondooVersion string `json:"min_mondoo_version,omitempty"`
+	// Cost of fetching the field, defaults to the cost of its resource
+	Cost string `json:"cost,omitempty"`
 }
 
 func (d LrDocsRefs) MarshalGo() string {
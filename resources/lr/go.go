@@ -49,7 +49,12 @@ func Go(packageName string, ast *LR, collector *Collector) (string, error) {
 		imports += "\n\t" + strconv.Quote(importPath)
 	}
 
-	header := fmt.Sprintf(goHeader, imports)
+	stdImports := ""
+	if strings.Contains(o.data, "time.Time") {
+		stdImports += "\n\t\"time\""
+	}
+
+	header := fmt.Sprintf(goHeader, stdImports, imports)
 	return header + o.data, nil
 }
 
@@ -57,7 +62,7 @@ const goHeader = `// Code generated by resources. DO NOT EDIT.
 package resources
 
 import (
-	"errors"
+	"errors"%s
 
 	"go.mondoo.com/cnquery/providers/plugin"
 	"go.mondoo.com/cnquery/providers/proto"
@@ -432,6 +437,10 @@ func (t *SimpleType) typeItems(ast *LR) types.Type {
 		return types.Regex
 	case "time":
 		return types.Time
+	case "ip":
+		return types.IP
+	case "cidr":
+		return types.CIDR
 	case "dict":
 		return types.Dict
 	default:
@@ -502,6 +511,10 @@ func (t *SimpleType) mondooTypeItems() string {
 		return "types.Regex"
 	case "time":
 		return "types.Time"
+	case "ip":
+		return "types.IP"
+	case "cidr":
+		return "types.CIDR"
 	case "dict":
 		return "types.Dict"
 	default:
@@ -545,6 +558,8 @@ var primitiveTypes = map[string]string{
 	"int":    "int64",
 	"float":  "float64",
 	"time":   "*time.Time",
+	"ip":     "string",
+	"cidr":   "string",
 	"dict":   "interface{}",
 	"any":    "interface{}",
 }
@@ -572,6 +587,8 @@ var primitiveZeros = map[string]string{
 	"int":    "0",
 	"float":  "0.0",
 	"time":   "nil",
+	"ip":     "\"\"",
+	"cidr":   "\"\"",
 	"dict":   "nil",
 	"any":    "nil",
 }
@@ -66,6 +66,7 @@ type Resource struct {
 	IsPrivate bool           `@"private"?`
 	ID        string         `@Ident { @'.' @Ident }`
 	Defaults  string         ` ( '@' "defaults" '(' @String ')' )? `
+	Cache     []string       ` ( '@' "cache" '(' @String { ',' @String } ')' )? `
 	ListType  *SimplListType `[ '{' [ @@ ]`
 	Body      *ResourceDef   `@@ '}' ]`
 	title     string
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/lr/docs"
 )
 
 func parse(t *testing.T, cmd string, f func(*LR)) {
@@ -52,6 +54,29 @@ func TestParse(t *testing.T) {
 		})
 	})
 
+	t.Run("cache", func(t *testing.T) {
+		parse(t, "name @cache(\"24h\", \"/var/lib/dpkg/status\", \"/var/lib/rpm\")", func(res *LR) {
+			assert.Equal(t, []*Resource{
+				{
+					ID:    "name",
+					Cache: []string{"24h", "/var/lib/dpkg/status", "/var/lib/rpm"},
+				},
+			}, res.Resources)
+
+			policy, err := resourceCache(res.Resources[0])
+			assert.Nil(t, err)
+			assert.Equal(t, int64(24*60*60), policy.Ttl)
+			assert.Equal(t, []string{"/var/lib/dpkg/status", "/var/lib/rpm"}, policy.Invalidate)
+		})
+	})
+
+	t.Run("invalid cache", func(t *testing.T) {
+		parse(t, "name @cache(\"soon\")", func(res *LR) {
+			_, err := resourceCache(res.Resources[0])
+			assert.Error(t, err)
+		})
+	})
+
 	t.Run("resource with a static field", func(t *testing.T) {
 		parse(t, `
 		// resource-docs
@@ -238,6 +263,43 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestApplyManifest(t *testing.T) {
+	schema := &resources.Schema{Resources: map[string]*resources.ResourceInfo{
+		"file": {Id: "file", Fields: map[string]*resources.Field{
+			"path":    {Name: "path"},
+			"content": {Name: "content"},
+		}},
+	}}
+
+	err := ApplyManifest(schema, &docs.LrDocs{Resources: map[string]*docs.LrDocsEntry{
+		"file":    {Cost: "low", Fields: map[string]*docs.LrDocsField{"content": {Cost: "medium"}, "gone": {Cost: "high"}}},
+		"missing": {Cost: "high"},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "low", schema.Resources["file"].Cost)
+	assert.Equal(t, "", schema.Resources["file"].Fields["path"].Cost)
+	assert.Equal(t, "medium", schema.Resources["file"].Fields["content"].Cost)
+
+	err = ApplyManifest(schema, &docs.LrDocs{Resources: map[string]*docs.LrDocsEntry{
+		"file": {Cost: "cheap"},
+	}})
+	assert.EqualError(t, err, "Invalid cost \"cheap\" in the manifest of file, expected low, medium, or high")
+
+	t.Run("concurrency", func(t *testing.T) {
+		err := ApplyManifest(schema, &docs.LrDocs{Resources: map[string]*docs.LrDocsEntry{
+			"file": {Concurrency: map[string]int32{"default": 4, "winrm": 1}, Serial: true},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int32{"": 4, "winrm": 1}, schema.Resources["file"].Concurrency)
+		assert.True(t, schema.Resources["file"].Serial)
+
+		err = ApplyManifest(schema, &docs.LrDocs{Resources: map[string]*docs.LrDocsEntry{
+			"file": {Concurrency: map[string]int32{"winrm": 0}},
+		}})
+		assert.EqualError(t, err, "Invalid concurrency limit 0 for winrm in the manifest of file, it must be at least 1")
+	})
+}
+
 func TestParseLR(t *testing.T) {
 	files := []string{
 		"core/core.lr",
@@ -0,0 +1,82 @@
+package lr
+
+import (
+	"errors"
+	"strconv"
+
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/lr/docs"
+)
+
+var costs = map[string]struct{}{
+	"low":    {},
+	"medium": {},
+	"high":   {},
+}
+
+// ApplyManifest adds the metadata of a resource manifest, that the runtime
+// needs, to the schema
+func ApplyManifest(schema *resources.Schema, manifest *docs.LrDocs) error {
+	for id, entry := range manifest.Resources {
+		info, ok := schema.Resources[id]
+		if !ok || entry == nil {
+			continue
+		}
+
+		if err := checkCost(entry.Cost, id); err != nil {
+			return err
+		}
+		info.Cost = entry.Cost
+
+		concurrency, err := manifestConcurrency(entry.Concurrency, id)
+		if err != nil {
+			return err
+		}
+		info.Concurrency = concurrency
+		info.Serial = entry.Serial
+
+		for name, field := range entry.Fields {
+			f, ok := info.Fields[name]
+			if !ok || field == nil {
+				continue
+			}
+			if err := checkCost(field.Cost, id+"."+name); err != nil {
+				return err
+			}
+			f.Cost = field.Cost
+		}
+	}
+	return nil
+}
+
+func checkCost(cost string, id string) error {
+	if cost == "" {
+		return nil
+	}
+	if _, ok := costs[cost]; !ok {
+		return errors.New("Invalid cost \"" + cost + "\" in the manifest of " + id + ", expected low, medium, or high")
+	}
+	return nil
+}
+
+// defaultConcurrency is the manifest's key for the concurrency limit of all
+// connections, which the schema stores under the empty key
+const defaultConcurrency = "default"
+
+func manifestConcurrency(limits map[string]int32, id string) (map[string]int32, error) {
+	if len(limits) == 0 {
+		return nil, nil
+	}
+
+	res := make(map[string]int32, len(limits))
+	for connection, limit := range limits {
+		if limit < 1 {
+			return nil, errors.New("Invalid concurrency limit " + strconv.Itoa(int(limit)) + " for " + connection + " in the manifest of " + id + ", it must be at least 1")
+		}
+		if connection == defaultConcurrency {
+			connection = ""
+		}
+		res[connection] = limit
+	}
+	return res, nil
+}
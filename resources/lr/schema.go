@@ -2,6 +2,8 @@ package lr
 
 import (
 	"errors"
+	"strings"
+	"time"
 
 	"go.mondoo.com/cnquery/resources"
 	"go.mondoo.com/cnquery/types"
@@ -125,6 +127,11 @@ func resourceSchema(r *Resource, ast *LR) (*resources.ResourceInfo, error) {
 		return nil, err
 	}
 
+	cache, err := resourceCache(r)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &resources.ResourceInfo{
 		Id:       r.ID,
 		Name:     r.ID,
@@ -134,6 +141,7 @@ func resourceSchema(r *Resource, ast *LR) (*resources.ResourceInfo, error) {
 		Private:  r.IsPrivate,
 		Fields:   fields,
 		Defaults: r.Defaults,
+		Cache:    cache,
 	}
 
 	if r.ListType != nil {
@@ -142,3 +150,27 @@ func resourceSchema(r *Resource, ast *LR) (*resources.ResourceInfo, error) {
 
 	return res, nil
 }
+
+// resourceCache parses a resource's @cache annotation, e.g.
+// @cache("24h", "/var/lib/dpkg/status") caches the resource's fields for
+// 24 hours, unless /var/lib/dpkg/status changes in the meantime.
+func resourceCache(r *Resource) (*resources.CachePolicy, error) {
+	if len(r.Cache) == 0 {
+		return nil, nil
+	}
+
+	ttl, err := time.ParseDuration(r.Cache[0])
+	if err != nil || ttl < time.Second {
+		return nil, errors.New("Invalid cache TTL \"" + r.Cache[0] + "\" in resource " + r.ID + ", it must be at least 1s")
+	}
+
+	res := &resources.CachePolicy{Ttl: int64(ttl / time.Second)}
+	for _, path := range r.Cache[1:] {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			return nil, errors.New("Empty cache invalidation path in resource " + r.ID)
+		}
+		res.Invalidate = append(res.Invalidate, path)
+	}
+	return res, nil
+}
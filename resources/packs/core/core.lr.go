@@ -4151,10 +4151,6 @@ type Time interface {
 	Register(string) error
 	Validate() error
 	Now() (*time.Time, error)
-	Second() (*time.Time, error)
-	Minute() (*time.Time, error)
-	Hour() (*time.Time, error)
-	Day() (*time.Time, error)
 	Today() (*time.Time, error)
 	Tomorrow() (*time.Time, error)
 }
@@ -4189,22 +4185,6 @@ func newTime(runtime *resources.Runtime, args *resources.Args) (interface{}, err
 			if _, ok := val.(*time.Time); !ok {
 				return nil, errors.New("Failed to initialize \"time\", its \"now\" argument has the wrong type (expected type \"*time.Time\")")
 			}
-		case "second":
-			if _, ok := val.(*time.Time); !ok {
-				return nil, errors.New("Failed to initialize \"time\", its \"second\" argument has the wrong type (expected type \"*time.Time\")")
-			}
-		case "minute":
-			if _, ok := val.(*time.Time); !ok {
-				return nil, errors.New("Failed to initialize \"time\", its \"minute\" argument has the wrong type (expected type \"*time.Time\")")
-			}
-		case "hour":
-			if _, ok := val.(*time.Time); !ok {
-				return nil, errors.New("Failed to initialize \"time\", its \"hour\" argument has the wrong type (expected type \"*time.Time\")")
-			}
-		case "day":
-			if _, ok := val.(*time.Time); !ok {
-				return nil, errors.New("Failed to initialize \"time\", its \"day\" argument has the wrong type (expected type \"*time.Time\")")
-			}
 		case "today":
 			if _, ok := val.(*time.Time); !ok {
 				return nil, errors.New("Failed to initialize \"time\", its \"today\" argument has the wrong type (expected type \"*time.Time\")")
@@ -4251,14 +4231,6 @@ func (s *mqlTime) Register(name string) error {
 	switch name {
 	case "now":
 		return nil
-	case "second":
-		return nil
-	case "minute":
-		return nil
-	case "hour":
-		return nil
-	case "day":
-		return nil
 	case "today":
 		return nil
 	case "tomorrow":
@@ -4274,14 +4246,6 @@ func (s *mqlTime) Field(name string) (interface{}, error) {
 	switch name {
 	case "now":
 		return s.Now()
-	case "second":
-		return s.Second()
-	case "minute":
-		return s.Minute()
-	case "hour":
-		return s.Hour()
-	case "day":
-		return s.Day()
 	case "today":
 		return s.Today()
 	case "tomorrow":
@@ -4314,98 +4278,6 @@ func (s *mqlTime) Now() (*time.Time, error) {
 	return tres, nil
 }
 
-// Second accessor autogenerated
-func (s *mqlTime) Second() (*time.Time, error) {
-	res, ok := s.Cache.Load("second")
-	if !ok || !res.Valid {
-		if err := s.ComputeSecond(); err != nil {
-			return nil, err
-		}
-		res, ok = s.Cache.Load("second")
-		if !ok {
-			return nil, errors.New("\"time\" calculated \"second\" but didn't find its value in cache.")
-		}
-		s.MotorRuntime.Trigger(s, "second")
-	}
-	if res.Error != nil {
-		return nil, res.Error
-	}
-	tres, ok := res.Data.(*time.Time)
-	if !ok {
-		return nil, fmt.Errorf("\"time\" failed to cast field \"second\" to the right type (*time.Time): %#v", res)
-	}
-	return tres, nil
-}
-
-// Minute accessor autogenerated
-func (s *mqlTime) Minute() (*time.Time, error) {
-	res, ok := s.Cache.Load("minute")
-	if !ok || !res.Valid {
-		if err := s.ComputeMinute(); err != nil {
-			return nil, err
-		}
-		res, ok = s.Cache.Load("minute")
-		if !ok {
-			return nil, errors.New("\"time\" calculated \"minute\" but didn't find its value in cache.")
-		}
-		s.MotorRuntime.Trigger(s, "minute")
-	}
-	if res.Error != nil {
-		return nil, res.Error
-	}
-	tres, ok := res.Data.(*time.Time)
-	if !ok {
-		return nil, fmt.Errorf("\"time\" failed to cast field \"minute\" to the right type (*time.Time): %#v", res)
-	}
-	return tres, nil
-}
-
-// Hour accessor autogenerated
-func (s *mqlTime) Hour() (*time.Time, error) {
-	res, ok := s.Cache.Load("hour")
-	if !ok || !res.Valid {
-		if err := s.ComputeHour(); err != nil {
-			return nil, err
-		}
-		res, ok = s.Cache.Load("hour")
-		if !ok {
-			return nil, errors.New("\"time\" calculated \"hour\" but didn't find its value in cache.")
-		}
-		s.MotorRuntime.Trigger(s, "hour")
-	}
-	if res.Error != nil {
-		return nil, res.Error
-	}
-	tres, ok := res.Data.(*time.Time)
-	if !ok {
-		return nil, fmt.Errorf("\"time\" failed to cast field \"hour\" to the right type (*time.Time): %#v", res)
-	}
-	return tres, nil
-}
-
-// Day accessor autogenerated
-func (s *mqlTime) Day() (*time.Time, error) {
-	res, ok := s.Cache.Load("day")
-	if !ok || !res.Valid {
-		if err := s.ComputeDay(); err != nil {
-			return nil, err
-		}
-		res, ok = s.Cache.Load("day")
-		if !ok {
-			return nil, errors.New("\"time\" calculated \"day\" but didn't find its value in cache.")
-		}
-		s.MotorRuntime.Trigger(s, "day")
-	}
-	if res.Error != nil {
-		return nil, res.Error
-	}
-	tres, ok := res.Data.(*time.Time)
-	if !ok {
-		return nil, fmt.Errorf("\"time\" failed to cast field \"day\" to the right type (*time.Time): %#v", res)
-	}
-	return tres, nil
-}
-
 // Today accessor autogenerated
 func (s *mqlTime) Today() (*time.Time, error) {
 	res, ok := s.Cache.Load("today")
@@ -4458,14 +4330,6 @@ func (s *mqlTime) MqlCompute(name string) error {
 	switch name {
 	case "now":
 		return s.ComputeNow()
-	case "second":
-		return s.ComputeSecond()
-	case "minute":
-		return s.ComputeMinute()
-	case "hour":
-		return s.ComputeHour()
-	case "day":
-		return s.ComputeDay()
 	case "today":
 		return s.ComputeToday()
 	case "tomorrow":
@@ -4489,62 +4353,6 @@ func (s *mqlTime) ComputeNow() error {
 	return nil
 }
 
-// ComputeSecond computer autogenerated
-func (s *mqlTime) ComputeSecond() error {
-	var err error
-	if _, ok := s.Cache.Load("second"); ok {
-		return nil
-	}
-	vres, err := s.GetSecond()
-	if _, ok := err.(resources.NotReadyError); ok {
-		return err
-	}
-	s.Cache.Store("second", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
-	return nil
-}
-
-// ComputeMinute computer autogenerated
-func (s *mqlTime) ComputeMinute() error {
-	var err error
-	if _, ok := s.Cache.Load("minute"); ok {
-		return nil
-	}
-	vres, err := s.GetMinute()
-	if _, ok := err.(resources.NotReadyError); ok {
-		return err
-	}
-	s.Cache.Store("minute", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
-	return nil
-}
-
-// ComputeHour computer autogenerated
-func (s *mqlTime) ComputeHour() error {
-	var err error
-	if _, ok := s.Cache.Load("hour"); ok {
-		return nil
-	}
-	vres, err := s.GetHour()
-	if _, ok := err.(resources.NotReadyError); ok {
-		return err
-	}
-	s.Cache.Store("hour", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
-	return nil
-}
-
-// ComputeDay computer autogenerated
-func (s *mqlTime) ComputeDay() error {
-	var err error
-	if _, ok := s.Cache.Load("day"); ok {
-		return nil
-	}
-	vres, err := s.GetDay()
-	if _, ok := err.(resources.NotReadyError); ok {
-		return err
-	}
-	s.Cache.Store("day", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
-	return nil
-}
-
 // ComputeToday computer autogenerated
 func (s *mqlTime) ComputeToday() error {
 	var err error
@@ -8804,6 +8612,8 @@ type Port interface {
 	RemoteAddress() (string, error)
 	RemotePort() (int64, error)
 	Tls() (Tls, error)
+	Ip() (string, error)
+	RemoteIp() (string, error)
 }
 
 // mqlPort for the port resource
@@ -8957,6 +8767,18 @@ func (s *mqlPort) Register(name string) error {
 			return err
 		}
 		return nil
+	case "ip":
+		var err error
+		if err = s.MotorRuntime.WatchAndCompute(s, "address", s, "ip"); err != nil {
+			return err
+		}
+		return nil
+	case "remoteIp":
+		var err error
+		if err = s.MotorRuntime.WatchAndCompute(s, "remoteAddress", s, "remoteIp"); err != nil {
+			return err
+		}
+		return nil
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"port\" resource")
 	}
@@ -8984,6 +8806,10 @@ func (s *mqlPort) Field(name string) (interface{}, error) {
 		return s.RemotePort()
 	case "tls":
 		return s.Tls()
+	case "ip":
+		return s.Ip()
+	case "remoteIp":
+		return s.RemoteIp()
 	default:
 		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"port\" resource")
 	}
@@ -9133,6 +8959,38 @@ func (s *mqlPort) Tls() (Tls, error) {
 	return tres, nil
 }
 
+// Ip accessor autogenerated
+func (s *mqlPort) Ip() (string, error) {
+	res, ok := s.Cache.Load("ip")
+	if !ok || !res.Valid {
+		return "", resources.NotReadyError{}
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"port\" failed to cast field \"ip\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// RemoteIp accessor autogenerated
+func (s *mqlPort) RemoteIp() (string, error) {
+	res, ok := s.Cache.Load("remoteIp")
+	if !ok || !res.Valid {
+		return "", resources.NotReadyError{}
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"port\" failed to cast field \"remoteIp\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
 // Compute accessor autogenerated
 func (s *mqlPort) MqlCompute(name string) error {
 	log.Trace().Str("field", name).Msg("[port].MqlCompute")
@@ -9155,6 +9013,10 @@ func (s *mqlPort) MqlCompute(name string) error {
 		return nil
 	case "tls":
 		return s.ComputeTls()
+	case "ip":
+		return s.ComputeIp()
+	case "remoteIp":
+		return s.ComputeRemoteIp()
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"port\" resource")
 	}
@@ -9195,6 +9057,44 @@ func (s *mqlPort) ComputeTls() error {
 	return nil
 }
 
+// ComputeIp computer autogenerated
+func (s *mqlPort) ComputeIp() error {
+	var err error
+	vargAddress, err := s.Address()
+	if err != nil {
+		if _, ok := err.(resources.NotReadyError); ok {
+			return err
+		}
+		s.Cache.Store("ip", &resources.CacheEntry{Valid: true, Error: err, Timestamp: time.Now().Unix()})
+		return nil
+	}
+	vres, err := s.GetIp(vargAddress)
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("ip", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeRemoteIp computer autogenerated
+func (s *mqlPort) ComputeRemoteIp() error {
+	var err error
+	vargRemoteAddress, err := s.RemoteAddress()
+	if err != nil {
+		if _, ok := err.(resources.NotReadyError); ok {
+			return err
+		}
+		s.Cache.Store("remoteIp", &resources.CacheEntry{Valid: true, Error: err, Timestamp: time.Now().Unix()})
+		return nil
+	}
+	vres, err := s.GetRemoteIp(vargRemoteAddress)
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("remoteIp", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
 // Ports resource interface
 type Ports interface {
 	MqlResource() (*resources.Resource)
@@ -9395,6 +9295,7 @@ type Socket interface {
 	Protocol() (string, error)
 	Port() (int64, error)
 	Address() (string, error)
+	Ip() (string, error)
 }
 
 // mqlSocket for the socket resource
@@ -9485,6 +9386,12 @@ func (s *mqlSocket) Register(name string) error {
 		return nil
 	case "address":
 		return nil
+	case "ip":
+		var err error
+		if err = s.MotorRuntime.WatchAndCompute(s, "address", s, "ip"); err != nil {
+			return err
+		}
+		return nil
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"socket\" resource")
 	}
@@ -9500,6 +9407,8 @@ func (s *mqlSocket) Field(name string) (interface{}, error) {
 		return s.Port()
 	case "address":
 		return s.Address()
+	case "ip":
+		return s.Ip()
 	default:
 		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"socket\" resource")
 	}
@@ -9553,6 +9462,22 @@ func (s *mqlSocket) Address() (string, error) {
 	return tres, nil
 }
 
+// Ip accessor autogenerated
+func (s *mqlSocket) Ip() (string, error) {
+	res, ok := s.Cache.Load("ip")
+	if !ok || !res.Valid {
+		return "", resources.NotReadyError{}
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"socket\" failed to cast field \"ip\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
 // Compute accessor autogenerated
 func (s *mqlSocket) MqlCompute(name string) error {
 	log.Trace().Str("field", name).Msg("[socket].MqlCompute")
@@ -9563,11 +9488,32 @@ func (s *mqlSocket) MqlCompute(name string) error {
 		return nil
 	case "address":
 		return nil
+	case "ip":
+		return s.ComputeIp()
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"socket\" resource")
 	}
 }
 
+// ComputeIp computer autogenerated
+func (s *mqlSocket) ComputeIp() error {
+	var err error
+	vargAddress, err := s.Address()
+	if err != nil {
+		if _, ok := err.(resources.NotReadyError); ok {
+			return err
+		}
+		s.Cache.Store("ip", &resources.CacheEntry{Valid: true, Error: err, Timestamp: time.Now().Unix()})
+		return nil
+	}
+	vres, err := s.GetIp(vargAddress)
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("ip", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
 // Tls resource interface
 type Tls interface {
 	MqlResource() (*resources.Resource)
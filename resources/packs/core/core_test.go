@@ -396,63 +396,86 @@ func TestNumber_Methods(t *testing.T) {
 
 var emojiTestString = []rune("☀⛺➿🌀🎂👍🔒😀🙈🚵🛼🤌🤣🥳🧡🧿🩰🫖")
 
+// emptyGroups are the results of capture groups that didn't match
+func emptyGroups(n int) []interface{} {
+	res := make([]interface{}, n)
+	for i := range res {
+		res[i] = ""
+	}
+	return res
+}
+
 func TestRegex_Methods(t *testing.T) {
 	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
 	x.TestSimple(t, []testutils.SimpleTest{
 		{
 			"'hello bob'.find(/he\\w*\\s?[bo]+/)",
 			0,
-			[]interface{}{"hello bob"},
+			[]interface{}{[]interface{}{"hello bob"}},
 		},
 		{
 			"'HellO'.find(/hello/i)",
 			0,
-			[]interface{}{"HellO"},
+			[]interface{}{[]interface{}{"HellO"}},
 		},
 		{
 			"'hello\nworld'.find(/hello.world/s)",
 			0,
-			[]interface{}{"hello\nworld"},
+			[]interface{}{[]interface{}{"hello\nworld"}},
 		},
 		{
 			"'yo! hello\nto the world'.find(/\\w+$/m)",
 			0,
-			[]interface{}{"hello", "world"},
+			[]interface{}{[]interface{}{"hello"}, []interface{}{"world"}},
 		},
 		{
 			"'IPv4: 0.0.0.0, 255.255.255.255, 1.50.120.230, 256.0.0.0 '.find(regex.ipv4)",
 			0,
-			[]interface{}{"0.0.0.0", "255.255.255.255", "1.50.120.230"},
+			[]interface{}{
+				[]interface{}{"0.0.0.0", "0", ".0", "0"},
+				[]interface{}{"255.255.255.255", "255", ".255", "255"},
+				[]interface{}{"1.50.120.230", "1", ".230", "230"},
+			},
 		},
 		{
 			"'IPv6: 2001:0db8:85a3:0000:0000:8a2e:0370:7334'.find(regex.ipv6)",
 			0,
-			[]interface{}{"2001:0db8:85a3:0000:0000:8a2e:0370:7334"},
+			[]interface{}{
+				// the regex has 30 groups, only the first two of which match
+				append(
+					[]interface{}{"2001:0db8:85a3:0000:0000:8a2e:0370:7334", "2001:0db8:85a3:0000:0000:8a2e:0370:7334", "0370:"},
+					emptyGroups(28)...,
+				),
+			},
 		},
 		{
 			"'Sarah Summers <sarah@summe.rs>'.find( regex.email )",
 			0,
-			[]interface{}{"sarah@summe.rs"},
+			[]interface{}{
+				[]interface{}{"sarah@summe.rs", "sarah", "", "h", "", "", "summe.rs", "summe", ".rs", "rs"},
+			},
 		},
 		{
 			"'one+1@sum.me.rs:'.find( regex.email )",
 			0,
-			[]interface{}{"one+1@sum.me.rs"},
+			[]interface{}{
+				[]interface{}{"one+1@sum.me.rs", "one+1", "", "1", "", "", "sum.me.rs", "sum", ".rs", "rs"},
+			},
 		},
 		{
 			"'Urls: http://mondoo.com/welcome'.find( regex.url )",
 			0,
-			[]interface{}{"http://mondoo.com/welcome"},
+			[]interface{}{[]interface{}{"http://mondoo.com/welcome", "", "/welcome"}},
 		},
 		{
 			"'mac 01:23:45:67:89:ab attack'.find(regex.mac)",
 			0,
-			[]interface{}{"01:23:45:67:89:ab"},
+			[]interface{}{[]interface{}{"01:23:45:67:89:ab", ":ab"}},
 		},
 		{
 			"'uuid: b7f99555-5bca-48f4-b86f-a953a4883383.'.find(regex.uuid)",
 			0,
-			[]interface{}{"b7f99555-5bca-48f4-b86f-a953a4883383"},
+			[]interface{}{[]interface{}{"b7f99555-5bca-48f4-b86f-a953a4883383"}},
 		},
 		{
 			"'some ⮆" + string(emojiTestString) + " ⮄ emojis'.find(regex.emoji).length",
@@ -461,7 +484,10 @@ func TestRegex_Methods(t *testing.T) {
 		{
 			"'semvers: 1, 1.2, 1.2.3, 1.2.3-4'.find(regex.semver)",
 			0,
-			[]interface{}{"1.2.3", "1.2.3-4"},
+			[]interface{}{
+				[]interface{}{"1.2.3", "1", "2", "3", "", ""},
+				[]interface{}{"1.2.3-4", "1", "2", "3", "4", ""},
+			},
 		},
 	})
 }
@@ -551,6 +577,109 @@ func TestString_Methods(t *testing.T) {
 			"'hello ' + 'world'",
 			0, "hello world",
 		},
+		{
+			"'hello world'.replace('o', '0')",
+			0, "hell0 w0rld",
+		},
+		{
+			"'a1b22'.replaceAll(/[0-9]+/, '#')",
+			0, "a#b#",
+		},
+		{
+			"'k=v, a=b'.find(/(\\w)=(\\w)/)",
+			0,
+			[]interface{}{
+				[]interface{}{"k=v", "k", "v"},
+				[]interface{}{"a=b", "a", "b"},
+			},
+		},
+		{
+			"'hello'.substr(1, 3)",
+			0, "ell",
+		},
+		{
+			"'hello'.substr(3)",
+			0, "lo",
+		},
+		{
+			"'hello'.startsWith('he')",
+			0, true,
+		},
+		{
+			"'hello'.endsWith('he')",
+			0, false,
+		},
+		{
+			"'7'.padLeft(3, '0')",
+			0, "007",
+		},
+		{
+			"'ab'.padRight(4)",
+			0, "ab  ",
+		},
+		{
+			"'%s has uid %d'.format('root', 0)",
+			0, "root has uid 0",
+		},
+	})
+
+	x.TestSimpleErrors(t, []testutils.SimpleTest{
+		{
+			"'a'.padLeft(1000000000000)",
+			0, "failed to pad string, width 1000000000000 exceeds the maximum of 65536",
+		},
+	})
+}
+
+func TestString_Interpolation(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			`"say \"hi\""`,
+			0, "say \"hi\"",
+		},
+		{
+			"`raw \\n string`",
+			0, "raw \\n string",
+		},
+		{
+			`"uid ${1 + 1}"`,
+			0, "uid 2",
+		},
+		{
+			`"${users.list[0].name} has uid ${users.list[0].uid}"`,
+			0, "root has uid 0",
+		},
+		{
+			`"not \${interpolated}"`,
+			0, "not ${interpolated}",
+		},
+		{
+			`users.list.map("${name}:${uid}")[0]`,
+			0, "root:0",
+		},
+	})
+}
+
+func TestFunctions(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"def inc(x int) { x + 1 }\ninc(2)",
+			0, int64(3),
+		},
+		{
+			"def isRoot(u user) { u.uid == 0 }\nusers.list.where(isRoot(_)).length",
+			0, int64(1),
+		},
+		{
+			"def double(x int) { x * 2 }\n[1,2,3].map(double(_))",
+			0, []interface{}{int64(2), int64(4), int64(6)},
+		},
+		{
+			"def greet(name string) { return \"hi ${name}\" }\ngreet(name: 'bob')",
+			0, "hi bob",
+		},
 	})
 }
 
@@ -592,6 +721,191 @@ func TestTypeof_Methods(t *testing.T) {
 	})
 }
 
+func TestVersion_Methods(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"version('1.2.10')",
+			0, llx.Version{Value: "1.2.10"},
+		},
+		{
+			"version('1:1.0', type: 'deb')",
+			0, llx.Version{Value: "1:1.0", Ecosystem: "deb"},
+		},
+		{
+			"typeof(version('1.2'))",
+			0, "version",
+		},
+		{
+			"version('1.2.10') > version('1.2.9')",
+			0, true,
+		},
+		{
+			"version('1.2.10') >= '1.2.9'",
+			0, true,
+		},
+		{
+			"'1.2.9' < version('1.2.10')",
+			0, true,
+		},
+		{
+			"version('1.2.0-beta.1') < '1.2.0'",
+			0, true,
+		},
+		{
+			"version('1.2') == '1.2.0'",
+			0, true,
+		},
+		{
+			"version('1.2') != '1.2.1'",
+			0, true,
+		},
+		{
+			"version('1:1.0', type: 'deb') > '2.0'",
+			0, true,
+		},
+		{
+			"version('1.0~rc1', type: 'deb') < '1.0'",
+			0, true,
+		},
+		{
+			"version('1.0-2.el8', type: 'rpm') < '1.0-10.el8'",
+			0, true,
+		},
+		{
+			"version('1.5.0').inRange('<2.0, >=1.4')",
+			0, true,
+		},
+		{
+			"version('2.1').inRange('<2.0, >=1.4')",
+			0, false,
+		},
+		{
+			"v = version('1.5'); v.inRange('<1.0 || >=1.5')",
+			1, true,
+		},
+		{
+			"version('1:1.0', 'deb') > '2.0'",
+			0, true,
+		},
+		{
+			"[version('1.10'), version('1.9'), version('1.2')].sort",
+			0, []interface{}{llx.Version{Value: "1.2"}, llx.Version{Value: "1.9"}, llx.Version{Value: "1.10"}},
+		},
+		{
+			"[version('1.2'), version('1.10'), version('1.9')].max",
+			0, llx.Version{Value: "1.10"},
+		},
+		{
+			"[version('1.2'), version('1.2.0'), version('1.3')].unique",
+			0, []interface{}{llx.Version{Value: "1.2"}, llx.Version{Value: "1.3"}},
+		},
+	})
+
+	x.TestSimpleErrors(t, []testutils.SimpleTest{
+		{
+			"version('1.0') >= 'garbage'",
+			0, "invalid version 'garbage', it must start with a number",
+		},
+		{
+			"version('1.0', 'deb') == version('1.0', 'rpm')",
+			0, "cannot compare deb version '1.0' to rpm version '1.0'",
+		},
+	})
+}
+
+func TestIP_Methods(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"ip('[::1]')",
+			0, "::1",
+		},
+		{
+			"typeof(ip('10.0.0.1'))",
+			0, "ip",
+		},
+		{
+			"ip('10.0.0.1').isPrivate",
+			0, true,
+		},
+		{
+			"ip('8.8.8.8').isPrivate",
+			0, false,
+		},
+		{
+			"ip('127.0.0.1').isLoopback",
+			0, true,
+		},
+		{
+			"ip('::').isUnspecified",
+			0, true,
+		},
+		{
+			"ip('::ffff:10.0.0.1').version",
+			0, int64(4),
+		},
+		{
+			"ip('::1') == '0:0:0:0:0:0:0:1'",
+			0, true,
+		},
+		{
+			"ip('10.0.0.2') < ip('10.0.0.10')",
+			0, true,
+		},
+	})
+}
+
+func TestCIDR_Methods(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"cidr('10.1.2.3/8')",
+			0, "10.0.0.0/8",
+		},
+		{
+			"cidr(ip('10.0.0.1'))",
+			0, "10.0.0.1/32",
+		},
+		{
+			"cidr('10.0.0.0/8').contains('10.1.2.3')",
+			0, true,
+		},
+		{
+			"cidr('10.0.0.0/8').contains(ip('11.1.2.3'))",
+			0, false,
+		},
+		{
+			"cidr('10.1.0.0/16').contains(cidr('10.0.0.0/8'))",
+			0, false,
+		},
+		{
+			"cidr('10.0.0.0/8').overlaps('10.1.0.0/16')",
+			0, true,
+		},
+		{
+			"cidr('0.0.0.0/0').prefixLength",
+			0, int64(0),
+		},
+		{
+			"cidr('fc00::/7').version",
+			0, int64(6),
+		},
+		{
+			"cidr('192.168.1.0/24').isPrivate",
+			0, true,
+		},
+		{
+			"cidr('0.0.0.0/0').isPrivate",
+			0, false,
+		},
+		{
+			"cidr('0.0.0.0/0') == '0.0.0.0/0'",
+			0, true,
+		},
+	})
+}
+
 func duration(i int64) *time.Time {
 	res := llx.DurationToTime(i)
 	return &res
@@ -726,7 +1040,7 @@ func TestTime_Methods(t *testing.T) {
 		},
 		{
 			"2*time.hour + 1*time.hour",
-			0, duration(3 * 60 * 60),
+			0, llx.Duration{Seconds: 3 * 60 * 60},
 		},
 		{
 			"time.today + 1*time.day",
@@ -734,23 +1048,23 @@ func TestTime_Methods(t *testing.T) {
 		},
 		{
 			"2*time.hour - 1*time.hour",
-			0, duration(60 * 60),
+			0, llx.Duration{Seconds: 60 * 60},
 		},
 		{
 			"3 * time.second",
-			0, duration(3),
+			0, llx.Duration{Seconds: 3},
 		},
 		{
 			"3 * time.minute",
-			0, duration(3 * 60),
+			0, llx.Duration{Seconds: 3 * 60},
 		},
 		{
 			"3 * time.hour",
-			0, duration(3 * 60 * 60),
+			0, llx.Duration{Seconds: 3 * 60 * 60},
 		},
 		{
 			"3 * time.day",
-			0, duration(3 * 60 * 60 * 24),
+			0, llx.Duration{Seconds: 3 * 60 * 60 * 24},
 		},
 		{
 			"1 * time.day > 3 * time.hour",
@@ -783,6 +1097,106 @@ func TestTime_Methods(t *testing.T) {
 	})
 }
 
+func TestDuration_Methods(t *testing.T) {
+	jan31 := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	feb29 := time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+
+	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"duration('90d')",
+			0, llx.Duration{Seconds: 90 * 24 * 60 * 60},
+		},
+		{
+			"duration('PT24H') == duration('1d')",
+			2, true,
+		},
+		{
+			"typeof(duration('1h'))",
+			0, "duration",
+		},
+		{
+			"duration('1y').days",
+			0, int64(365),
+		},
+		{
+			"duration('90d') > 2 * time.month",
+			2, true,
+		},
+		{
+			"3 * time.month",
+			0, llx.Duration{Months: 3},
+		},
+		{
+			"typeof(time.day) == typeof(time.month)",
+			2, true,
+		},
+		{
+			"duration('2d') / 2",
+			0, llx.Duration{Seconds: 24 * 60 * 60},
+		},
+		{
+			"-duration('1d')",
+			0, llx.Duration{Seconds: -24 * 60 * 60},
+		},
+		{
+			"time.now - 3 * time.month < time.now",
+			2, true,
+		},
+		{
+			"time.parse('2024-01-31', 'date') + time.month",
+			0, &feb29,
+		},
+		{
+			"time.parse('2024-03-31', 'date') - time.month",
+			0, &feb29,
+		},
+		{
+			"time.parse('2024-01-31', 'date') - time.parse('2024-01-01', 'date') > duration('29d')",
+			2, true,
+		},
+		{
+			"time.parse('2024-01-31T01:00:00+01:00') == time.parse('2024-01-31', 'date')",
+			2, true,
+		},
+		{
+			"parse.date('2024-01-31', 'date')",
+			0, &jan31,
+		},
+		{
+			"time.parse('2024-01-31 10:00', '2006-01-02 15:04', 'Europe/Berlin').format('rfc3339')",
+			0, "2024-01-31T09:00:00Z",
+		},
+		{
+			"time.parse('2024-01-31', 'date').format('2006-01-02 15:04', '+02:00')",
+			0, "2024-01-31 02:00",
+		},
+		{
+			"[duration('2d'), duration('1h'), duration('1d')].sort",
+			0, []interface{}{llx.Duration{Seconds: 60 * 60}, llx.Duration{Seconds: 24 * 60 * 60}, llx.Duration{Seconds: 2 * 24 * 60 * 60}},
+		},
+		{
+			"[duration('1d'), duration('PT24H'), duration('1h')].unique.length",
+			0, int64(2),
+		},
+		{
+			"[duration('2d'), duration('1mo')].min",
+			0, llx.Duration{Seconds: 2 * 24 * 60 * 60},
+		},
+	})
+
+	x.TestSimpleErrors(t, []testutils.SimpleTest{
+		{
+			"duration('90')",
+			0, "invalid duration '90': missing unit after '90'",
+		},
+		{
+			"time.now.format('rfc3339', 'Mars/Base')",
+			0, "unknown timezone 'Mars/Base'",
+		},
+	})
+}
+
 func TestArray_Access(t *testing.T) {
 	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
 	x.TestSimpleErrors(t, []testutils.SimpleTest{
@@ -977,6 +1391,91 @@ func TestArray(t *testing.T) {
 			0,
 			[]interface{}{int64(1), int64(2)},
 		},
+		{
+			"[3,1,2].sort",
+			0,
+			[]interface{}{int64(1), int64(2), int64(3)},
+		},
+		{
+			"[3,1,2].sortDesc",
+			0,
+			[]interface{}{int64(3), int64(2), int64(1)},
+		},
+		{
+			"['b','c','a'].sort(_)",
+			0,
+			[]interface{}{"a", "b", "c"},
+		},
+		{
+			"[1,3,2].sortDesc(_ * 2)",
+			0,
+			[]interface{}{int64(3), int64(2), int64(1)},
+		},
+		{
+			"[3,1,2].reverse",
+			0,
+			[]interface{}{int64(2), int64(1), int64(3)},
+		},
+		{
+			"[1,2,3].take(2)",
+			0,
+			[]interface{}{int64(1), int64(2)},
+		},
+		{
+			"[1,2,3].skip(2)",
+			0,
+			[]interface{}{int64(3)},
+		},
+		{
+			"[1,2,3].skip(5)",
+			0,
+			[]interface{}{},
+		},
+		{
+			"[1,2,3].sum",
+			0, int64(6),
+		},
+		{
+			"[1.5,2.5].sum",
+			0, float64(4),
+		},
+		{
+			"[3,1,2].min",
+			0, int64(1),
+		},
+		{
+			"[3,1,2].max",
+			0, int64(3),
+		},
+		{
+			"[1,2].avg",
+			0, float64(1.5),
+		},
+		{
+			"[4,1,3,2].median",
+			0, float64(2.5),
+		},
+		{
+			"[1,2,3].where(_ > 3).max",
+			0, nil,
+		},
+		{
+			"[1,2,3].sum(_ * 2)",
+			0, int64(12),
+		},
+		{
+			"[1,2,3,4].groupBy(_ > 2)",
+			0,
+			map[string]interface{}{
+				"false": []interface{}{int64(1), int64(2)},
+				"true":  []interface{}{int64(3), int64(4)},
+			},
+		},
+		{
+			"['a','bb','c'].countBy(length)",
+			0,
+			map[int]interface{}{1: int64(2), 2: int64(1)},
+		},
 	})
 }
 
@@ -1082,6 +1581,35 @@ func TestListResource(t *testing.T) {
 			"users.map(name)",
 			0, []interface{}([]interface{}{"root", "chris", "christopher", "chris", "bin"}),
 		},
+		{
+			"users.sort(name).map(name)",
+			0, []interface{}{"bin", "chris", "chris", "christopher", "root"},
+		},
+		{
+			"users.reverse.take(2).map(name)",
+			0, []interface{}{"bin", "chris"},
+		},
+		{
+			"users.sum(uid)",
+			0, int64(3003),
+		},
+		{
+			"users.map(uid).max",
+			0, int64(1002),
+		},
+		{
+			"users.median(uid)",
+			0, float64(1000),
+		},
+		{
+			"users.countBy(name)",
+			0,
+			map[string]interface{}{"bin": int64(1), "chris": int64(2), "christopher": int64(1), "root": int64(1)},
+		},
+		{
+			"users.groupBy(gid)[1001].map(name)",
+			0, []interface{}{"chris", "christopher"},
+		},
 		{
 			// outside variables cause the block to be standalone
 			"n=false; users.contains(n)",
@@ -1408,6 +1936,14 @@ func TestDict_Methods_OtherJson(t *testing.T) {
 			"parse.json('/dummy.null.json').params",
 			0, nil,
 		},
+		{
+			"parse.json('/dummy.string.json').params.padLeft(4, '>')",
+			0, ">>hi",
+		},
+		{
+			"parse.json('/dummy.string.json').params.startsWith('h')",
+			0, true,
+		},
 	})
 }
 
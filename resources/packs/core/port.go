@@ -14,6 +14,7 @@ import (
 	"time"
 	"unsafe"
 
+	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/resources"
 	"go.mondoo.com/cnquery/resources/packs/core/lsof"
 
@@ -763,3 +764,20 @@ func (s *mqlPort) GetTls(address string, port int64, proto string) (interface{},
 		"domainName", "",
 	)
 }
+
+func (s *mqlPort) GetIp(address string) (string, error) {
+	return normalizeIP(address)
+}
+
+func (s *mqlPort) GetRemoteIp(remoteAddress string) (string, error) {
+	return normalizeIP(remoteAddress)
+}
+
+// normalizeIP turns an address into the canonical form of its IP address
+func normalizeIP(address string) (string, error) {
+	ip, err := llx.ParseIP(address)
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
@@ -20,3 +20,7 @@ func (s *mqlSocket) id() (string, error) {
 
 	return protocol + "://" + address + ":" + strconv.Itoa(int(port)), nil
 }
+
+func (s *mqlSocket) GetIp(address string) (string, error) {
+	return normalizeIP(address)
+}
@@ -10,10 +10,27 @@ import (
 	"go.mondoo.com/cnquery/resources/packs/core/versions/semver"
 )
 
+// Compare two versions of the given format, e.g. deb, rpm, apk, or npm.
+// It returns -1 if a is lower than b, 0 if they are equal, and 1 if a is
+// higher. Versions without a format are compared as semantic versions if
+// possible and like Debian versions otherwise.
 func Compare(format, a, b string) (int, error) {
 	var cmp int
 	var err error
 	switch format {
+	case "":
+		if err := checkGenericVersion(a); err != nil {
+			return 0, err
+		}
+		if err := checkGenericVersion(b); err != nil {
+			return 0, err
+		}
+		var parser semver.Parser
+		cmp, err = parser.Compare(a, b)
+		if err != nil {
+			var parser deb.Parser
+			cmp, err = parser.Compare(a, b)
+		}
 	case "rpm":
 		var parser rpm.Parser
 		cmp, err = parser.Compare(a, b)
@@ -27,7 +44,7 @@ func Compare(format, a, b string) (int, error) {
 		var parser apk.Parser
 		// for apk versions, we need to remove the epoch, since it is the build version for alpine
 		cmp, err = parser.Compare(VersionWithoutEpoch(a), VersionWithoutEpoch(b))
-	case "npm":
+	case "npm", "semver":
 		var parser semver.Parser
 		cmp, err = parser.Compare(a, b)
 	default:
@@ -36,6 +53,17 @@ func Compare(format, a, b string) (int, error) {
 	return cmp, err
 }
 
+// checkGenericVersion makes sure a version without a format looks like a
+// version. The Debian parser we fall back to accepts any word, which
+// would let us compare versions to arbitrary strings.
+func checkGenericVersion(version string) error {
+	v := strings.TrimPrefix(strings.TrimSpace(VersionWithoutEpoch(version)), "v")
+	if v == "" || v[0] < '0' || v[0] > '9' {
+		return errors.New("invalid version '" + version + "', it must start with a number")
+	}
+	return nil
+}
+
 func VersionWithoutEpoch(version string) string {
 	splitted := strings.SplitN(version, ":", 2)
 	if len(splitted) == 1 {
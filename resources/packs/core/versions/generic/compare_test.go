@@ -4,9 +4,73 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNoEpoch(t *testing.T) {
 	r := VersionWithoutEpoch("1632431095:1.2.2-r7")
 	assert.Equal(t, "1.2.2-r7", r)
 }
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		format string
+		a      string
+		b      string
+		cmp    int
+	}{
+		{"", "1.2.10", "1.2.9", 1},
+		{"", "1.2.0-beta.1", "1.2.0", -1},
+		{"", "1.2", "1.2.0", 0},
+		{"", "1:1.0", "2.0", 1},
+		{"deb", "1:1.0", "2.0", 1},
+		{"deb", "1.0~rc1", "1.0", -1},
+		{"rpm", "1.0-2.el8", "1.0-10.el8", -1},
+		{"semver", "2.0.0", "10.0.0", -1},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.format+" "+cur.a+" "+cur.b, func(t *testing.T) {
+			cmp, err := Compare(cur.format, cur.a, cur.b)
+			require.NoError(t, err)
+			assert.Equal(t, cur.cmp, cmp)
+		})
+	}
+
+	_, err := Compare("nope", "1.0", "2.0")
+	assert.Error(t, err)
+	_, err = Compare("", "1.0", "garbage")
+	assert.Error(t, err)
+}
+
+func TestInRange(t *testing.T) {
+	tests := []struct {
+		version string
+		rng     string
+		ok      bool
+	}{
+		{"1.4.0", "<2.0, >=1.4", true},
+		{"1.3.9", "<2.0, >=1.4", false},
+		{"2.0.0", "<2.0, >=1.4", false},
+		{"1.0", "1.0", true},
+		{"1.0", "!= 1.0", false},
+		{"0.9", "<1.0 || >=2.0", true},
+		{"1.5", "<1.0 || >=2.0", false},
+		{"2.1", "<1.0 || >=2.0", true},
+	}
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.version+" in "+cur.rng, func(t *testing.T) {
+			ok, err := InRange("", cur.version, cur.rng)
+			require.NoError(t, err)
+			assert.Equal(t, cur.ok, ok)
+		})
+	}
+
+	_, err := InRange("", "1.0", "")
+	assert.Error(t, err)
+	_, err = InRange("", "1.0", ">=1.0, <")
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"errors"
+	"strings"
+)
+
+var rangeOperators = []string{"<=", ">=", "==", "!=", "<", ">", "="}
+
+// InRange checks if a version matches a range of constraints, e.g.
+// "<2.0, >=1.4". Constraints that are separated by commas must all match,
+// while alternatives are separated by "||", e.g. "<1.0 || >=2.0". A version
+// without an operator must match exactly.
+func InRange(format, version, constraints string) (bool, error) {
+	if strings.TrimSpace(constraints) == "" {
+		return false, errors.New("version range is empty")
+	}
+
+	for _, alternative := range strings.Split(constraints, "||") {
+		ok, err := inAllRanges(format, version, alternative)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+func inAllRanges(format, version, constraints string) (bool, error) {
+	for _, constraint := range strings.Split(constraints, ",") {
+		op, other := parseConstraint(constraint)
+		if other == "" {
+			return false, errors.New("missing version in range constraint '" + strings.TrimSpace(constraint) + "'")
+		}
+
+		cmp, err := Compare(format, version, other)
+		if err != nil {
+			return false, err
+		}
+
+		var ok bool
+		switch op {
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "!=":
+			ok = cmp != 0
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// parseConstraint splits a constraint like ">= 1.4" into its operator and
+// version. Constraints without an operator test for equality.
+func parseConstraint(constraint string) (string, string) {
+	constraint = strings.TrimSpace(constraint)
+	for _, op := range rangeOperators {
+		if strings.HasPrefix(constraint, op) {
+			return op, strings.TrimSpace(constraint[len(op):])
+		}
+	}
+	return "==", constraint
+}
@@ -7,6 +7,8 @@ import (
 	"net"
 	"strconv"
 	"strings"
+
+	"go.mondoo.com/cnquery/llx"
 )
 
 // Stat represents a structured statistic entry.
@@ -45,6 +47,24 @@ func (ie *mqlIptablesEntry) id() (string, error) {
 	return strconv.FormatInt(ln, 10) + chain, nil
 }
 
+func (ie *mqlIptablesEntry) GetSourceNetwork(source string) (string, error) {
+	return normalizeNetwork(source)
+}
+
+func (ie *mqlIptablesEntry) GetDestinationNetwork(destination string) (string, error) {
+	return normalizeNetwork(destination)
+}
+
+// normalizeNetwork turns a source or destination into the canonical form
+// of its network. Single addresses are networks with only this address.
+func normalizeNetwork(address string) (string, error) {
+	network, err := llx.ParseCIDR(address)
+	if err != nil {
+		return "", err
+	}
+	return network.String(), nil
+}
+
 func (i *mqlIptables) GetOutput() ([]interface{}, error) {
 	osProvider, err := osProvider(i.MotorRuntime.Motor)
 	if err != nil {
@@ -19243,6 +19243,8 @@ type IptablesEntry interface {
 	Destination() (string, error)
 	Options() (string, error)
 	Chain() (string, error)
+	SourceNetwork() (string, error)
+	DestinationNetwork() (string, error)
 }
 
 // mqlIptablesEntry for the iptables.entry resource
@@ -19414,6 +19416,18 @@ func (s *mqlIptablesEntry) Register(name string) error {
 		return nil
 	case "chain":
 		return nil
+	case "sourceNetwork":
+		var err error
+		if err = s.MotorRuntime.WatchAndCompute(s, "source", s, "sourceNetwork"); err != nil {
+			return err
+		}
+		return nil
+	case "destinationNetwork":
+		var err error
+		if err = s.MotorRuntime.WatchAndCompute(s, "destination", s, "destinationNetwork"); err != nil {
+			return err
+		}
+		return nil
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"iptables.entry\" resource")
 	}
@@ -19447,6 +19461,10 @@ func (s *mqlIptablesEntry) Field(name string) (interface{}, error) {
 		return s.Options()
 	case "chain":
 		return s.Chain()
+	case "sourceNetwork":
+		return s.SourceNetwork()
+	case "destinationNetwork":
+		return s.DestinationNetwork()
 	default:
 		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"iptables.entry\" resource")
 	}
@@ -19644,6 +19662,38 @@ func (s *mqlIptablesEntry) Chain() (string, error) {
 	return tres, nil
 }
 
+// SourceNetwork accessor autogenerated
+func (s *mqlIptablesEntry) SourceNetwork() (string, error) {
+	res, ok := s.Cache.Load("sourceNetwork")
+	if !ok || !res.Valid {
+		return "", resources.NotReadyError{}
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"iptables.entry\" failed to cast field \"sourceNetwork\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// DestinationNetwork accessor autogenerated
+func (s *mqlIptablesEntry) DestinationNetwork() (string, error) {
+	res, ok := s.Cache.Load("destinationNetwork")
+	if !ok || !res.Valid {
+		return "", resources.NotReadyError{}
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"iptables.entry\" failed to cast field \"destinationNetwork\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
 // Compute accessor autogenerated
 func (s *mqlIptablesEntry) MqlCompute(name string) error {
 	log.Trace().Str("field", name).Msg("[iptables.entry].MqlCompute")
@@ -19672,8 +19722,50 @@ func (s *mqlIptablesEntry) MqlCompute(name string) error {
 		return nil
 	case "chain":
 		return nil
+	case "sourceNetwork":
+		return s.ComputeSourceNetwork()
+	case "destinationNetwork":
+		return s.ComputeDestinationNetwork()
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"iptables.entry\" resource")
 	}
 }
 
+// ComputeSourceNetwork computer autogenerated
+func (s *mqlIptablesEntry) ComputeSourceNetwork() error {
+	var err error
+	vargSource, err := s.Source()
+	if err != nil {
+		if _, ok := err.(resources.NotReadyError); ok {
+			return err
+		}
+		s.Cache.Store("sourceNetwork", &resources.CacheEntry{Valid: true, Error: err, Timestamp: time.Now().Unix()})
+		return nil
+	}
+	vres, err := s.GetSourceNetwork(vargSource)
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("sourceNetwork", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeDestinationNetwork computer autogenerated
+func (s *mqlIptablesEntry) ComputeDestinationNetwork() error {
+	var err error
+	vargDestination, err := s.Destination()
+	if err != nil {
+		if _, ok := err.(resources.NotReadyError); ok {
+			return err
+		}
+		s.Cache.Store("destinationNetwork", &resources.CacheEntry{Valid: true, Error: err, Timestamp: time.Now().Unix()})
+		return nil
+	}
+	vres, err := s.GetDestinationNetwork(vargDestination)
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("destinationNetwork", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
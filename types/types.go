@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"net/netip"
 	"strings"
 	"time"
 )
@@ -49,6 +50,10 @@ const (
 	byteFunction
 	byteStringSlice
 	byteRange
+	byteVersion
+	byteIP
+	byteCIDR
+	byteDuration
 )
 
 // Empty type is one whose type information is not available at all
@@ -104,6 +109,17 @@ const (
 	// or lines and columns combined. We use a special type for a very
 	// efficient storage and transmission structure.
 	Range = Type(rune(byteRange))
+
+	// Version of a package, kernel, or similar. Versions know the ecosystem
+	// they belong to (e.g. deb or rpm), which decides how they are compared.
+	Version = Type(rune(byteVersion))
+
+	// IP for IPv4 and IPv6 addresses
+	IP = Type(rune(byteIP))
+	// CIDR for networks, e.g. 10.0.0.0/8
+	CIDR = Type(rune(byteCIDR))
+	// Duration for lengths of time, e.g. 90 days or 3 months
+	Duration = Type(rune(byteDuration))
 )
 
 // IsEmpty returns true if the type has no information
@@ -235,6 +251,10 @@ var labels = map[byte]string{
 	byteBlock:       "block",
 	byteStringSlice: "stringslice",
 	byteRange:       "range",
+	byteVersion:     "version",
+	byteIP:          "ip",
+	byteCIDR:        "cidr",
+	byteDuration:    "duration",
 }
 
 var labelfun map[byte]func(Type) string
@@ -284,6 +304,22 @@ var Equal = map[Type]func(interface{}, interface{}) bool{
 	Regex: func(left, right interface{}) bool {
 		return left.(string) == right.(string)
 	},
+	IP: func(left, right interface{}) bool {
+		l, lerr := netip.ParseAddr(left.(string))
+		r, rerr := netip.ParseAddr(right.(string))
+		if lerr != nil || rerr != nil {
+			return left.(string) == right.(string)
+		}
+		return l.Unmap() == r.Unmap()
+	},
+	CIDR: func(left, right interface{}) bool {
+		l, lerr := netip.ParsePrefix(left.(string))
+		r, rerr := netip.ParsePrefix(right.(string))
+		if lerr != nil || rerr != nil {
+			return left.(string) == right.(string)
+		}
+		return l.Masked() == r.Masked()
+	},
 	Time: func(left, right interface{}) bool {
 		l := left.(*time.Time)
 		r := right.(*time.Time)
@@ -296,4 +332,19 @@ var Equal = map[Type]func(interface{}, interface{}) bool{
 	Score: func(left, right interface{}) bool {
 		return left.(int32) == right.(int32)
 	},
+	Version:  equalValues,
+	Duration: equalValues,
+}
+
+// valueEqualer is implemented by runtime values whose types are defined
+// outside of this package, like versions and durations
+type valueEqualer interface {
+	EqualValue(other interface{}) bool
+}
+
+func equalValues(left, right interface{}) bool {
+	if l, ok := left.(valueEqualer); ok {
+		return l.EqualValue(right)
+	}
+	return left == right
 }